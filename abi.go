@@ -0,0 +1,127 @@
+package winrt
+
+import (
+	"fmt"
+	"reflect"
+	"time"
+	"unsafe"
+
+	"github.com/go-ole/go-ole"
+)
+
+// ABIValue returns the value used to pass the given parameter to a vtable call. This is used for the
+// structs and the generic parameters of parameterized types, since their size depends on the target.
+//
+// Following the x64 calling convention, values of 1, 2, 4 or 8 bytes are passed by value and
+// any other value is passed by reference. The type must have the same memory layout as its
+// WinRT counterpart, see CheckTypeArg.
+func ABIValue[T any](v *T) uintptr {
+	size := unsafe.Sizeof(*v)
+	switch size {
+	case 1, 2, 4, 8:
+		if size <= unsafe.Sizeof(uintptr(0)) {
+			var value uintptr
+			dst := unsafe.Slice((*byte)(unsafe.Pointer(&value)), size)
+			src := unsafe.Slice((*byte)(unsafe.Pointer(v)), size)
+			copy(dst, src)
+			return value
+		}
+	}
+	return uintptr(unsafe.Pointer(v))
+}
+
+// CheckTypeArg panics if T cannot be used as the type argument of a typed wrapper, like IVectorOf.
+// The runtime classes are converted to their WinRT object (see TypeArgABI), and any other value is
+// passed to the vtable calls as it is, so it must have the same memory layout as its WinRT counterpart:
+// the numbers, bool, enums, ole.GUID, structs and interface pointers are supported. The wrappers must be
+// instantiated with hstring.HString for strings, and foundation.DateTime and foundation.TimeSpan for
+// the time types.
+func CheckTypeArg[T any]() {
+	var zero T
+	switch any(zero).(type) {
+	case time.Time, time.Duration:
+		panic(fmt.Sprintf("winrt: unsupported type argument %T, use the Windows.Foundation struct instead", zero))
+	case objectWrapper:
+		return
+	}
+
+	switch t := reflect.TypeOf(&zero).Elem(); t.Kind() {
+	case reflect.String:
+		panic(fmt.Sprintf("winrt: unsupported type argument %s, use hstring.HString instead", t))
+	case reflect.Interface, reflect.Slice, reflect.Map, reflect.Func, reflect.Chan:
+		panic(fmt.Sprintf("winrt: unsupported type argument %s", t))
+	}
+}
+
+// isClassTypeArg returns true if T is a runtime class embedding an Object, which is a pointer.
+// The other types are not converted to an interface, which would allocate the large values.
+func isClassTypeArg[T any]() bool {
+	if reflect.TypeOf((*T)(nil)).Elem().Kind() != reflect.Pointer {
+		return false
+	}
+	var zero T
+	_, ok := any(zero).(objectWrapper)
+	return ok
+}
+
+// objectSlot returns the given runtime class as the pointer it is, which holds the WinRT object
+// while a vtable call reads or writes it.
+func objectSlot[T any](v *T) *unsafe.Pointer {
+	return (*unsafe.Pointer)(unsafe.Pointer(v))
+}
+
+// TypeArgABI returns the value used to pass a type argument to a vtable call. The runtime classes pass
+// their WinRT object, other types are passed like ABIValue.
+func TypeArgABI[T any](v *T) uintptr {
+	if isClassTypeArg[T]() {
+		return ObjectABI(*objectSlot(v))
+	}
+	return ABIValue(v)
+}
+
+// TypeArgArray returns the array used to pass the given type arguments to a vtable call. The runtime
+// classes are copied into a new array holding their WinRT objects, other arrays are returned as they are.
+func TypeArgArray[T any](s []T) []T {
+	if !isClassTypeArg[T]() {
+		return s
+	}
+
+	objects := make([]T, len(s))
+	for i := range s {
+		if o := (*Object)(*objectSlot(&s[i])); o != nil {
+			*objectSlot(&objects[i]) = unsafe.Pointer(o.IUnknown)
+		}
+	}
+	return objects
+}
+
+// ClearTypeArgArray clears an array of runtime classes before it is filled by a vtable call, so that
+// TypeArgResults does not mistake the previous classes for WinRT objects. Other arrays are not changed.
+func ClearTypeArgArray[T any](s []T) {
+	if !isClassTypeArg[T]() {
+		return
+	}
+	for i := range s {
+		s[i] = *new(T)
+	}
+}
+
+// TypeArgResult converts a type argument written by a vtable call. The WinRT object returned for a
+// runtime class is wrapped in a new Object, which owns the reference. Other values are not changed.
+func TypeArgResult[T any](v *T) {
+	if !isClassTypeArg[T]() {
+		return
+	}
+	slot := objectSlot(v)
+	*slot = unsafe.Pointer(NewObject((*ole.IUnknown)(*slot)))
+}
+
+// TypeArgResults converts the type arguments written by a vtable call into an array, see TypeArgResult.
+func TypeArgResults[T any](s []T) {
+	if !isClassTypeArg[T]() {
+		return
+	}
+	for i := range s {
+		TypeArgResult(&s[i])
+	}
+}
@@ -0,0 +1,117 @@
+package winrt
+
+import (
+	"testing"
+	"time"
+	"unsafe"
+
+	"github.com/go-ole/go-ole"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestABIValueByValue(t *testing.T) {
+	u32 := uint32(0xdeadbeef)
+	assert.Equal(t, uintptr(0xdeadbeef), ABIValue(&u32))
+
+	b := true
+	assert.Equal(t, uintptr(1), ABIValue(&b))
+
+	var i int
+	ptr := &i
+	assert.Equal(t, uintptr(unsafe.Pointer(ptr)), ABIValue(&ptr))
+}
+
+func TestABIValueByReference(t *testing.T) {
+	guid := ole.GUID{Data1: 1}
+	assert.Equal(t, uintptr(unsafe.Pointer(&guid)), ABIValue(&guid))
+}
+
+// Test that the values whose size is not a power of two are passed by reference, even if they fit in a register.
+func TestABIValueOddSize(t *testing.T) {
+	three := [3]byte{1, 2, 3}
+	assert.Equal(t, uintptr(unsafe.Pointer(&three)), ABIValue(&three))
+
+	six := struct{ a, b, c uint16 }{1, 2, 3}
+	assert.Equal(t, uintptr(unsafe.Pointer(&six)), ABIValue(&six))
+
+	two := [2]byte{1, 2}
+	assert.Equal(t, uintptr(0x0201), ABIValue(&two))
+}
+
+func TestCheckTypeArg(t *testing.T) {
+	assert.NotPanics(t, CheckTypeArg[uint32])
+	assert.NotPanics(t, CheckTypeArg[ole.GUID])
+	assert.NotPanics(t, CheckTypeArg[*ole.IInspectable])
+	assert.NotPanics(t, CheckTypeArg[unsafe.Pointer])
+
+	assert.Panics(t, CheckTypeArg[string])
+	assert.Panics(t, CheckTypeArg[time.Time])
+	assert.Panics(t, CheckTypeArg[time.Duration])
+	assert.NotPanics(t, CheckTypeArg[*Object])
+	assert.NotPanics(t, CheckTypeArg[*testClass])
+	assert.Panics(t, CheckTypeArg[any])
+	assert.Panics(t, CheckTypeArg[[]uint32])
+}
+
+// testClass is laid out like the generated runtime classes.
+type testClass struct {
+	Object
+}
+
+// Test that the runtime classes used as type arguments are passed as their WinRT object.
+func TestTypeArgABI(t *testing.T) {
+	unk := &ole.IUnknown{}
+	class := (*testClass)(unsafe.Pointer(NewObject(unk)))
+	assert.Equal(t, uintptr(unsafe.Pointer(unk)), TypeArgABI(&class))
+
+	var null *testClass
+	assert.Zero(t, TypeArgABI(&null))
+
+	u32 := uint32(42)
+	assert.Equal(t, uintptr(42), TypeArgABI(&u32))
+
+	objects := TypeArgArray([]*testClass{class, nil})
+	assert.Equal(t, []uintptr{uintptr(unsafe.Pointer(unk)), 0}, []uintptr{uintptr(unsafe.Pointer(objects[0])), uintptr(unsafe.Pointer(objects[1]))})
+
+	values := []uint32{1, 2}
+	assert.Same(t, &values[0], &TypeArgArray(values)[0], "other arrays are not copied")
+}
+
+// Test that the WinRT objects returned for runtime classes are wrapped in an Object.
+func TestTypeArgResult(t *testing.T) {
+	unk := &ole.IUnknown{}
+
+	// the vtable call writes the WinRT object into the class
+	var out *testClass
+	*objectSlot(&out) = unsafe.Pointer(unk)
+	TypeArgResult(&out)
+	require.NotNil(t, out)
+	assert.Same(t, unk, out.IUnknown)
+
+	items := []*testClass{out, out}
+	ClearTypeArgArray(items)
+	assert.Equal(t, []*testClass{nil, nil}, items)
+
+	*objectSlot(&items[0]) = unsafe.Pointer(unk)
+	TypeArgResults(items)
+	require.NotNil(t, items[0])
+	assert.Same(t, unk, items[0].IUnknown)
+	assert.Nil(t, items[1])
+
+	values := []uint32{1, 2}
+	ClearTypeArgArray(values)
+	TypeArgResults(values)
+	assert.Equal(t, []uint32{1, 2}, values)
+}
+
+func TestTypeArgABIAllocs(t *testing.T) {
+	guid := ole.GUID{Data1: 1}
+	class := (*testClass)(unsafe.Pointer(NewObject(&ole.IUnknown{})))
+	allocs := testing.AllocsPerRun(100, func() {
+		_ = TypeArgABI(&guid)
+		_ = TypeArgABI(&class)
+		TypeArgResult(&guid)
+	})
+	assert.Zero(t, allocs)
+}
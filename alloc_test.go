@@ -0,0 +1,133 @@
+//go:build windows
+
+package winrt_test
+
+import (
+	"testing"
+
+	"github.com/go-ole/go-ole"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/waylyrics/winrt-go"
+	"github.com/waylyrics/winrt-go/hstring"
+	"github.com/waylyrics/winrt-go/windows/foundation"
+	"github.com/waylyrics/winrt-go/windows/media"
+)
+
+// initWinRT initializes WinRT for the test, or skips it if WinRT is not available.
+func initWinRT(tb testing.TB) {
+	if err := ole.RoInitialize(1); err != nil { // RO_INIT_MULTITHREADED
+		tb.Skipf("WinRT not available: %v", err)
+	}
+	tb.Cleanup(func() {
+		winrt.ReleaseFactories()
+		ole.CoUninitialize()
+	})
+}
+
+// Test that the generated methods do not allocate once their interface and activation factory are cached.
+func TestGeneratedMethodAllocs(t *testing.T) {
+	initWinRT(t)
+
+	timeline, err := media.NewSystemMediaTransportControlsTimelineProperties()
+	if err != nil {
+		t.Skipf("SystemMediaTransportControlsTimelineProperties not available: %v", err)
+	}
+	defer timeline.Release()
+
+	// the display properties are only available to the apps with a window
+	music, err := musicDisplayProperties()
+	if music != nil {
+		defer music.Release()
+	}
+
+	tests := []struct {
+		name string
+		call func() error
+		skip error
+	}{
+		{
+			name: "getter",
+			call: func() error {
+				_, err := timeline.GetPosition()
+				return err
+			},
+		},
+		{
+			name: "interface support",
+			call: func() error {
+				_ = timeline.HasISystemMediaTransportControlsTimelineProperties()
+				return nil
+			},
+		},
+		{
+			name: "string setter",
+			call: func() error {
+				return music.SetArtist("Artist")
+			},
+			skip: err,
+		},
+		{
+			name: "static method",
+			call: func() error {
+				value, err := foundation.PropertyValueCreateDouble(1.5)
+				if err != nil {
+					return err
+				}
+				(*ole.IUnknown)(value).Release()
+				return nil
+			},
+		},
+		{
+			name: "static method factory",
+			call: func() error {
+				_, err := winrt.GetActivationFactory("Windows.Media.SystemMediaTransportControls", &media.IIDiSystemMediaTransportControlsStatics)
+				return err
+			},
+		},
+		{
+			name: "string reference",
+			call: func() error {
+				r, err := hstring.NewReference("Artist")
+				if err != nil {
+					return err
+				}
+				r.Release()
+				return nil
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.skip != nil {
+				t.Skipf("not available: %v", tt.skip)
+			}
+
+			// the first call caches the interfaces and the factories
+			require.NoError(t, tt.call())
+
+			allocs := testing.AllocsPerRun(100, func() {
+				_ = tt.call()
+			})
+			assert.Zero(t, allocs)
+		})
+	}
+}
+
+// musicDisplayProperties returns the music properties of the media controls of the current view.
+func musicDisplayProperties() (*media.MusicDisplayProperties, error) {
+	controls, err := media.SystemMediaTransportControlsGetForCurrentView()
+	if err != nil {
+		return nil, err
+	}
+	defer controls.Release()
+
+	updater, err := controls.GetDisplayUpdater()
+	if err != nil {
+		return nil, err
+	}
+	defer updater.Release()
+
+	return updater.GetMusicProperties()
+}
@@ -0,0 +1,75 @@
+package winrt
+
+import (
+	"unsafe"
+
+	"github.com/go-ole/go-ole"
+
+	"github.com/waylyrics/winrt-go/hstring"
+)
+
+// ArrayABI returns the value used to pass the given array to a vtable call, that is, the address of its
+// first element. Empty arrays are passed as a null pointer.
+func ArrayABI[T any](s []T) uintptr {
+	if len(s) == 0 {
+		return 0
+	}
+	return uintptr(unsafe.Pointer(&s[0]))
+}
+
+// ReceiveArray copies an array allocated by a WinRT method (a receive array) into a Go slice.
+// The memory of the WinRT array is released using CoTaskMemFree.
+func ReceiveArray[T any](ptr unsafe.Pointer, size uint32) []T {
+	if ptr == nil {
+		return nil
+	}
+	defer ole.CoTaskMemFree(uintptr(ptr))
+
+	s := make([]T, size)
+	copy(s, unsafe.Slice((*T)(ptr), size))
+	return s
+}
+
+// ReceiveStringArray converts an array of HSTRINGs allocated by a WinRT method (a receive array)
+// into a Go slice. Both the HSTRINGs and the memory of the array are released.
+func ReceiveStringArray(ptr unsafe.Pointer, size uint32) []string {
+	hstrs := ReceiveArray[hstring.HString](ptr, size)
+	if hstrs == nil {
+		return nil
+	}
+
+	s := make([]string, size)
+	FillStringArray(s, hstrs)
+	return s
+}
+
+// FillStringArray converts the HSTRINGs written by a WinRT method into the given caller-allocated
+// array (a fill array), and releases them.
+func FillStringArray(s []string, hstrs []hstring.HString) {
+	for i, h := range hstrs {
+		s[i] = h.String()
+		_ = h.Delete()
+	}
+}
+
+// NewHStringArray creates an HSTRING for each of the given strings, to pass them to a WinRT method.
+// The returned HSTRINGs must be released using DeleteHStringArray.
+func NewHStringArray(s []string) ([]hstring.HString, error) {
+	hstrs := make([]hstring.HString, len(s))
+	for i, str := range s {
+		h, err := hstring.NewHString(str)
+		if err != nil {
+			DeleteHStringArray(hstrs[:i])
+			return nil, err
+		}
+		hstrs[i] = h
+	}
+	return hstrs, nil
+}
+
+// DeleteHStringArray releases all the given HSTRINGs.
+func DeleteHStringArray(hstrs []hstring.HString) {
+	for _, h := range hstrs {
+		_ = h.Delete()
+	}
+}
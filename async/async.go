@@ -0,0 +1,142 @@
+//go:build windows
+
+// Package async waits for the completion of WinRT asynchronous operations.
+//
+// The WinRT APIs return an IAsyncAction or an IAsyncOperation instead of blocking the caller. The functions
+// in this package register a completion handler in the operation, and block until the handler is called
+// or the given context is done. In the latter case the operation is canceled.
+//
+// The caller still owns the operation and is responsible for releasing it.
+package async
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/go-ole/go-ole"
+
+	"github.com/waylyrics/winrt-go"
+	"github.com/waylyrics/winrt-go/windows/foundation"
+)
+
+// ErrCanceled is returned when the asynchronous operation was canceled by someone else.
+var ErrCanceled = errors.New("async operation canceled")
+
+// asyncInfo contains the methods of IAsyncInfo used to check the result of an operation.
+type asyncInfo interface {
+	Cancel() error
+	GetErrorCode() (foundation.HResult, error)
+}
+
+// AwaitAction blocks until the given action completes, or until the context is done.
+func AwaitAction(ctx context.Context, action *foundation.IAsyncAction) error {
+	done := make(chan foundation.AsyncStatus, 1)
+	handler := foundation.NewAsyncActionCompletedHandler(
+		&foundation.IIDAsyncActionCompletedHandler,
+		func(_ *foundation.AsyncActionCompletedHandler, _ *foundation.IAsyncAction, status foundation.AsyncStatus) {
+			done <- status
+		},
+	)
+	defer handler.Release()
+
+	if err := action.SetCompleted(handler); err != nil {
+		return err
+	}
+	if err := wait(ctx, action, done); err != nil {
+		return err
+	}
+	return action.GetResults()
+}
+
+// AwaitActionWithProgress blocks until the given action completes, or until the context is done.
+func AwaitActionWithProgress[TProgress any](ctx context.Context, action *foundation.IAsyncActionWithProgressOf[TProgress]) error {
+	done := make(chan foundation.AsyncStatus, 1)
+	handler := foundation.NewAsyncActionWithProgressCompletedHandler(
+		ole.NewGUID(winrt.ParameterizedInstanceGUID(foundation.GUIDAsyncActionWithProgressCompletedHandler, action.Signatures()...)),
+		func(_ *foundation.AsyncActionWithProgressCompletedHandler, _ *foundation.IAsyncActionWithProgress, status foundation.AsyncStatus) {
+			done <- status
+		},
+	)
+	defer handler.Release()
+
+	if err := action.SetCompleted(handler); err != nil {
+		return err
+	}
+	if err := wait(ctx, action, done); err != nil {
+		return err
+	}
+	return action.GetResults()
+}
+
+// Await blocks until the given operation completes, or until the context is done, and returns its results.
+func Await[TResult any](ctx context.Context, operation *foundation.IAsyncOperationOf[TResult]) (TResult, error) {
+	done := make(chan foundation.AsyncStatus, 1)
+	handler := foundation.NewAsyncOperationCompletedHandler(
+		ole.NewGUID(winrt.ParameterizedInstanceGUID(foundation.GUIDAsyncOperationCompletedHandler, operation.Signatures()...)),
+		func(_ *foundation.AsyncOperationCompletedHandler, _ *foundation.IAsyncOperation, status foundation.AsyncStatus) {
+			done <- status
+		},
+	)
+	defer handler.Release()
+
+	if err := operation.SetCompleted(handler); err != nil {
+		return *new(TResult), err
+	}
+	if err := wait(ctx, operation, done); err != nil {
+		return *new(TResult), err
+	}
+	return operation.GetResults()
+}
+
+// AwaitWithProgress blocks until the given operation completes, or until the context is done, and returns its results.
+func AwaitWithProgress[TResult any, TProgress any](ctx context.Context, operation *foundation.IAsyncOperationWithProgressOf[TResult, TProgress]) (TResult, error) {
+	done := make(chan foundation.AsyncStatus, 1)
+	handler := foundation.NewAsyncOperationWithProgressCompletedHandler(
+		ole.NewGUID(winrt.ParameterizedInstanceGUID(foundation.GUIDAsyncOperationWithProgressCompletedHandler, operation.Signatures()...)),
+		func(_ *foundation.AsyncOperationWithProgressCompletedHandler, _ *foundation.IAsyncOperationWithProgress, status foundation.AsyncStatus) {
+			done <- status
+		},
+	)
+	defer handler.Release()
+
+	if err := operation.SetCompleted(handler); err != nil {
+		return *new(TResult), err
+	}
+	if err := wait(ctx, operation, done); err != nil {
+		return *new(TResult), err
+	}
+	return operation.GetResults()
+}
+
+// wait blocks until the completion handler sends the final status of the operation, and converts it into an error.
+// If the context is done first, the operation is canceled and the context error is returned, even if the
+// cancellation fails.
+func wait(ctx context.Context, info asyncInfo, done <-chan foundation.AsyncStatus) error {
+	select {
+	case status := <-done:
+		return statusError(info, status)
+	case <-ctx.Done():
+		if err := info.Cancel(); err != nil {
+			return fmt.Errorf("%w (cancel failed: %v)", ctx.Err(), err)
+		}
+		return ctx.Err()
+	}
+}
+
+func statusError(info asyncInfo, status foundation.AsyncStatus) error {
+	switch status {
+	case foundation.AsyncStatusCompleted:
+		return nil
+	case foundation.AsyncStatusCanceled:
+		return ErrCanceled
+	case foundation.AsyncStatusError:
+		code, err := info.GetErrorCode()
+		if err != nil {
+			return err
+		}
+		return ole.NewError(uintptr(uint32(code.Value)))
+	default:
+		return errors.New("async operation completed with an unexpected status")
+	}
+}
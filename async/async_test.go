@@ -0,0 +1,121 @@
+//go:build windows
+
+package async
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/go-ole/go-ole"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/waylyrics/winrt-go/windows/foundation"
+)
+
+// fakeInfo is an asyncInfo returning the given error code, and counting the cancellations.
+type fakeInfo struct {
+	code      foundation.HResult
+	codeErr   error
+	cancelErr error
+	canceled  int
+}
+
+func (f *fakeInfo) Cancel() error {
+	f.canceled++
+	return f.cancelErr
+}
+
+func (f *fakeInfo) GetErrorCode() (foundation.HResult, error) {
+	return f.code, f.codeErr
+}
+
+func TestWaitStatus(t *testing.T) {
+	errCode := errors.New("GetErrorCode failed")
+
+	tests := []struct {
+		name   string
+		info   *fakeInfo
+		status foundation.AsyncStatus
+		check  func(t *testing.T, err error)
+	}{
+		{
+			name:   "completed",
+			info:   &fakeInfo{},
+			status: foundation.AsyncStatusCompleted,
+			check: func(t *testing.T, err error) {
+				assert.NoError(t, err)
+			},
+		},
+		{
+			name:   "canceled",
+			info:   &fakeInfo{},
+			status: foundation.AsyncStatusCanceled,
+			check: func(t *testing.T, err error) {
+				assert.ErrorIs(t, err, ErrCanceled)
+			},
+		},
+		{
+			name:   "error",
+			info:   &fakeInfo{code: foundation.HResult{Value: -2147024891}}, // E_ACCESSDENIED
+			status: foundation.AsyncStatusError,
+			check: func(t *testing.T, err error) {
+				var oleErr *ole.OleError
+				if assert.ErrorAs(t, err, &oleErr) {
+					assert.Equal(t, uintptr(0x80070005), oleErr.Code())
+				}
+			},
+		},
+		{
+			name:   "error_code_failed",
+			info:   &fakeInfo{codeErr: errCode},
+			status: foundation.AsyncStatusError,
+			check: func(t *testing.T, err error) {
+				assert.ErrorIs(t, err, errCode)
+			},
+		},
+		{
+			name:   "unknown_status",
+			info:   &fakeInfo{},
+			status: foundation.AsyncStatus(42),
+			check: func(t *testing.T, err error) {
+				assert.Error(t, err)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			done := make(chan foundation.AsyncStatus, 1)
+			done <- tt.status
+
+			tt.check(t, wait(context.Background(), tt.info, done))
+			assert.Zero(t, tt.info.canceled)
+		})
+	}
+}
+
+func TestWaitContextDone(t *testing.T) {
+	tests := []struct {
+		name      string
+		cancelErr error
+	}{
+		{name: "canceled"},
+		{name: "cancel_failed", cancelErr: errors.New("Cancel failed")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+
+			info := &fakeInfo{cancelErr: tt.cancelErr}
+			err := wait(ctx, info, make(chan foundation.AsyncStatus))
+			assert.ErrorIs(t, err, context.Canceled)
+			if tt.cancelErr != nil {
+				assert.ErrorContains(t, err, tt.cancelErr.Error())
+			}
+			assert.Equal(t, 1, info.canceled)
+		})
+	}
+}
@@ -0,0 +1,91 @@
+package winrt
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-ole/go-ole"
+)
+
+// The property values are created by the Windows.Foundation.PropertyValue statics, and read using the
+// Windows.Foundation.IReference`1 interface of their type.
+const (
+	propertyValueClass        = "Windows.Foundation.PropertyValue"
+	guidIPropertyValueStatics = "629bdbc8-d932-4ff4-96b9-8d96c5c1e858"
+	guidIReference            = "61c17706-2d65-11e0-9ae8-d48564015472"
+)
+
+// Signatures of the Windows.Foundation structs that box the Go time types.
+const (
+	signatureDateTime = "struct(Windows.Foundation.DateTime;i8)"
+	signatureTimeSpan = "struct(Windows.Foundation.TimeSpan;i8)"
+)
+
+// ErrNullReference is returned by Unbox when the given object is nil, that is, a null IReference.
+var ErrNullReference = errors.New("null reference")
+
+var iidIPropertyValueStatics = ole.NewGUID(guidIPropertyValueStatics)
+
+// referenceIIDs holds the IID of the IReference`1 instantiated with each of the signatures returned by referenceSignature.
+var referenceIIDs = func() map[string]*ole.GUID {
+	iids := make(map[string]*ole.GUID)
+	for _, signature := range []string{
+		SignatureUInt8, SignatureUInt16, SignatureUInt32, SignatureUInt64,
+		SignatureInt16, SignatureInt32, SignatureInt64,
+		SignatureFloat32, SignatureFloat64, SignatureBool, SignatureString, SignatureGUID,
+		signatureDateTime, signatureTimeSpan,
+	} {
+		iids[signature] = ole.NewGUID(ParameterizedInstanceGUID(guidIReference, signature))
+	}
+	return iids
+}()
+
+// referenceSignature returns the signature of the WinRT type that holds the values of type T, e.g. "i4" for int32.
+// time.Time and time.Duration are held by a DateTime and a TimeSpan. WinRT chars (Char16) have no Go type,
+// uint16 values are held by a UInt16. PropertyValue cannot create an Int8, so int8 is not supported.
+func referenceSignature[T any]() (string, error) {
+	var value T
+	switch any(value).(type) {
+	case uint8:
+		return SignatureUInt8, nil
+	case uint16:
+		return SignatureUInt16, nil
+	case uint32:
+		return SignatureUInt32, nil
+	case uint64:
+		return SignatureUInt64, nil
+	case int16:
+		return SignatureInt16, nil
+	case int32:
+		return SignatureInt32, nil
+	case int64:
+		return SignatureInt64, nil
+	case float32:
+		return SignatureFloat32, nil
+	case float64:
+		return SignatureFloat64, nil
+	case bool:
+		return SignatureBool, nil
+	case string:
+		return SignatureString, nil
+	case ole.GUID:
+		return SignatureGUID, nil
+	case time.Time:
+		return signatureDateTime, nil
+	case time.Duration:
+		return signatureTimeSpan, nil
+	default:
+		return "", fmt.Errorf("cannot unbox a value of type %T", value)
+	}
+}
+
+// ReferenceIID returns the IID of the Windows.Foundation.IReference`1 that holds values of type T,
+// see Unbox for the supported types.
+func ReferenceIID[T any]() (*ole.GUID, error) {
+	signature, err := referenceSignature[T]()
+	if err != nil {
+		return nil, err
+	}
+	return referenceIIDs[signature], nil
+}
@@ -0,0 +1,23 @@
+//go:build !windows
+
+package winrt
+
+import "github.com/go-ole/go-ole"
+
+// Box returns a WinRT object holding the given value, created by Windows.Foundation.PropertyValue.
+// A nil value returns a nil object, that is, a null reference. The caller must release the returned object.
+func Box(value any) (*ole.IInspectable, error) {
+	if value == nil {
+		return nil, nil
+	}
+	return nil, ole.NewError(ole.E_NOTIMPL)
+}
+
+// Unbox returns the value held by the given WinRT object. A nil object (a null reference) returns ErrNullReference.
+func Unbox[T any](inspectable *ole.IInspectable) (T, error) {
+	var value T
+	if inspectable == nil {
+		return value, ErrNullReference
+	}
+	return value, ole.NewError(ole.E_NOTIMPL)
+}
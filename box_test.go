@@ -0,0 +1,50 @@
+package winrt
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-ole/go-ole"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Test the IIDs of the IReference`1 instances used to unbox the values.
+func TestReferenceIID(t *testing.T) {
+	tests := []struct {
+		name     string
+		iid      func() (*ole.GUID, error)
+		expected string
+	}{
+		{name: "int32", iid: ReferenceIID[int32], expected: "{548CEFBD-BC8A-5FA0-8DF2-957440FC8BF4}"},
+		{name: "bool", iid: ReferenceIID[bool], expected: "{3C00FD60-2950-5939-A21A-2D12C5A01B8A}"},
+		{name: "string", iid: ReferenceIID[string], expected: "{FD416DFB-2A07-52EB-AAE3-DFCE14116C05}"},
+		{name: "DateTime", iid: ReferenceIID[time.Time], expected: "{5541D8A7-497C-5AA4-86FC-7713ADBF2A2C}"},
+		{name: "TimeSpan", iid: ReferenceIID[time.Duration], expected: "{604D0C4C-91DE-5C2A-935F-362F13EAF800}"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			iid, err := tt.iid()
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, iid.String())
+		})
+	}
+}
+
+func TestReferenceIIDUnsupported(t *testing.T) {
+	_, err := ReferenceIID[int]()
+	assert.EqualError(t, err, "cannot unbox a value of type int")
+
+	// PropertyValue has no Int8
+	_, err = ReferenceIID[int8]()
+	assert.EqualError(t, err, "cannot unbox a value of type int8")
+}
+
+func TestNullReference(t *testing.T) {
+	boxed, err := Box(nil)
+	require.NoError(t, err)
+	assert.Nil(t, boxed)
+
+	_, err = Unbox[int32](boxed)
+	assert.ErrorIs(t, err, ErrNullReference)
+}
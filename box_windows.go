@@ -0,0 +1,160 @@
+//go:build windows
+
+package winrt
+
+import (
+	"fmt"
+	"syscall"
+	"time"
+	"unsafe"
+
+	"github.com/go-ole/go-ole"
+
+	"github.com/waylyrics/winrt-go/hstring"
+)
+
+// propertyValueStaticsVtbl holds the methods of IPropertyValueStatics that create the scalar values.
+type propertyValueStaticsVtbl struct {
+	ole.IInspectableVtbl
+
+	CreateEmpty       uintptr
+	CreateUInt8       uintptr
+	CreateInt16       uintptr
+	CreateUInt16      uintptr
+	CreateInt32       uintptr
+	CreateUInt32      uintptr
+	CreateInt64       uintptr
+	CreateUInt64      uintptr
+	CreateSingle      uintptr
+	CreateDouble      uintptr
+	CreateChar16      uintptr
+	CreateBoolean     uintptr
+	CreateString      uintptr
+	CreateInspectable uintptr
+	CreateGuid        uintptr
+	CreateDateTime    uintptr
+	CreateTimeSpan    uintptr
+}
+
+// referenceVtbl is the vtable of an IReference`1.
+type referenceVtbl struct {
+	ole.IInspectableVtbl
+
+	GetValue uintptr
+}
+
+// Box returns a WinRT object holding the given value, created by Windows.Foundation.PropertyValue.
+// It can be passed to the methods that take an IReference`1 (a nullable value) or an object.
+//
+// The supported types are the unsigned integers, int16, int32, int64, float32, float64, bool, string,
+// ole.GUID, time.Time (a DateTime) and time.Duration (a TimeSpan). A nil value returns a nil object,
+// that is, a null reference. The caller must release the returned object.
+func Box(value any) (*ole.IInspectable, error) {
+	if value == nil {
+		return nil, nil
+	}
+
+	factory, err := GetActivationFactory(propertyValueClass, iidIPropertyValueStatics)
+	if err != nil {
+		return nil, err
+	}
+	vtbl := (*propertyValueStaticsVtbl)(unsafe.Pointer(factory.RawVTable))
+
+	var out *ole.IInspectable
+	var hr uintptr
+	switch v := value.(type) {
+	case uint8:
+		hr, _, _ = syscall.Syscall(vtbl.CreateUInt8, 3, uintptr(unsafe.Pointer(factory)), uintptr(v), uintptr(unsafe.Pointer(&out)))
+	case int16:
+		hr, _, _ = syscall.Syscall(vtbl.CreateInt16, 3, uintptr(unsafe.Pointer(factory)), uintptr(v), uintptr(unsafe.Pointer(&out)))
+	case uint16:
+		hr, _, _ = syscall.Syscall(vtbl.CreateUInt16, 3, uintptr(unsafe.Pointer(factory)), uintptr(v), uintptr(unsafe.Pointer(&out)))
+	case int32:
+		hr, _, _ = syscall.Syscall(vtbl.CreateInt32, 3, uintptr(unsafe.Pointer(factory)), uintptr(v), uintptr(unsafe.Pointer(&out)))
+	case uint32:
+		hr, _, _ = syscall.Syscall(vtbl.CreateUInt32, 3, uintptr(unsafe.Pointer(factory)), uintptr(v), uintptr(unsafe.Pointer(&out)))
+	case int64:
+		hr, _, _ = syscall.Syscall(vtbl.CreateInt64, 3, uintptr(unsafe.Pointer(factory)), uintptr(v), uintptr(unsafe.Pointer(&out)))
+	case uint64:
+		hr, _, _ = syscall.Syscall(vtbl.CreateUInt64, 3, uintptr(unsafe.Pointer(factory)), uintptr(v), uintptr(unsafe.Pointer(&out)))
+	case float32:
+		hr, _, _ = syscall.Syscall(vtbl.CreateSingle, 3, uintptr(unsafe.Pointer(factory)), ABIValue(&v), uintptr(unsafe.Pointer(&out)))
+	case float64:
+		hr, _, _ = syscall.Syscall(vtbl.CreateDouble, 3, uintptr(unsafe.Pointer(factory)), ABIValue(&v), uintptr(unsafe.Pointer(&out)))
+	case bool:
+		hr, _, _ = syscall.Syscall(vtbl.CreateBoolean, 3, uintptr(unsafe.Pointer(factory)), uintptr(*(*byte)(unsafe.Pointer(&v))), uintptr(unsafe.Pointer(&out)))
+	case string:
+		ref, err := hstring.NewReference(v)
+		if err != nil {
+			return nil, err
+		}
+		hr, _, _ = syscall.Syscall(vtbl.CreateString, 3, uintptr(unsafe.Pointer(factory)), uintptr(ref.HString()), uintptr(unsafe.Pointer(&out)))
+		// the fast-pass HSTRING must be alive until the end of the call, the value keeps its own copy
+		ref.Release()
+	case ole.GUID:
+		// GUIDs do not fit in a register, so they are passed by reference
+		hr, _, _ = syscall.Syscall(vtbl.CreateGuid, 3, uintptr(unsafe.Pointer(factory)), uintptr(unsafe.Pointer(&v)), uintptr(unsafe.Pointer(&out)))
+	case time.Time:
+		hr, _, _ = syscall.Syscall(vtbl.CreateDateTime, 3, uintptr(unsafe.Pointer(factory)), uintptr(TicksFromTime(v)), uintptr(unsafe.Pointer(&out)))
+	case time.Duration:
+		hr, _, _ = syscall.Syscall(vtbl.CreateTimeSpan, 3, uintptr(unsafe.Pointer(factory)), uintptr(TicksFromDuration(v)), uintptr(unsafe.Pointer(&out)))
+	default:
+		return nil, fmt.Errorf("cannot box a value of type %T", value)
+	}
+
+	if hr != 0 {
+		return nil, ole.NewError(hr)
+	}
+	return out, nil
+}
+
+// Unbox returns the value held by the given WinRT object, e.g. an IReference`1 returned by a method or
+// an object created by Box. The type argument must match the type of the value, otherwise an error that
+// matches ErrInterfaceNotSupported is returned. A nil object (a null reference) returns ErrNullReference.
+//
+// The supported types are the unsigned integers, int16, int32, int64, float32, float64, bool, string,
+// ole.GUID, time.Time (a DateTime) and time.Duration (a TimeSpan), like Box. The caller still owns the
+// object and is responsible for releasing it.
+func Unbox[T any](inspectable *ole.IInspectable) (T, error) {
+	var value T
+	if inspectable == nil {
+		return value, ErrNullReference
+	}
+
+	iid, err := ReferenceIID[T]()
+	if err != nil {
+		return value, err
+	}
+	itf, err := QueryInterface(&inspectable.IUnknown, iid)
+	if err != nil {
+		return value, err
+	}
+	defer itf.Release()
+	vtbl := (*referenceVtbl)(unsafe.Pointer(itf.RawVTable))
+
+	var hr uintptr
+	switch v := any(&value).(type) {
+	case *string:
+		var hstr hstring.HString
+		hr, _, _ = syscall.Syscall(vtbl.GetValue, 2, uintptr(unsafe.Pointer(itf)), uintptr(unsafe.Pointer(&hstr)), 0)
+		*v = hstr.String()
+		_ = hstr.Delete()
+	case *time.Time:
+		var ticks int64
+		hr, _, _ = syscall.Syscall(vtbl.GetValue, 2, uintptr(unsafe.Pointer(itf)), uintptr(unsafe.Pointer(&ticks)), 0)
+		*v = TimeFromTicks(ticks)
+	case *time.Duration:
+		var ticks int64
+		hr, _, _ = syscall.Syscall(vtbl.GetValue, 2, uintptr(unsafe.Pointer(itf)), uintptr(unsafe.Pointer(&ticks)), 0)
+		*v = DurationFromTicks(ticks)
+	default:
+		// the remaining types have the same memory layout as their WinRT counterpart
+		hr, _, _ = syscall.Syscall(vtbl.GetValue, 2, uintptr(unsafe.Pointer(itf)), uintptr(unsafe.Pointer(&value)), 0)
+	}
+
+	if hr != 0 {
+		var zero T
+		return zero, ole.NewError(hr)
+	}
+	return value, nil
+}
@@ -0,0 +1,86 @@
+//go:build windows
+
+package winrt_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+	"unsafe"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/waylyrics/winrt-go"
+	"github.com/waylyrics/winrt-go/windows/foundation"
+)
+
+// boxUnbox boxes the given value, and unboxes it as a T.
+func boxUnbox[T any](t *testing.T, value T) T {
+	boxed, err := winrt.Box(value)
+	require.NoError(t, err)
+	defer boxed.Release()
+
+	got, err := winrt.Unbox[T](boxed)
+	require.NoError(t, err)
+	return got
+}
+
+func TestBox(t *testing.T) {
+	initWinRT(t)
+
+	if _, err := winrt.Box(int32(0)); err != nil {
+		t.Skipf("PropertyValue not available: %v", err)
+	}
+
+	assert.Equal(t, uint8(200), boxUnbox(t, uint8(200)))
+	assert.Equal(t, int16(-2), boxUnbox(t, int16(-2)))
+	assert.Equal(t, int32(-42), boxUnbox(t, int32(-42)))
+	assert.Equal(t, uint64(1<<63), boxUnbox(t, uint64(1<<63)))
+	assert.Equal(t, float32(1.5), boxUnbox(t, float32(1.5)))
+	assert.Equal(t, 2.25, boxUnbox(t, 2.25))
+	assert.True(t, boxUnbox(t, true))
+	assert.Equal(t, "Artist", boxUnbox(t, "Artist"))
+	assert.Equal(t, foundation.IIDIReference, boxUnbox(t, foundation.IIDIReference))
+	assert.Equal(t, 90*time.Second, boxUnbox(t, 90*time.Second))
+
+	now := time.Now().UTC().Truncate(100 * time.Nanosecond)
+	assert.True(t, now.Equal(boxUnbox(t, now)))
+}
+
+func TestUnboxWrongType(t *testing.T) {
+	initWinRT(t)
+
+	boxed, err := winrt.Box(int32(42))
+	if err != nil {
+		t.Skipf("PropertyValue not available: %v", err)
+	}
+	defer boxed.Release()
+
+	_, err = winrt.Unbox[string](boxed)
+	assert.True(t, errors.Is(err, winrt.ErrInterfaceNotSupported), err)
+}
+
+// Test that the boxed values can be read using the generated IPropertyValue.
+func TestBoxPropertyValue(t *testing.T) {
+	initWinRT(t)
+
+	boxed, err := winrt.Box(1.5)
+	if err != nil {
+		t.Skipf("PropertyValue not available: %v", err)
+	}
+	defer boxed.Release()
+
+	itf, err := winrt.QueryInterface(&boxed.IUnknown, &foundation.IIDIPropertyValue)
+	require.NoError(t, err)
+	defer itf.Release()
+	value := (*foundation.IPropertyValue)(unsafe.Pointer(itf))
+
+	propertyType, err := value.GetType()
+	require.NoError(t, err)
+	assert.Equal(t, foundation.PropertyTypeDouble, propertyType)
+
+	got, err := value.GetDouble()
+	require.NoError(t, err)
+	assert.Equal(t, 1.5, got)
+}
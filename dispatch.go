@@ -0,0 +1,107 @@
+package winrt
+
+import (
+	"sync"
+
+	"github.com/go-ole/go-ole"
+)
+
+// DispatchMode defines where the callbacks of a delegate run.
+type DispatchMode int
+
+const (
+	// DispatchInline runs the callback on the thread that invoked the delegate, blocking the caller until it returns.
+	DispatchInline DispatchMode = iota
+	// DispatchGoroutine runs each callback on a new goroutine.
+	DispatchGoroutine
+	// DispatchQueue runs the callbacks one at a time, in the order the delegate was invoked.
+	DispatchQueue
+)
+
+// These functions are replaced by the tests, which use fake objects.
+var (
+	addRefObject  = (*ole.IUnknown).AddRef
+	releaseObject = (*ole.IUnknown).Release
+)
+
+// Dispatcher runs the callbacks of the delegates created with New<Delegate>WithDispatcher.
+//
+// WinRT invokes the delegates from its own threads, so a callback that blocks also blocks the event
+// source. A Dispatcher using the DispatchGoroutine or DispatchQueue modes returns to WinRT right away,
+// and holds a reference to the delegate and its object arguments until the callback returns.
+//
+// The queue of a Dispatcher is shared by the delegates using it: create a Dispatcher for each delegate
+// to serialize its callbacks only.
+type Dispatcher struct {
+	mode DispatchMode
+
+	mu      sync.Mutex
+	pending []func()
+	running bool
+}
+
+// NewDispatcher returns a Dispatcher that runs the callbacks using the given mode.
+func NewDispatcher(mode DispatchMode) *Dispatcher {
+	return &Dispatcher{mode: mode}
+}
+
+// Dispatch runs the callback. Unless the callback runs inline, the given objects are kept alive (using AddRef)
+// until it returns. Nil objects are ignored.
+func (d *Dispatcher) Dispatch(callback func(), objects ...*ole.IUnknown) {
+	if d.mode == DispatchInline {
+		callback()
+		return
+	}
+
+	for _, obj := range objects {
+		if obj != nil {
+			addRefObject(obj)
+		}
+	}
+	run := func() {
+		defer func() {
+			for _, obj := range objects {
+				if obj != nil {
+					releaseObject(obj)
+				}
+			}
+		}()
+		callback()
+	}
+
+	if d.mode == DispatchGoroutine {
+		go run()
+		return
+	}
+	d.enqueue(run)
+}
+
+// enqueue adds the callback to the queue, and starts a goroutine running the queue if there is none.
+// The goroutine stops once the queue is empty, so an idle Dispatcher does not need to be closed.
+func (d *Dispatcher) enqueue(callback func()) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.pending = append(d.pending, callback)
+	if !d.running {
+		d.running = true
+		go d.run()
+	}
+}
+
+func (d *Dispatcher) run() {
+	for {
+		d.mu.Lock()
+		if len(d.pending) == 0 {
+			d.running = false
+			d.mu.Unlock()
+			return
+		}
+		callback := d.pending[0]
+		d.pending[0] = nil // release the closure
+		d.pending = d.pending[1:]
+		d.mu.Unlock()
+
+		callback()
+	}
+}
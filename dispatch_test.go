@@ -0,0 +1,112 @@
+package winrt
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-ole/go-ole"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeRefs replaces the reference counting functions with counters of the fake objects.
+func fakeRefs(t *testing.T) func(obj *ole.IUnknown) int32 {
+	var mu sync.Mutex
+	refs := make(map[*ole.IUnknown]int32)
+
+	addRefObject = func(obj *ole.IUnknown) int32 {
+		mu.Lock()
+		defer mu.Unlock()
+		refs[obj]++
+		return refs[obj]
+	}
+	releaseObject = func(obj *ole.IUnknown) int32 {
+		mu.Lock()
+		defer mu.Unlock()
+		refs[obj]--
+		return refs[obj]
+	}
+	t.Cleanup(func() {
+		addRefObject = (*ole.IUnknown).AddRef
+		releaseObject = (*ole.IUnknown).Release
+	})
+
+	return func(obj *ole.IUnknown) int32 {
+		mu.Lock()
+		defer mu.Unlock()
+		return refs[obj]
+	}
+}
+
+func TestDispatcherInline(t *testing.T) {
+	refs := fakeRefs(t)
+	obj := &ole.IUnknown{}
+
+	called := false
+	NewDispatcher(DispatchInline).Dispatch(func() {
+		called = true
+		assert.EqualValues(t, 0, refs(obj), "inline callbacks use the reference of the caller")
+	}, obj)
+	assert.True(t, called)
+}
+
+func TestDispatcherGoroutine(t *testing.T) {
+	refs := fakeRefs(t)
+	obj := &ole.IUnknown{}
+
+	unblock := make(chan struct{})
+	done := make(chan struct{})
+	NewDispatcher(DispatchGoroutine).Dispatch(func() {
+		<-unblock
+		close(done)
+	}, obj, nil)
+
+	// the caller is not blocked, and the object is kept alive until the callback returns
+	assert.EqualValues(t, 1, refs(obj))
+	close(unblock)
+	<-done
+	require.Eventually(t, func() bool {
+		return refs(obj) == 0
+	}, time.Second, time.Millisecond)
+}
+
+func TestDispatcherQueue(t *testing.T) {
+	refs := fakeRefs(t)
+	objects := make([]ole.IUnknown, 10)
+
+	d := NewDispatcher(DispatchQueue)
+	unblock := make(chan struct{})
+	var mu sync.Mutex
+	var order []int
+	var wg sync.WaitGroup
+	for i := range objects {
+		i := i
+		wg.Add(1)
+		d.Dispatch(func() {
+			defer wg.Done()
+			<-unblock
+			mu.Lock()
+			defer mu.Unlock()
+			order = append(order, i)
+		}, &objects[i])
+	}
+
+	for i := range objects {
+		assert.EqualValues(t, 1, refs(&objects[i]))
+	}
+	close(unblock)
+	wg.Wait()
+	assert.Equal(t, []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}, order, "the callbacks run in order")
+
+	// the queue goroutine stops when the queue is empty, and is started again by the next callback
+	require.Eventually(t, func() bool {
+		d.mu.Lock()
+		defer d.mu.Unlock()
+		return !d.running && refs(&objects[9]) == 0
+	}, time.Second, time.Millisecond)
+
+	done := make(chan struct{})
+	d.Dispatch(func() { close(done) })
+	<-done
+}
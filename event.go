@@ -0,0 +1,28 @@
+package winrt
+
+import "sync"
+
+// EventToken is the registration of an event handler, returned by the On<Event> methods
+// generated with the Go-style accessors.
+type EventToken struct {
+	// Value is the token returned by WinRT when the handler was added.
+	Value int64
+
+	once   sync.Once
+	remove func(value int64) error
+	err    error
+}
+
+// NewEventToken returns the EventToken of a handler registration. The given function is used to remove the handler.
+func NewEventToken(value int64, remove func(value int64) error) *EventToken {
+	return &EventToken{Value: value, remove: remove}
+}
+
+// Remove removes the event handler. Only the first call removes it, the following ones return the same result.
+func (t *EventToken) Remove() error {
+	t.once.Do(func() {
+		t.err = t.remove(t.Value)
+		t.remove = nil // release the references held by the function
+	})
+	return t.err
+}
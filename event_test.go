@@ -0,0 +1,29 @@
+package winrt
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEventToken(t *testing.T) {
+	var removed []int64
+	token := NewEventToken(42, func(value int64) error {
+		removed = append(removed, value)
+		return nil
+	})
+
+	assert.NoError(t, token.Remove())
+	assert.NoError(t, token.Remove())
+	assert.Equal(t, []int64{42}, removed)
+}
+
+func TestEventTokenError(t *testing.T) {
+	errRemove := errors.New("remove failed")
+	token := NewEventToken(1, func(int64) error { return errRemove })
+
+	assert.ErrorIs(t, token.Remove(), errRemove)
+	// the error is kept, the handler is not removed twice
+	assert.ErrorIs(t, token.Remove(), errRemove)
+}
@@ -0,0 +1,195 @@
+// Package events delivers the WinRT events to Go channels.
+//
+// WinRT calls the event handlers synchronously, on a thread owned by the event source. The Chan function
+// registers a handler that forwards the events to a buffered channel instead, so they can be received
+// in a select loop. The handler is removed when the given context is done.
+package events
+
+import (
+	"context"
+	"reflect"
+	"sync"
+
+	"github.com/waylyrics/winrt-go"
+)
+
+// defaultBufferSize is the size of the channel buffer when WithBufferSize is not used.
+const defaultBufferSize = 16
+
+// Object is a reference counted WinRT object, like the event args of a WinRT event.
+// All the generated classes and interfaces implement it.
+type Object interface {
+	AddRef() int32
+	Release() int32
+}
+
+// Overflow is the policy used when an event is received and the channel buffer is full.
+type Overflow int
+
+const (
+	// Block blocks the event source until the consumer receives an event, or the context is done.
+	Block Overflow = iota
+	// DropOldest drops the oldest event in the buffer to make room for the new one.
+	DropOldest
+	// DropNewest drops the new event.
+	DropNewest
+)
+
+// Event is an event received from a WinRT event source.
+type Event[TSender any, TArgs Object] struct {
+	// Sender is the event source. It is kept alive by the subscription, so it is only valid until the context is done.
+	Sender TSender
+	// Args holds a reference to the event args, which is released by Release. It is nil if the event source
+	// raised the event without args.
+	Args TArgs
+}
+
+// Release releases the event args. It must be called once the consumer is done with the event.
+func (e Event[TSender, TArgs]) Release() {
+	if !isNil(e.Args) {
+		e.Args.Release()
+	}
+}
+
+// isNil returns true if the given event args are nil. The typed handlers pass the null args as nil pointers,
+// which are not equal to a nil interface.
+func isNil[TArgs Object](args TArgs) bool {
+	if any(args) == nil {
+		return true
+	}
+	v := reflect.ValueOf(args)
+	return v.Kind() == reflect.Pointer && v.IsNil()
+}
+
+// Option configures the channel returned by Chan.
+type Option func(*options)
+
+type options struct {
+	bufferSize int
+	overflow   Overflow
+}
+
+// WithBufferSize sets the size of the channel buffer. The default size is 16.
+func WithBufferSize(size int) Option {
+	return func(o *options) {
+		o.bufferSize = size
+	}
+}
+
+// WithOverflow sets the policy used when the channel buffer is full. The default policy is Block.
+func WithOverflow(overflow Overflow) Option {
+	return func(o *options) {
+		o.overflow = overflow
+	}
+}
+
+// Chan subscribes to a WinRT event and sends the received events to the returned channel.
+// The subscribe function is the typed subscription method of the event, e.g.:
+//
+//	ch, err := events.Chan(ctx, controls.SubscribeButtonPressed, events.WithOverflow(events.DropOldest))
+//
+// The consumer must call the Release method of each received event. When the context is done the handler
+// is removed, the events still in the buffer are released, and the channel is closed.
+func Chan[TSender any, TArgs Object](
+	ctx context.Context,
+	subscribe func(handler func(sender TSender, args TArgs)) (*winrt.EventToken, error),
+	opts ...Option,
+) (<-chan Event[TSender, TArgs], error) {
+	o := options{bufferSize: defaultBufferSize, overflow: Block}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	q := &queue[TSender, TArgs]{
+		ch:       make(chan Event[TSender, TArgs], o.bufferSize),
+		overflow: o.overflow,
+		done:     ctx.Done(),
+	}
+
+	token, err := subscribe(q.push)
+	if err != nil {
+		return nil, err
+	}
+
+	go func() {
+		<-ctx.Done()
+		// the handler may still be running, so the channel is closed by the queue
+		_ = token.Remove()
+		q.close()
+	}()
+
+	return q.ch, nil
+}
+
+// queue sends the events to the channel, applying the overflow policy.
+type queue[TSender any, TArgs Object] struct {
+	// mu prevents closing the channel while an event is being sent.
+	mu     sync.Mutex
+	closed bool
+
+	ch       chan Event[TSender, TArgs]
+	overflow Overflow
+	done     <-chan struct{}
+}
+
+// push is the event handler. The args are only valid while the handler runs, so
+// a reference is kept until the consumer releases the event.
+func (q *queue[TSender, TArgs]) push(sender TSender, args TArgs) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.closed {
+		return
+	}
+
+	if !isNil(args) {
+		args.AddRef()
+	}
+	e := Event[TSender, TArgs]{Sender: sender, Args: args}
+
+	switch q.overflow {
+	case DropNewest:
+		select {
+		case q.ch <- e:
+		default:
+			e.Release()
+		}
+	case DropOldest:
+		for {
+			select {
+			case q.ch <- e:
+				return
+			default:
+			}
+
+			// the consumer may receive the oldest event in the meantime
+			select {
+			case old := <-q.ch:
+				old.Release()
+			default:
+			}
+		}
+	default:
+		select {
+		case q.ch <- e:
+		case <-q.done:
+			e.Release()
+		}
+	}
+}
+
+// close releases the events that were not received, and closes the channel.
+func (q *queue[TSender, TArgs]) close() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	q.closed = true
+	for {
+		select {
+		case e := <-q.ch:
+			e.Release()
+		default:
+			close(q.ch)
+			return
+		}
+	}
+}
@@ -0,0 +1,197 @@
+package events
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/waylyrics/winrt-go"
+)
+
+// fakeArgs counts its references, the event source holds the first one.
+type fakeArgs struct {
+	id   int
+	refs int32
+}
+
+func newFakeArgs(id int) *fakeArgs {
+	return &fakeArgs{id: id, refs: 1}
+}
+
+func (a *fakeArgs) AddRef() int32  { return atomic.AddInt32(&a.refs, 1) }
+func (a *fakeArgs) Release() int32 { return atomic.AddInt32(&a.refs, -1) }
+
+// fakeSource is an event source that calls its handler synchronously.
+type fakeSource struct {
+	handler func(sender string, args *fakeArgs)
+	removed int32
+}
+
+func (s *fakeSource) subscribe(handler func(sender string, args *fakeArgs)) (*winrt.EventToken, error) {
+	s.handler = handler
+	return winrt.NewEventToken(1, func(int64) error {
+		atomic.StoreInt32(&s.removed, 1)
+		return nil
+	}), nil
+}
+
+// fire raises an event and releases the reference of the source, like WinRT does after calling the handlers.
+func (s *fakeSource) fire(id int) *fakeArgs {
+	args := newFakeArgs(id)
+	s.handler("source", args)
+	args.Release()
+	return args
+}
+
+func receive(t *testing.T, ch <-chan Event[string, *fakeArgs]) Event[string, *fakeArgs] {
+	t.Helper()
+	select {
+	case e := <-ch:
+		return e
+	case <-time.After(time.Second):
+		require.FailNow(t, "event not received")
+		return Event[string, *fakeArgs]{}
+	}
+}
+
+func TestChan(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	src := &fakeSource{}
+	ch, err := Chan(ctx, src.subscribe)
+	require.NoError(t, err)
+
+	args := src.fire(1)
+	// the reference is kept until the consumer releases the event
+	assert.EqualValues(t, 1, atomic.LoadInt32(&args.refs))
+
+	e := receive(t, ch)
+	assert.Equal(t, "source", e.Sender)
+	assert.Equal(t, 1, e.Args.id)
+	e.Release()
+	assert.EqualValues(t, 0, atomic.LoadInt32(&args.refs))
+}
+
+func TestChanNilArgs(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	src := &fakeSource{}
+	ch, err := Chan(ctx, src.subscribe, WithBufferSize(1), WithOverflow(DropNewest))
+	require.NoError(t, err)
+
+	// the event source may raise events without args, the second one is dropped
+	src.handler("source", nil)
+	src.handler("source", nil)
+
+	e := receive(t, ch)
+	assert.Nil(t, e.Args)
+	assert.NotPanics(t, e.Release)
+}
+
+func TestChanDropNewest(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	src := &fakeSource{}
+	ch, err := Chan(ctx, src.subscribe, WithBufferSize(1), WithOverflow(DropNewest))
+	require.NoError(t, err)
+
+	src.fire(1)
+	dropped := src.fire(2)
+	assert.EqualValues(t, 0, atomic.LoadInt32(&dropped.refs))
+
+	e := receive(t, ch)
+	assert.Equal(t, 1, e.Args.id)
+	e.Release()
+}
+
+func TestChanDropOldest(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	src := &fakeSource{}
+	ch, err := Chan(ctx, src.subscribe, WithBufferSize(1), WithOverflow(DropOldest))
+	require.NoError(t, err)
+
+	dropped := src.fire(1)
+	src.fire(2)
+	assert.EqualValues(t, 0, atomic.LoadInt32(&dropped.refs))
+
+	e := receive(t, ch)
+	assert.Equal(t, 2, e.Args.id)
+	e.Release()
+}
+
+func TestChanBlock(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	src := &fakeSource{}
+	ch, err := Chan(ctx, src.subscribe, WithBufferSize(1), WithOverflow(Block))
+	require.NoError(t, err)
+
+	src.fire(1)
+	fired := make(chan struct{})
+	go func() {
+		src.fire(2)
+		close(fired)
+	}()
+
+	select {
+	case <-fired:
+		require.FailNow(t, "the source was not blocked")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	e := receive(t, ch)
+	assert.Equal(t, 1, e.Args.id)
+	e.Release()
+	<-fired
+
+	e = receive(t, ch)
+	assert.Equal(t, 2, e.Args.id)
+	e.Release()
+}
+
+func TestChanCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	src := &fakeSource{}
+	ch, err := Chan(ctx, src.subscribe, WithBufferSize(1), WithOverflow(Block))
+	require.NoError(t, err)
+
+	pending := src.fire(1)
+	blocked := make(chan *fakeArgs)
+	go func() {
+		blocked <- src.fire(2)
+	}()
+
+	cancel()
+	// the blocked source is released, and the pending events are released
+	unsent := <-blocked
+	require.Eventually(t, func() bool {
+		return atomic.LoadInt32(&pending.refs) == 0
+	}, time.Second, time.Millisecond)
+	_, ok := <-ch
+	assert.False(t, ok)
+	assert.EqualValues(t, 1, atomic.LoadInt32(&src.removed))
+	assert.EqualValues(t, 0, atomic.LoadInt32(&unsent.refs))
+
+	// the events received after the handler was removed are ignored
+	late := src.fire(3)
+	assert.EqualValues(t, 0, atomic.LoadInt32(&late.refs))
+}
+
+func TestChanSubscribeError(t *testing.T) {
+	failing := func(func(string, *fakeArgs)) (*winrt.EventToken, error) {
+		return nil, assert.AnError
+	}
+	_, err := Chan(context.Background(), failing)
+	assert.ErrorIs(t, err, assert.AnError)
+}
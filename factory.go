@@ -0,0 +1,94 @@
+package winrt
+
+import (
+	"sync"
+	"unsafe"
+
+	"github.com/go-ole/go-ole"
+)
+
+// factories holds the activation factories used by the static methods and constructors of the generated classes.
+var factories = newFactoryCache(func(class string, iid *ole.GUID) (*ole.IUnknown, error) {
+	inspectable, err := ole.RoGetActivationFactory(class, iid)
+	if err != nil {
+		return nil, err
+	}
+	return (*ole.IUnknown)(unsafe.Pointer(inspectable)), nil
+})
+
+type factoryKey struct {
+	class string
+	iid   ole.GUID
+}
+
+// factoryCache keeps the activation factories of the runtime classes, so each of them is only looked up once.
+type factoryCache struct {
+	mu        sync.RWMutex
+	get       func(class string, iid *ole.GUID) (*ole.IUnknown, error)
+	factories map[factoryKey]*ole.IUnknown
+}
+
+func newFactoryCache(get func(class string, iid *ole.GUID) (*ole.IUnknown, error)) *factoryCache {
+	return &factoryCache{
+		get:       get,
+		factories: make(map[factoryKey]*ole.IUnknown),
+	}
+}
+
+func (c *factoryCache) cached(key factoryKey) (*ole.IUnknown, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	factory, ok := c.factories[key]
+	return factory, ok
+}
+
+func (c *factoryCache) activationFactory(class string, iid *ole.GUID) (*ole.IUnknown, error) {
+	key := factoryKey{class: class, iid: *iid}
+	if factory, ok := c.cached(key); ok {
+		return factory, nil
+	}
+
+	factory, err := c.get(class, iid)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if cached, ok := c.factories[key]; ok {
+		// another goroutine got the same factory in the meantime
+		factory.Release()
+		return cached, nil
+	}
+	c.factories[key] = factory
+	return factory, nil
+}
+
+func (c *factoryCache) release() {
+	c.mu.Lock()
+	cached := c.factories
+	c.factories = make(map[factoryKey]*ole.IUnknown)
+	c.mu.Unlock()
+
+	for _, factory := range cached {
+		factory.Release()
+	}
+}
+
+// GetActivationFactory returns the activation factory interface with the given IID of a runtime class, like
+// ole.RoGetActivationFactory. The factory is cached and reused by the following calls with the same class
+// and IID, so the caller must not release it.
+//
+// The static methods and the constructors of the generated classes use it to avoid looking up the factory on every call.
+func GetActivationFactory(class string, iid *ole.GUID) (*ole.IUnknown, error) {
+	return factories.activationFactory(class, iid)
+}
+
+// ReleaseFactories releases the activation factories cached by GetActivationFactory, e.g. before calling
+// ole.CoUninitialize. It must not be called while static methods are running, the factories are looked up
+// again by the following calls.
+func ReleaseFactories() {
+	factories.release()
+}
@@ -0,0 +1,64 @@
+package winrt
+
+import (
+	"testing"
+
+	"github.com/go-ole/go-ole"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFactoryCache(t *testing.T) {
+	// fake factories, the cache never dereferences them outside Release, which is a no-op on non-windows
+	objects := make([]ole.IUnknown, 3)
+	statics, activation, other := &objects[0], &objects[1], &objects[2]
+
+	iidStatics := ole.NewGUID("99fa3ff4-1742-42a6-902e-087d41f965ec")
+	iidActivation := ole.NewGUID("ea98d2f6-7f3c-4af2-a586-72889808efb1")
+
+	lookups := 0
+	cache := newFactoryCache(func(class string, iid *ole.GUID) (*ole.IUnknown, error) {
+		lookups++
+		switch {
+		case class == "Test.Class" && ole.IsEqualGUID(iid, iidStatics):
+			return statics, nil
+		case class == "Test.Class" && ole.IsEqualGUID(iid, iidActivation):
+			return activation, nil
+		case class == "Test.Other" && ole.IsEqualGUID(iid, iidStatics):
+			return other, nil
+		default:
+			return nil, ole.NewError(ole.E_NOINTERFACE)
+		}
+	})
+
+	for i := 0; i < 3; i++ {
+		factory, err := cache.activationFactory("Test.Class", ole.NewGUID(iidStatics.String()))
+		require.NoError(t, err)
+		assert.Same(t, statics, factory)
+	}
+	assert.Equal(t, 1, lookups, "the factory is only looked up once")
+
+	// the factories are keyed by class and IID
+	factory, err := cache.activationFactory("Test.Class", iidActivation)
+	require.NoError(t, err)
+	assert.Same(t, activation, factory)
+	factory, err = cache.activationFactory("Test.Other", iidStatics)
+	require.NoError(t, err)
+	assert.Same(t, other, factory)
+	assert.Equal(t, 3, lookups)
+
+	// errors are not cached
+	for i := 0; i < 2; i++ {
+		_, err = cache.activationFactory("Test.Other", iidActivation)
+		assert.Error(t, err)
+	}
+	assert.Equal(t, 5, lookups)
+	assert.Len(t, cache.factories, 3)
+
+	cache.release()
+	assert.Empty(t, cache.factories)
+
+	_, err = cache.activationFactory("Test.Class", iidStatics)
+	require.NoError(t, err)
+	assert.Equal(t, 6, lookups, "released factories are looked up again")
+}
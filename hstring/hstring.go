@@ -0,0 +1,79 @@
+// Package hstring manages the lifecycle of the WinRT strings (HSTRING).
+//
+// There are two kinds of HSTRINGs:
+//   - Regular HSTRINGs are reference counted by WinRT. They are created using NewHString, and
+//     must be released using Delete. WinRT methods return regular HSTRINGs, and their ownership
+//     is transferred to the caller.
+//   - Fast-pass (or reference) HSTRINGs are backed by memory owned by the caller, so they do not
+//     allocate nor need to be released. They are created using NewReference, and are only valid
+//     while the Reference is reachable. They should be used for the input parameters of methods.
+//     The memory of the released References is reused, so the calls that release them do not allocate.
+package hstring
+
+import (
+	"sync"
+	"unicode/utf16"
+)
+
+// maxPooledBuffer is the capacity of the largest buffer kept by the released References.
+const maxPooledBuffer = 4096
+
+// HString is a handle to a WinRT string. The zero value is a valid handle for the empty string.
+type HString uintptr
+
+// header has the size of an HSTRING_HEADER, which is 24 bytes in 64 bit systems and 20 bytes in 32 bit ones.
+type header struct {
+	_ [3]uint64
+}
+
+// Reference is a fast-pass HSTRING. It must be kept alive while the HSTRING is being used,
+// e.g. by releasing it after a method call.
+type Reference struct {
+	header header
+	buf    []uint16
+	hstr   HString
+}
+
+// references holds the released References, to reuse their memory.
+var references = sync.Pool{
+	New: func() interface{} {
+		return &Reference{}
+	},
+}
+
+// newReference returns an empty Reference, reusing a released one if possible.
+func newReference() *Reference {
+	return references.Get().(*Reference)
+}
+
+// HString returns the handle of the fast-pass HSTRING.
+func (r *Reference) HString() HString {
+	return r.hstr
+}
+
+// Release returns the Reference to a pool, so its memory is reused by the following calls to NewReference.
+// Releasing a Reference is optional, but neither the Reference nor its HSTRING can be used after releasing it.
+func (r *Reference) Release() {
+	if cap(r.buf) > maxPooledBuffer {
+		return
+	}
+
+	r.header = header{}
+	r.hstr = 0
+	r.buf = r.buf[:0]
+	references.Put(r)
+}
+
+// appendUTF16 appends the UTF-16 encoding of the string to the buffer, like utf16.Encode
+// but without allocating when the buffer is large enough.
+func appendUTF16(buf []uint16, s string) []uint16 {
+	for _, c := range s {
+		if c < 0x10000 {
+			buf = append(buf, uint16(c))
+			continue
+		}
+		r1, r2 := utf16.EncodeRune(c)
+		buf = append(buf, uint16(r1), uint16(r2))
+	}
+	return buf
+}
@@ -0,0 +1,33 @@
+//go:build !windows
+
+package hstring
+
+import "github.com/go-ole/go-ole"
+
+// NewHString creates a new HSTRING with the contents of the given string.
+// The returned HSTRING must be released using Delete.
+func NewHString(s string) (HString, error) {
+	if s == "" {
+		return 0, nil
+	}
+	return 0, ole.NewError(ole.E_NOTIMPL)
+}
+
+// NewReference creates a fast-pass HSTRING with the contents of the given string.
+// The returned Reference does not need to be released, but releasing it allows reusing its memory.
+func NewReference(s string) (*Reference, error) {
+	if s == "" {
+		return newReference(), nil
+	}
+	return nil, ole.NewError(ole.E_NOTIMPL)
+}
+
+// String returns the contents of the HSTRING.
+func (h HString) String() string {
+	return ""
+}
+
+// Delete releases the HSTRING. Deleting the empty string is a no-op.
+func (h HString) Delete() error {
+	return nil
+}
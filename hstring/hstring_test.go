@@ -0,0 +1,35 @@
+package hstring
+
+import (
+	"testing"
+	"unicode/utf16"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAppendUTF16(t *testing.T) {
+	for _, s := range []string{"", "Play", "çà et là", "🎵 music", "invalid \xff utf-8"} {
+		assert.Equal(t, utf16.Encode([]rune(s)), appendUTF16([]uint16{}, s), s)
+	}
+
+	buf := make([]uint16, 0, 16)
+	allocs := testing.AllocsPerRun(100, func() {
+		buf = appendUTF16(buf[:0], "🎵 music")
+	})
+	assert.Zero(t, allocs)
+}
+
+func TestReferenceRelease(t *testing.T) {
+	r, err := NewReference("")
+	assert.NoError(t, err)
+	r.buf = append(r.buf, 'a')
+	r.hstr = 1
+	r.Release()
+	assert.Empty(t, r.buf)
+	assert.Zero(t, r.hstr)
+
+	// large buffers are not kept
+	r = &Reference{buf: make([]uint16, 0, maxPooledBuffer+1)}
+	r.Release()
+	assert.Equal(t, maxPooledBuffer+1, cap(r.buf))
+}
@@ -0,0 +1,103 @@
+//go:build windows
+
+package hstring
+
+import (
+	"syscall"
+	"unicode/utf16"
+	"unsafe"
+
+	"github.com/go-ole/go-ole"
+	"golang.org/x/sys/windows"
+)
+
+var (
+	libCombase = windows.NewLazySystemDLL("combase.dll")
+
+	procWindowsCreateString          = libCombase.NewProc("WindowsCreateString")
+	procWindowsCreateStringReference = libCombase.NewProc("WindowsCreateStringReference")
+	procWindowsDeleteString          = libCombase.NewProc("WindowsDeleteString")
+	procWindowsGetStringRawBuffer    = libCombase.NewProc("WindowsGetStringRawBuffer")
+)
+
+// NewHString creates a new HSTRING with the contents of the given string.
+// The returned HSTRING must be released using Delete.
+func NewHString(s string) (HString, error) {
+	if s == "" {
+		return 0, nil
+	}
+
+	u16 := utf16.Encode([]rune(s))
+	var h HString
+	// https://learn.microsoft.com/en-us/windows/win32/api/winstring/nf-winstring-windowscreatestring
+	hr, _, _ := syscall.SyscallN(
+		procWindowsCreateString.Addr(),
+		uintptr(unsafe.Pointer(&u16[0])),
+		uintptr(len(u16)),
+		uintptr(unsafe.Pointer(&h)),
+	)
+	if hr != 0 {
+		return 0, ole.NewError(hr)
+	}
+	return h, nil
+}
+
+// NewReference creates a fast-pass HSTRING with the contents of the given string.
+// The returned Reference does not need to be released, but releasing it allows reusing its memory.
+func NewReference(s string) (*Reference, error) {
+	r := newReference()
+	if s == "" {
+		return r, nil
+	}
+
+	// the buffer of fast-pass strings must be null terminated
+	r.buf = append(appendUTF16(r.buf[:0], s), 0)
+	// https://learn.microsoft.com/en-us/windows/win32/api/winstring/nf-winstring-windowscreatestringreference
+	hr, _, _ := syscall.SyscallN(
+		procWindowsCreateStringReference.Addr(),
+		uintptr(unsafe.Pointer(&r.buf[0])),
+		uintptr(len(r.buf)-1),
+		uintptr(unsafe.Pointer(&r.header)),
+		uintptr(unsafe.Pointer(&r.hstr)),
+	)
+	if hr != 0 {
+		return nil, ole.NewError(hr)
+	}
+	return r, nil
+}
+
+// String returns the contents of the HSTRING.
+func (h HString) String() string {
+	if h == 0 {
+		return ""
+	}
+
+	var length uint32
+	// https://learn.microsoft.com/en-us/windows/win32/api/winstring/nf-winstring-windowsgetstringrawbuffer
+	ret, _, _ := syscall.SyscallN(
+		procWindowsGetStringRawBuffer.Addr(),
+		uintptr(h),
+		uintptr(unsafe.Pointer(&length)),
+	)
+	if ret == 0 || length == 0 {
+		return ""
+	}
+
+	// The buffer is owned by the HSTRING, and it is copied before returning.
+	buf := *(*unsafe.Pointer)(unsafe.Pointer(&ret))
+	return string(utf16.Decode(unsafe.Slice((*uint16)(buf), length)))
+}
+
+// Delete releases the HSTRING. Deleting the empty string is a no-op.
+func (h HString) Delete() error {
+	if h == 0 {
+		return nil
+	}
+
+	// https://learn.microsoft.com/en-us/windows/win32/api/winstring/nf-winstring-windowsdeletestring
+	hr, _, _ := syscall.SyscallN(procWindowsDeleteString.Addr(), uintptr(h))
+	if hr != 0 {
+		return ole.NewError(hr)
+	}
+	return nil
+}
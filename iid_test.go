@@ -0,0 +1,58 @@
+//go:build windows
+
+package winrt_test
+
+import (
+	"testing"
+
+	"github.com/go-ole/go-ole"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/waylyrics/winrt-go"
+	"github.com/waylyrics/winrt-go/windows/foundation"
+	"github.com/waylyrics/winrt-go/windows/media"
+)
+
+const smtcClass = "Windows.Media.SystemMediaTransportControls"
+
+func TestPrecomputedIIDs(t *testing.T) {
+	tests := []struct {
+		guid string
+		iid  *ole.GUID
+	}{
+		{media.GUIDiSystemMediaTransportControls, &media.IIDiSystemMediaTransportControls},
+		{media.GUIDiSystemMediaTransportControlsStatics, &media.IIDiSystemMediaTransportControlsStatics},
+		{foundation.GUIDIAsyncInfo, &foundation.IIDIAsyncInfo},
+		{foundation.GUIDTypedEventHandler, &foundation.IIDTypedEventHandler},
+	}
+	for _, tt := range tests {
+		assert.True(t, ole.IsEqualGUID(ole.NewGUID(tt.guid), tt.iid), tt.guid)
+	}
+}
+
+// activationFactory initializes WinRT, and skips the test if the activation factory of the
+// SystemMediaTransportControls is not available.
+func activationFactory(tb testing.TB) {
+	initWinRT(tb)
+
+	if _, err := winrt.GetActivationFactory(smtcClass, &media.IIDiSystemMediaTransportControlsStatics); err != nil {
+		tb.Skipf("activation factory not available: %v", err)
+	}
+}
+
+func BenchmarkGetActivationFactory(b *testing.B) {
+	activationFactory(b)
+
+	b.Run("parsed IID", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_, _ = winrt.GetActivationFactory(smtcClass, ole.NewGUID(media.GUIDiSystemMediaTransportControlsStatics))
+		}
+	})
+	b.Run("precomputed IID", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_, _ = winrt.GetActivationFactory(smtcClass, &media.IIDiSystemMediaTransportControlsStatics)
+		}
+	})
+}
@@ -10,6 +10,40 @@ import (
 	"github.com/waylyrics/winrt-go/internal/codegen"
 )
 
+const classUsage = `The class to generate. This should include the namespace and the class name, e.g. 'System.Runtime.InteropServices.WindowsRuntime.EventRegistrationToken'.
+This option can be set several times to generate multiple classes in a single run.`
+
+const manifestUsage = `A file listing the classes to generate, one per line. Each class name may be followed by the method filters
+that only apply to that class, separated by spaces. Lines starting with '#' are ignored. For example:
+    Windows.Media.SystemMediaTransportControlsDisplayUpdater !CopyFromFileAsync !get_Thumbnail`
+
+const withDepsUsage = `Also generates all the types referenced by the generated classes, and the types referenced by those, until
+the whole dependency tree is generated. Types that already exist on disk or that match a '-deny' filter are skipped.`
+
+const noInterfaceCacheUsage = `Disables the interface cache of the generated runtime classes. By default, the interfaces queried by the
+methods of a runtime class are cached by the class until its Release method is called. With this option every method
+call queries and releases the interface it forwards to, and the generated classes embed ole.IUnknown instead of a
+winrt.Object, so they are created by casting the WinRT object.`
+
+const goAccessorsUsage = `Generates the methods of properties and events with Go-style names. Property getters are named after the
+property (e.g. 'PlaybackStatus()' instead of 'GetPlaybackStatus()'), setters keep the 'Set' prefix, and the handlers
+of an event are added with 'On<Event>(handler)', which returns a token whose Remove method removes the handler.
+The token can also be passed to 'Off<Event>(token)'. Method filters still use the metadata names, e.g. 'get_Thumbnail'.`
+
+const goTimeUsage = `Uses 'time.Time' and 'time.Duration' in the generated method signatures instead of the
+'Windows.Foundation.DateTime' and 'Windows.Foundation.TimeSpan' structs, which hold 100-nanosecond ticks
+(since 1601-01-01 for DateTime). The values are converted when calling the methods.`
+
+const denyUsage = `A type that must not be generated as a dependency when using '-with-deps'. This option can be set several times.
+A trailing '*' matches any type starting with the given prefix, e.g. 'Windows.Storage.*'.`
+
+const winmdUsage = `A .winmd file, or a directory containing .winmd files, to load in addition to the embedded Windows metadata.
+This option can be set several times. The types defined in these files take precedence over the embedded ones.`
+
+const maxContractUsage = `The newest version of an API contract to generate, with the format 'Contract=Major[.Minor]', e.g.
+'Windows.Foundation.UniversalApiContract=10'. The types and methods introduced in a newer version of the contract
+are not generated. This option can be set several times, once per contract.`
+
 const methodFilterUsage = `The filter to use when generating the methods. This option can be set several times, 
 the given filters will be applied in order, and the first that matches will determine the result. The generator
 will allow any method by default. The filter uses the overloaded method name to discriminate between overloaded
@@ -19,18 +53,37 @@ You can use the '!' character to negate a filter. For example, to generate all m
     -method-filter !Add
 
 You can also use the '*' character to match any method, so if you want to generate only the 'Add' method, you can do:
-    -method-filter Add -method-filter !*`
+    -method-filter Add -method-filter !*
+
+These filters apply to all the generated classes. The filters defined for a class in the manifest are applied first.`
 
 // NewGenerateCommand returns a new subcommand for generating code.
 func NewGenerateCommand(logger log.Logger) *subcommands.Command {
 	cfg := codegen.NewConfig()
 	fs := flag.NewFlagSet("winrt-go-gen", flag.ExitOnError)
 	_ = fs.String("config", "", "config file (optional)")
-	fs.StringVar(&cfg.Class, "class", cfg.Class, "The class to generate. This should include the namespace and the class name, e.g. 'System.Runtime.InteropServices.WindowsRuntime.EventRegistrationToken'.")
+	fs.Func("class", classUsage, func(c string) error {
+		cfg.AddClass(c)
+		return nil
+	})
+	fs.Func("manifest", manifestUsage, cfg.LoadManifest)
 	fs.Func("method-filter", methodFilterUsage, func(m string) error {
 		cfg.AddMethodFilter(m)
 		return nil
 	})
+	fs.Func("winmd", winmdUsage, func(p string) error {
+		cfg.AddWinMDPath(p)
+		return nil
+	})
+	fs.Func("max-contract", maxContractUsage, cfg.AddMaxContract)
+	fs.BoolVar(&cfg.WithDeps, "with-deps", cfg.WithDeps, withDepsUsage)
+	fs.BoolVar(&cfg.NoInterfaceCache, "no-interface-cache", cfg.NoInterfaceCache, noInterfaceCacheUsage)
+	fs.BoolVar(&cfg.GoAccessors, "go-accessors", cfg.GoAccessors, goAccessorsUsage)
+	fs.BoolVar(&cfg.GoTime, "go-time", cfg.GoTime, goTimeUsage)
+	fs.Func("deny", denyUsage, func(c string) error {
+		cfg.AddDeniedClass(c)
+		return nil
+	})
 	fs.BoolVar(&cfg.Debug, "debug", cfg.Debug, "Enables the debug logging.")
 	return subcommands.NewCommand(fs.Name(), fs, func() error {
 		if cfg.Debug {
@@ -2,6 +2,7 @@ package codegen
 
 import (
 	"bytes"
+	"encoding/binary"
 	"fmt"
 	"go/format"
 	"os"
@@ -21,10 +22,25 @@ const (
 	invokeMethodName = "Invoke"
 )
 
+// Windows.Foundation.Metadata.CompositionType.Public
+const compositionTypePublic = 2
+
 type generator struct {
 	class        string
 	methodFilter *MethodFilter
 
+	// noInterfaceCache disables the interface cache of the generated runtime classes.
+	noInterfaceCache bool
+
+	// goAccessors enables the Go-style names of the property and event methods.
+	goAccessors bool
+
+	// goTime uses time.Time and time.Duration instead of the DateTime and TimeSpan structs in the method signatures.
+	goTime bool
+
+	// maxContracts holds the newest version allowed for each API contract.
+	maxContracts map[string]uint32
+
 	logger log.Logger
 
 	genDataFiles []*genDataFile
@@ -38,18 +54,100 @@ func Generate(cfg *Config, logger log.Logger) error {
 		return err
 	}
 
-	mdStore, err := winmd.NewStore(logger)
+	sources := make([]winmd.Source, 0, len(cfg.WinMDPaths()))
+	for _, path := range cfg.WinMDPaths() {
+		src, err := winmd.PathSource(path)
+		if err != nil {
+			return err
+		}
+		sources = append(sources, src)
+	}
+
+	// loading the metadata is the most expensive part of the generation,
+	// so the store is shared by all the generated classes.
+	mdStore, err := winmd.NewStore(logger, sources...)
 	if err != nil {
 		return err
 	}
 
-	g := &generator{
-		class:        cfg.Class,
-		methodFilter: cfg.MethodFilter(),
-		logger:       logger,
-		mdStore:      mdStore,
+	// classes is a queue that grows with the dependencies of the generated classes (if enabled)
+	classes := append([]string{}, cfg.Classes()...)
+	visited := make(map[string]bool)
+	for _, class := range classes {
+		visited[class] = true
+	}
+
+	for i := 0; i < len(classes); i++ {
+		g := &generator{
+			class:            classes[i],
+			methodFilter:     cfg.MethodFilter(classes[i]),
+			noInterfaceCache: cfg.NoInterfaceCache,
+			goAccessors:      cfg.GoAccessors,
+			goTime:           cfg.GoTime,
+			maxContracts:     cfg.maxContracts,
+			logger:           logger,
+			mdStore:          mdStore,
+		}
+		if err := g.run(); err != nil {
+			return err
+		}
+
+		if !cfg.WithDeps {
+			continue
+		}
+
+		for _, dep := range g.dependencies() {
+			if visited[dep] {
+				continue
+			}
+			visited[dep] = true
+
+			if g.isDependencyRequired(cfg, dep) {
+				classes = append(classes, dep)
+			}
+		}
 	}
-	return g.run()
+
+	return nil
+}
+
+// dependencies returns the fully qualified names of all the types referenced by the generated files.
+func (g *generator) dependencies() []string {
+	var deps []string
+	for _, fData := range g.genDataFiles {
+		for _, dep := range fData.Data.Dependencies() {
+			deps = append(deps, dep.Namespace+"."+dep.Name)
+		}
+	}
+	return deps
+}
+
+// isDependencyRequired returns true if the given dependency needs to be generated.
+func (g *generator) isDependencyRequired(cfg *Config, class string) bool {
+	if cfg.IsDenied(class) {
+		_ = level.Debug(g.logger).Log("msg", "skipping denied dependency", "class", class)
+		return false
+	}
+
+	typeDef, err := g.mdStore.TypeDefByName(class)
+	if err != nil {
+		// built-in types (like unsafe.Pointer) are not found in the metadata
+		_ = level.Debug(g.logger).Log("msg", "skipping dependency not found in the metadata", "class", class)
+		return false
+	}
+
+	if typeDef.IsInterface() && typeDef.Flags.NotPublic() {
+		// exclusive interfaces are generated together with the class they belong to
+		return false
+	}
+
+	if _, err := os.Stat(typeDefFilename(typeDef, "")); err == nil {
+		_ = level.Debug(g.logger).Log("msg", "skipping dependency that already exists", "class", class)
+		return false
+	}
+
+	_ = level.Info(g.logger).Log("msg", "adding dependency", "class", class, "requiredBy", g.class)
+	return true
 }
 
 func (g *generator) run() error {
@@ -71,6 +169,15 @@ func (g *generator) generate(typeDef *winmd.TypeDef) error {
 		return fmt.Errorf("%s.%s is not a WinRT class", typeDef.TypeNamespace, typeDef.TypeName)
 	}
 
+	contract, err := typeDef.ContractVersion()
+	if err != nil {
+		return err
+	}
+	if !g.isContractAllowed(contract) {
+		_ = level.Info(g.logger).Log("msg", "skipping type newer than the max contract", "class", typeDef.TypeNamespace+"."+typeDef.TypeName, "contract", contract)
+		return nil
+	}
+
 	// get templates
 	tmpl, err := getTemplates()
 	if err != nil {
@@ -182,10 +289,8 @@ func (g *generator) loadCodeGenData(typeDef *winmd.TypeDef) error {
 }
 
 func (g *generator) addFile(typeDef *winmd.TypeDef, suffix string) *genDataFile {
-	folder := typeToFolder(typeDef.TypeNamespace, typeDef.TypeName)
-	filename := folder + "/" + typeFilename(typeDef.TypeName) + suffix + ".go"
 	f := genDataFile{
-		Filename: filename,
+		Filename: typeDefFilename(typeDef, suffix),
 		Data: genData{
 			Package: typePackage(typeDef.TypeNamespace, typeDef.TypeName),
 		},
@@ -194,6 +299,11 @@ func (g *generator) addFile(typeDef *winmd.TypeDef, suffix string) *genDataFile
 	return &f
 }
 
+func typeDefFilename(typeDef *winmd.TypeDef, suffix string) string {
+	folder := typeToFolder(typeDef.TypeNamespace, typeDef.TypeName)
+	return folder + "/" + typeFilename(typeDef.TypeName) + suffix + ".go"
+}
+
 func (g *generator) validateInterface(typeDef *winmd.TypeDef) error {
 	// Any WinRT interface with private visibility must have a single ExclusiveToAttribute.
 	// the ExclusiveToAttribute must reference a runtime class.
@@ -225,12 +335,491 @@ func (g *generator) createGenInterface(typeDef *winmd.TypeDef, requiresActivatio
 		return nil, err
 	}
 
-	return &genInterface{
-		Name:      typeDefGoName(typeDef.TypeName, typeDef.Flags.Public()),
-		GUID:      guid,
-		Signature: typeSig,
-		Funcs:     funcs,
-	}, nil
+	contract, err := typeDef.ContractVersion()
+	if err != nil {
+		return nil, err
+	}
+
+	// activation interfaces are called statically, so there's no instance to query the parents from.
+	var requiredInterfaces []*genInterface
+	var requiredImports []*genImport
+	if !requiresActivation {
+		requiredInterfaces, requiredImports, err = g.createRequiredGenInterfaces(typeDef, funcs)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	iface := &genInterface{
+		Name:               typeDefGoName(typeDef.TypeName, typeDef.Flags.Public()),
+		GUID:               guid,
+		Signature:          typeSig,
+		RequiresImports:    requiredImports,
+		Funcs:              funcs,
+		RequiredInterfaces: requiredInterfaces,
+		IsParameterized:    isParameterizedName(typeDef.TypeName),
+		RequiresActivation: requiresActivation,
+		Contract:           contract,
+	}
+
+	if !iface.IsParameterized && !requiresActivation {
+		iface.Events, err = g.createGenEvents(typeDef, funcs)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if iface.IsParameterized && !requiresActivation {
+		genericParams, err := typeDef.GetGenericParams()
+		if err != nil {
+			return nil, err
+		}
+		iface.GenericParams = make([]string, len(genericParams))
+		for _, p := range genericParams {
+			iface.GenericParams[p.Number] = p.Name
+		}
+
+		iface.TypedFuncs, err = g.createTypedFuncs(iface)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return iface, nil
+}
+
+// createTypedFuncs returns the methods of the typed wrapper of the given parameterized interface.
+// Only the methods that use the generic params (or return a parameterized interface) need to be
+// wrapped, the rest are promoted from the raw interface.
+func (g *generator) createTypedFuncs(iface *genInterface) ([]*genTypedFunc, error) {
+	var typedFuncs []*genTypedFunc
+	for _, f := range iface.Funcs {
+		if !f.Implement {
+			continue
+		}
+
+		if typed, ok := typedGenFunc(f, iface.GenericParams, nil); ok {
+			typedFuncs = append(typedFuncs, &genTypedFunc{Func: typed, Direct: true})
+			continue
+		}
+
+		returnType, returnWrap, err := g.typedReturn(f, iface.GenericParams, nil)
+		if err != nil {
+			return nil, err
+		}
+		if returnWrap != "" {
+			typedFuncs = append(typedFuncs, &genTypedFunc{Func: f, ReturnType: returnType, ReturnWrap: returnWrap})
+		}
+	}
+
+	// the methods of parameterized parents need the IID of the parent instance
+	for _, parent := range iface.RequiredInterfaces {
+		if !parent.IsParameterized || parent.instanceArgs == nil {
+			continue
+		}
+
+		for _, f := range parent.Funcs {
+			if !f.Implement {
+				continue
+			}
+
+			if _, ok := typedGenFunc(f, iface.GenericParams, parent.instanceArgs); ok {
+				// the forwarded method uses the generic params, this is not supported yet.
+				_ = level.Warn(g.logger).Log("msg", "skipping typed inherited method that uses generic params", "method", f.Name, "interface", iface.Name)
+				continue
+			}
+
+			pkg := ""
+			if f.InheritedFrom.Namespace != "" {
+				pkg = f.InheritedFrom.Namespace + "."
+			}
+			parentIID := fmt.Sprintf("ole.NewGUID(winrt.ParameterizedInstanceGUID(%sGUID%s, %s))",
+				pkg, f.InheritedFrom.Name, typedSignatures(parent.instanceArgs))
+
+			returnType, returnWrap, err := g.typedReturn(f, iface.GenericParams, parent.instanceArgs)
+			if err != nil {
+				return nil, err
+			}
+
+			typedFuncs = append(typedFuncs, &genTypedFunc{
+				Func:       f,
+				ParentIID:  parentIID,
+				ReturnType: returnType,
+				ReturnWrap: returnWrap,
+			})
+		}
+	}
+
+	return typedFuncs, nil
+}
+
+// createGenEvents returns the typed subscription methods of the events of the given interface.
+// These methods are only generated for the events whose handler is a parameterized delegate instantiated
+// with reference types, e.g. TypedEventHandler<SystemMediaTransportControls, SystemMediaTransportControlsButtonPressedEventArgs>.
+// Other delegates already have a typed callback.
+func (g *generator) createGenEvents(typeDef *winmd.TypeDef, funcs []*genFunc) ([]*genEvent, error) {
+	removers := make(map[string]*genFunc)
+	for _, f := range funcs {
+		if f.Implement && f.semantics != nil && f.semantics.Attributes.RemoveOn() {
+			removers[f.semantics.Name] = f
+		}
+	}
+
+	curPackage := typePackage(typeDef.TypeNamespace, typeDef.TypeName)
+
+	var events []*genEvent
+	for _, f := range funcs {
+		if !f.Implement || f.semantics == nil || !f.semantics.Attributes.AddOn() {
+			continue
+		}
+		remover, ok := removers[f.semantics.Name]
+		if !ok || len(f.InParams) != 1 || len(remover.InParams) != 1 {
+			continue
+		}
+
+		handler := f.InParams[0].Type
+		if handler.typeArgs == nil {
+			continue
+		}
+
+		event, err := g.createGenEvent(typeDef, curPackage, f.semantics.Name, handler)
+		if err != nil {
+			return nil, err
+		}
+		if event == nil {
+			continue
+		}
+		event.Adder = f
+		event.Remover = remover
+		events = append(events, event)
+	}
+	return events, nil
+}
+
+// createGenEvent returns the typed subscription method of an event with the given handler. It returns nil
+// if the type arguments of the handler cannot be used as Go func params.
+func (g *generator) createGenEvent(typeDef *winmd.TypeDef, curPackage, name string, handler *genParamType) (*genEvent, error) {
+	delegateTypeDef, err := g.mdStore.TypeDefByName(handler.namespace + "." + handler.name)
+	if err != nil {
+		return nil, err
+	}
+	delegate, err := g.createGenDelegate(delegateTypeDef)
+	if err != nil {
+		return nil, err
+	}
+
+	signatures := make([]string, 0, len(handler.typeArgs))
+	for _, arg := range handler.typeArgs {
+		sig, err := g.elementSignature(typeDef.Ctx(), arg)
+		if err != nil {
+			_ = level.Debug(g.logger).Log("msg", "skipping typed event, the signature of the handler is unknown", "event", name, "err", err)
+			return nil, nil
+		}
+		signatures = append(signatures, sig)
+	}
+
+	event := &genEvent{
+		Name:      name,
+		IID:       winrt.ParameterizedInstanceGUID(delegate.GUID, signatures...),
+		Signature: winrt.ParameterizedInstanceSignature(delegate.GUID, signatures...),
+		Delegate:  delegate.Name,
+
+		ObjectTypeArgs: make([]bool, len(handler.typeArgs)),
+	}
+	if pkg := typePackage(delegateTypeDef.TypeNamespace, delegateTypeDef.TypeName); pkg != curPackage {
+		event.DelegatePackage = pkg
+	}
+
+	for _, raw := range delegate.InParams {
+		raw.callerPackage = curPackage
+		typed := raw
+		if raw.Type.IsGeneric {
+			argType, err := g.elementType(typeDef.Ctx(), types.Element{Type: handler.typeArgs[raw.Type.genericIndex]})
+			if err != nil {
+				return nil, err
+			}
+			if !argType.IsPointer || argType.IsArray {
+				// value types are not passed as a pointer to the delegate
+				_ = level.Debug(g.logger).Log("msg", "skipping typed event, the handler has value type arguments", "event", name)
+				return nil, nil
+			}
+
+			event.ObjectTypeArgs[raw.Type.genericIndex] = argType.IsObject()
+			typed = &genParam{callerPackage: curPackage, varName: raw.varName, Type: argType}
+			event.requiresImports = append(event.requiresImports, &genImport{argType.namespace, argType.name})
+		}
+		event.Params = append(event.Params, &genEventParam{Raw: raw, Typed: typed})
+	}
+
+	return event, nil
+}
+
+// elementSignature returns the signature of the given type argument of a parameterized type.
+func (g *generator) elementSignature(ctx *types.Context, t types.ElementType) (string, error) {
+	switch t.Kind {
+	case types.ELEMENT_TYPE_OBJECT:
+		return "cinterface(IInspectable)", nil
+	case types.ELEMENT_TYPE_CLASS, types.ELEMENT_TYPE_VALUETYPE, types.ELEMENT_TYPE_GENERICINST:
+		namespace, name, err := ctx.ResolveTypeDefOrRefName(t.TypeDef.Index)
+		if err != nil {
+			return "", err
+		}
+		if namespace == "System" && name == "Guid" {
+			return winrt.SignatureGUID, nil
+		}
+
+		typeDef, err := g.mdStore.TypeDefByName(namespace + "." + name)
+		if err != nil {
+			return "", err
+		}
+		if len(t.TypeDef.Generics) == 0 {
+			return g.Signature(typeDef)
+		}
+
+		guid, err := typeDef.GUID()
+		if err != nil {
+			return "", err
+		}
+		args := make([]string, 0, len(t.TypeDef.Generics))
+		for _, arg := range t.TypeDef.Generics {
+			sig, err := g.elementSignature(ctx, arg)
+			if err != nil {
+				return "", err
+			}
+			args = append(args, sig)
+		}
+		return winrt.ParameterizedInstanceSignature(guid, args...), nil
+	default:
+		if sig := primitiveTypeSignature(t.Kind); sig != "" {
+			return sig, nil
+		}
+		return "", fmt.Errorf("unsupported type argument: %v", t.Kind)
+	}
+}
+
+// typedGenFunc returns a copy of the given function where all the generic params have been replaced
+// by the given type parameters. If the function does not use any generic param, it returns false.
+// The function may belong to a parent interface, in which case the argMapping is used to map the generic
+// params of the parent to the type parameters.
+func typedGenFunc(f *genFunc, typeParams []string, argMapping []uint32) (*genFunc, bool) {
+	typed := *f
+	replaced := false
+
+	typedParams := func(params []*genParam) []*genParam {
+		result := make([]*genParam, 0, len(params))
+		for _, p := range params {
+			if !p.Type.IsGeneric {
+				result = append(result, p)
+				continue
+			}
+
+			idx := p.Type.genericIndex
+			if argMapping != nil {
+				idx = argMapping[idx]
+			}
+			name := typeParams[idx]
+
+			defaultValue := genDefaultValue{"*new(" + name + ")", true}
+			if p.Type.IsArray {
+				defaultValue = genDefaultValue{"nil", true}
+			}
+
+			typedParam := *p
+			typedParam.Type = &genParamType{
+				name:         name,
+				IsPrimitive:  true,
+				IsArray:      p.Type.IsArray,
+				IsGeneric:    true,
+				genericIndex: idx,
+				isTypeParam:  true,
+				defaultValue: defaultValue,
+			}
+			result = append(result, &typedParam)
+			replaced = true
+		}
+		return result
+	}
+
+	typed.InParams = typedParams(f.InParams)
+	typed.ReturnParams = typedParams(f.ReturnParams)
+	return &typed, replaced
+}
+
+// typedReturn returns the type and the expression used to wrap the result of the given function,
+// if the function returns a parameterized interface instantiated using the generic params.
+func (g *generator) typedReturn(f *genFunc, typeParams []string, argMapping []uint32) (string, string, error) {
+	if len(f.ReturnParams) != 1 {
+		return "", "", nil
+	}
+	for _, p := range f.InParams {
+		if p.IsGoReturn() {
+			return "", "", nil
+		}
+	}
+
+	ret := f.ReturnParams[0]
+	if ret.Type.genericArgs == nil || !isParameterizedName(ret.Type.name) {
+		return "", "", nil
+	}
+
+	retTypeDef, err := g.mdStore.TypeDefByName(ret.Type.namespace + "." + ret.Type.name)
+	if err != nil {
+		return "", "", err
+	}
+	if !retTypeDef.IsInterface() {
+		// only interfaces have a typed wrapper
+		return "", "", nil
+	}
+
+	args := make([]uint32, 0, len(ret.Type.genericArgs))
+	typeArgs := make([]string, 0, len(ret.Type.genericArgs))
+	for _, idx := range ret.Type.genericArgs {
+		if argMapping != nil {
+			idx = argMapping[idx]
+		}
+		args = append(args, idx)
+		typeArgs = append(typeArgs, typeParams[idx])
+	}
+
+	pkg := ""
+	if retPkg := typePackage(ret.Type.namespace, ret.Type.name); retPkg != ret.callerPackage {
+		pkg = retPkg + "."
+	}
+	name := typeNameToGoName(ret.Type.name, true)
+
+	returnType := fmt.Sprintf("*%s%sOf[%s]", pkg, name, strings.Join(typeArgs, ", "))
+	returnWrap := fmt.Sprintf("%sNew%sOf[%s](out, %s)", pkg, name, strings.Join(typeArgs, ", "), typedSignatures(args))
+	return returnType, returnWrap, nil
+}
+
+// typedSignatures returns the expression used to reference the signatures of the given type parameters
+// from a typed wrapper.
+func typedSignatures(args []uint32) string {
+	signatures := make([]string, 0, len(args))
+	for _, idx := range args {
+		signatures = append(signatures, fmt.Sprintf("w.signatures[%d]", idx))
+	}
+	return strings.Join(signatures, ", ")
+}
+
+// createRequiredGenInterfaces returns the interfaces required by the given interface, and the imports they need.
+// https://docs.microsoft.com/en-us/uwp/winrt-cref/winmd-files#interfaces
+func (g *generator) createRequiredGenInterfaces(typeDef *winmd.TypeDef, ownFuncs []*genFunc) ([]*genInterface, []*genImport, error) {
+	parents, err := typeDef.GetRequiredInterfaces()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	curPackage := typePackage(typeDef.TypeNamespace, typeDef.TypeName)
+	ownFuncNames := make(map[string]bool, len(ownFuncs))
+	for _, f := range ownFuncs {
+		ownFuncNames[f.Name] = true
+	}
+
+	var requiredInterfaces []*genInterface
+	var requiredImports []*genImport
+	for _, parent := range parents {
+		parentTypeDef, err := g.mdStore.TypeDefByName(parent.Namespace + "." + parent.Name)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		itf, err := g.createGenInterface(parentTypeDef, false)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		pkg := ""
+		if typeDef.TypeNamespace != parentTypeDef.TypeNamespace {
+			pkg = typePackage(parent.Namespace, parent.Name)
+		}
+
+		funcs := make([]*genFunc, 0, len(itf.Funcs))
+		for _, f := range itf.Funcs {
+			if ownFuncNames[f.Name] {
+				_ = level.Warn(g.logger).Log(
+					"msg", "skipping inherited method, the name collides with a method of the interface",
+					"method", f.Name,
+					"interface", typeDef.TypeNamespace+"."+typeDef.TypeName,
+					"parent", parent.Namespace+"."+parent.Name,
+				)
+				continue
+			}
+
+			f.InheritedFrom = winmd.QualifiedID{
+				Namespace: pkg,
+				Name:      typeDefGoName(parentTypeDef.TypeName, parentTypeDef.Flags.Public()),
+			}
+			setCallerPackage(f, curPackage)
+			funcs = append(funcs, f)
+		}
+		itf.Funcs = funcs
+
+		itf.instanceArgs = genericVarArgs(parent.Generics)
+
+		requiredImports = append(requiredImports, &genImport{parent.Namespace, parent.Name})
+		requiredInterfaces = append(requiredInterfaces, itf)
+	}
+
+	return requiredInterfaces, requiredImports, nil
+}
+
+// setCallerPackage updates the package the function params are referenced from.
+// This is required when a function is forwarded from a different package.
+func setCallerPackage(f *genFunc, pkg string) {
+	for _, p := range f.InParams {
+		p.callerPackage = pkg
+	}
+	for _, p := range f.ReturnParams {
+		p.callerPackage = pkg
+	}
+}
+
+// setConstructors turns the methods of the given factory interface into constructors of the class.
+// The constructors are named New<Class>With<Overload>, see constructorName.
+//
+// The methods of composable factories have two additional params: the outer (controlling) object and the inner
+// (non-delegating) object. Only non-aggregated objects can be created for now, so those params are hidden
+// from the constructors.
+func (g *generator) setConstructors(class string, factory *genInterface, composable bool, used map[string]bool) {
+	for _, f := range factory.Funcs {
+		if !f.Implement {
+			continue
+		}
+
+		if composable {
+			n := len(f.InParams)
+			if n < 2 || f.InParams[n-2].IsOut || !f.InParams[n-1].IsOut {
+				_ = level.Warn(g.logger).Log("msg", "skipping composable factory method with unexpected params", "class", class, "method", f.Name)
+				f.Implement = false
+				continue
+			}
+			f.InParams[n-2].isCompositionArg = true // outer
+			f.InParams[n-1].isCompositionArg = true // inner
+		}
+
+		f.Constructor = constructorName(class, f.Name, used)
+		used[f.Constructor] = true
+	}
+}
+
+// constructorName returns the name of the constructor generated for the given factory method:
+// New<Class>With<Overload>, where the Create prefix of the overload is dropped. The default
+// factory methods (Create, CreateInstance and Create<Class>) use New<Class>, unless it is already in use.
+func constructorName(class, overload string, used map[string]bool) string {
+	suffix := strings.TrimPrefix(strings.TrimPrefix(overload, "Create"), "With")
+	if suffix == "" || suffix == "Instance" || suffix == class {
+		if name := "New" + class; !used[name] {
+			return name
+		}
+		suffix = overload
+	}
+
+	name := "New" + class + "With" + suffix
+	if used[name] {
+		name = "New" + class + "With" + overload
+	}
+	return name
 }
 
 // https://docs.microsoft.com/en-us/uwp/winrt-cref/winmd-files#runtime-classes
@@ -240,6 +829,9 @@ func (g *generator) createGenClass(typeDef *winmd.TypeDef) (*genClass, error) {
 
 	// true => interface requires activation, false => interface is implemented by this class
 	activatedInterfaces := make(map[string]bool)
+	// the methods of the factory interfaces are generated as constructors of the class.
+	// true => composable factory, false => activation factory
+	factoryInterfaces := make(map[string]bool)
 
 	// get all the interfaces this class implements
 	interfaces, err := typeDef.GetImplementedInterfaces()
@@ -265,11 +857,18 @@ func (g *generator) createGenClass(typeDef *winmd.TypeDef) (*genClass, error) {
 		if typeDef.TypeNamespace != ifaceTypeDef.TypeNamespace {
 			pkg = typePackage(iface.Namespace, iface.Name)
 		}
+		itf.Package = pkg
 		for _, f := range itf.Funcs {
 			f.InheritedFrom = winmd.QualifiedID{
 				Namespace: pkg,
 				Name:      typeDefGoName(ifaceTypeDef.TypeName, ifaceTypeDef.Flags.Public()),
 			}
+			setCallerPackage(f, typePackage(typeDef.TypeNamespace, typeDef.TypeName))
+		}
+		for _, e := range itf.Events {
+			for _, p := range e.Params {
+				p.Typed.callerPackage = typePackage(typeDef.TypeNamespace, typeDef.TypeName)
+			}
 		}
 
 		implInterfaces = append(implInterfaces, itf)
@@ -327,8 +926,35 @@ func (g *generator) createGenClass(typeDef *winmd.TypeDef) (*genClass, error) {
 		}
 		exclusiveInterfaceTypes = append(exclusiveInterfaceTypes, activatableClass)
 		activatedInterfaces[activatableClass.TypeNamespace+"."+activatableClass.TypeName] = true // activatable interfaces require activation
+		factoryInterfaces[activatableClass.TypeNamespace+"."+activatableClass.TypeName] = false
+	}
+
+	// Unsealed runtime classes have zero or more ComposableAttribute custom attributes
+	// https://docs.microsoft.com/en-us/uwp/winrt-cref/winmd-files#composition
+	composableAttributeBlobs := typeDef.GetTypeDefAttributesWithType(winmd.AttributeTypeComposableAttribute)
+	for _, blob := range composableAttributeBlobs {
+		class := extractClassFromBlob(blob)
+		if !composableAttrIsPublic(blob) {
+			// protected factories can only be used by the classes deriving from this one
+			_ = level.Debug(g.logger).Log("msg", "skipping protected composable interface", "class", class)
+			continue
+		}
+
+		_ = level.Debug(g.logger).Log("msg", "found composable interface", "class", class)
+		composableClass, err := g.mdStore.TypeDefByName(class)
+		if err != nil {
+			_ = level.Error(g.logger).Log("msg", "composable class defined in ComposableAttribute not found", "class", class, "err", err)
+			return nil, err
+		}
+		exclusiveInterfaceTypes = append(exclusiveInterfaceTypes, composableClass)
+		activatedInterfaces[composableClass.TypeNamespace+"."+composableClass.TypeName] = true // composable interfaces require activation
+		factoryInterfaces[composableClass.TypeNamespace+"."+composableClass.TypeName] = true
 	}
 
+	className := typeDefGoName(typeDef.TypeName, typeDef.Flags.Public())
+	// the constructor names that are already in use
+	constructors := map[string]bool{"New" + className: hasEmptyConstructor}
+
 	// generate exclusive interfaces
 	var exclusiveGenInterfaces []*genInterface
 	for _, iface := range exclusiveInterfaceTypes {
@@ -340,6 +966,10 @@ func (g *generator) createGenClass(typeDef *winmd.TypeDef) (*genClass, error) {
 			return nil, err
 		}
 
+		if composable, ok := factoryInterfaces[iface.TypeNamespace+"."+iface.TypeName]; ok {
+			g.setConstructors(className, ifaceGen, composable, constructors)
+		}
+
 		// if all methods from the exclusive interface have been filtered, and the interface
 		// is an activated interface, then we can skip it.
 		impl := false
@@ -361,7 +991,7 @@ func (g *generator) createGenClass(typeDef *winmd.TypeDef) (*genClass, error) {
 	}
 
 	return &genClass{
-		Name:                typeDefGoName(typeDef.TypeName, typeDef.Flags.Public()),
+		Name:                className,
 		Signature:           typeSig,
 		RequiresImports:     requiredImports,
 		FullyQualifiedName:  typeDef.TypeNamespace + "." + typeDef.TypeName,
@@ -369,6 +999,7 @@ func (g *generator) createGenClass(typeDef *winmd.TypeDef) (*genClass, error) {
 		ExclusiveInterfaces: exclusiveGenInterfaces,
 		HasEmptyConstructor: hasEmptyConstructor,
 		IsAbstract:          typeDef.Flags.Abstract(),
+		CacheInterfaces:     !g.noInterfaceCache,
 	}, nil
 }
 
@@ -419,8 +1050,9 @@ func (g *generator) createGenEnum(typeDef *winmd.TypeDef) (*genEnum, error) {
 		}
 
 		enumValues = append(enumValues, &genEnumValue{
-			Name:  enumName(typeDef.TypeName, field.Name),
-			Value: enumRawValue,
+			Name:      enumName(typeDef.TypeName, field.Name),
+			Value:     enumRawValue,
+			FieldName: field.Name,
 		})
 	}
 
@@ -434,6 +1066,7 @@ func (g *generator) createGenEnum(typeDef *winmd.TypeDef) (*genEnum, error) {
 		Type:      enumType,
 		Signature: typeSig,
 		Values:    enumValues,
+		IsFlags:   len(typeDef.GetTypeDefAttributesWithType(winmd.AttributeTypeFlags)) > 0,
 	}, nil
 }
 
@@ -473,10 +1106,21 @@ func (g *generator) createGenStruct(typeDef *winmd.TypeDef) (*genStruct, error)
 		return nil, err
 	}
 
+	var timeConversion string
+	if typeDef.TypeNamespace == "Windows.Foundation" {
+		switch typeDef.TypeName {
+		case "DateTime":
+			timeConversion = "Time"
+		case "TimeSpan":
+			timeConversion = "Duration"
+		}
+	}
+
 	return &genStruct{
-		Name:      typeDefGoName(typeDef.TypeName, typeDef.Flags.Public()),
-		Signature: typeSig,
-		Fields:    genFields,
+		Name:           typeDefGoName(typeDef.TypeName, typeDef.Flags.Public()),
+		Signature:      typeSig,
+		Fields:         genFields,
+		TimeConversion: timeConversion,
 	}, nil
 }
 
@@ -550,6 +1194,21 @@ func activatableAttrIsEmpty(blob []byte) bool {
 	return len(blob) >= 3 && blob[0] == 0x01 && blob[1] == 0x00 && blob[2] == 0x00
 }
 
+func composableAttrIsPublic(blob []byte) bool {
+	// the composable attribute contains the factory interface
+	// 01 00 - header
+	// XX - size
+	// followed by the type name and the composition type (int32):
+	// 01 00 00 00 - protected
+	// 02 00 00 00 - public
+	class := extractClassFromBlob(blob)
+	offset := 3 + len(class)
+	if len(blob) < offset+4 {
+		return false
+	}
+	return binary.LittleEndian.Uint32(blob[offset:]) == compositionTypePublic
+}
+
 func extractClassFromBlob(blob []byte) string {
 	// the blob contains a two byte header
 	// 01 00
@@ -589,20 +1248,79 @@ func (g *generator) getGenFuncs(typeDef *winmd.TypeDef, requiresActivation bool)
 		genFuncs = append(genFuncs, generatedFunc)
 	}
 
+	if g.goAccessors {
+		pairEventMethods(genFuncs)
+	}
 	return genFuncs, nil
 }
 
+// accessorName returns the Go-style name of a property or event method: X for property getters, SetX for
+// setters, OnX for the methods that add event handlers, and OffX for the ones that remove them.
+func accessorName(semantics winmd.MethodSemantics, methodName string) string {
+	switch {
+	case semantics.Attributes.Getter():
+		return semantics.Name
+	case semantics.Attributes.Setter():
+		return "Set" + semantics.Name
+	case semantics.Attributes.AddOn():
+		return "On" + semantics.Name
+	case semantics.Attributes.RemoveOn():
+		return "Off" + semantics.Name
+	default:
+		return methodName
+	}
+}
+
+// pairEventMethods links the methods that add event handlers to the methods that remove them, so the
+// added handlers can be removed using the returned winrt.EventToken. Only the events whose methods
+// are both implemented are linked, the rest return the raw registration token.
+func pairEventMethods(funcs []*genFunc) {
+	removers := make(map[string]*genFunc)
+	for _, f := range funcs {
+		if f.Implement && f.semantics != nil && f.semantics.Attributes.RemoveOn() {
+			removers[f.semantics.Name] = f
+		}
+	}
+
+	for _, f := range funcs {
+		if !f.Implement || f.semantics == nil || !f.semantics.Attributes.AddOn() {
+			continue
+		}
+		remover, ok := removers[f.semantics.Name]
+		if !ok || len(f.ReturnParams) != 1 || len(remover.InParams) != 1 {
+			continue
+		}
+		f.EventRemover = remover
+		f.ReturnParams[0].isEventToken = true
+	}
+}
+
 func (g *generator) genFuncFromMethod(typeDef *winmd.TypeDef, methodDef *types.MethodDef, exclusiveTo string, requiresActivation bool) (*genFunc, error) {
 	// add the type imports to the top of the file
 	// only if the method is going to be implemented
 
-	overloadName := winmd.GetMethodOverloadName(typeDef.Ctx(), methodDef)
-	implement := g.shouldImplementMethod(overloadName)
+	overloadName := typeDef.GetMethodOverloadName(methodDef)
+	contract, err := typeDef.MethodContractVersion(methodDef)
+	if err != nil {
+		return nil, err
+	}
+
+	// the method filters always use the metadata name
+	name := overloadName
+	var semantics *winmd.MethodSemantics
+	if ms, ok := typeDef.GetMethodSemantics(methodDef); ok {
+		semantics = &ms
+		if g.goAccessors {
+			name = accessorName(ms, overloadName)
+		}
+	}
+
+	implement := g.shouldImplementMethod(overloadName) && g.isContractAllowed(contract)
 	if !implement {
 		// if we don't implement the method, we don't need to gather
 		// all the information, just the name of it is enough
 		return &genFunc{
-			Name:               overloadName,
+			Name:               name,
 			RequiresImports:    nil,
 			Implement:          implement,
 			InParams:           nil,
@@ -635,13 +1353,17 @@ func (g *generator) genFuncFromMethod(typeDef *winmd.TypeDef, methodDef *types.M
 	var requiredImports []*genImport
 	for _, p := range allImplementedParams {
 		p.callerPackage = curPackage
+		// the delegates keep the structs, since their params are cast from the raw args
+		if g.goTime && !typeDef.IsDelegate() {
+			p.Type = goTimeType(p.Type)
+		}
 		if !p.Type.IsPrimitive {
 			requiredImports = append(requiredImports, &genImport{p.Type.namespace, p.Type.name})
 		}
 	}
 
 	return &genFunc{
-		Name:               overloadName,
+		Name:               name,
 		RequiresImports:    requiredImports,
 		Implement:          implement,
 		InParams:           params,
@@ -649,6 +1371,8 @@ func (g *generator) genFuncFromMethod(typeDef *winmd.TypeDef, methodDef *types.M
 		FuncOwner:          typeDefGoName(typeDef.TypeName, typeDef.Flags.Public()),
 		ExclusiveTo:        exclusiveTo,
 		RequiresActivation: requiresActivation,
+		Contract:           contract,
+		semantics:          semantics,
 	}, nil
 }
 
@@ -656,6 +1380,16 @@ func (g *generator) shouldImplementMethod(methodName string) bool {
 	return g.methodFilter.Filter(methodName)
 }
 
+// isContractAllowed returns false if the given contract version is newer than the max version of the contract.
+// Types and methods that do not belong to a contract are always allowed.
+func (g *generator) isContractAllowed(contract *winmd.ContractVersion) bool {
+	if contract == nil {
+		return true
+	}
+	maxVersion, ok := g.maxContracts[contract.Contract]
+	return !ok || contract.Version <= maxVersion
+}
+
 func (g *generator) getInParameters(curPackage string, typeDef *winmd.TypeDef, methodDef *types.MethodDef) ([]*genParam, error) {
 
 	params, err := methodDef.ResolveParamList(typeDef.Ctx())
@@ -683,7 +1417,7 @@ func (g *generator) getInParameters(curPackage string, typeDef *winmd.TypeDef, m
 		// parameter that immediately precedes the array parameter is omitted from both the
 		// MethodDefSig blob as well from as the params table. => so we need to add it manually.
 		// Do not trust e.IsArray variable, it's only true for the ELEMENT_TYPE_ARRAY, it
-		if e.Type.Kind == types.ELEMENT_TYPE_SZARRAY || e.Type.Kind == types.ELEMENT_TYPE_ARRAY {
+		if e.Type.Kind == types.ELEMENT_TYPE_SZARRAY || e.Type.Kind == types.ELEMENT_TYPE_ARRAY || e.IsArray {
 			// The direction of the array parameter is directly encoded in metadata.The direction of
 			// the array length parameter may be inferred as follows.
 			//   - If the array parameter is an in parameter, the array length parameter must also
@@ -694,20 +1428,27 @@ func (g *generator) getInParameters(curPackage string, typeDef *winmd.TypeDef, m
 			//   - If the array parameter is an out parameter and carries the BYREF marker, the
 			//     array length is an OUT PARAMETER.
 			sizeIsOutParam := param.Flags.Out() && e.ByRef
-			genParams = append(genParams, &genParam{
-				callerPackage: curPackage,
-				// Do not change this without also changing the code in the templates
-				varName: cleanReservedWords(param.Name + "Size"),
-				IsOut:   sizeIsOutParam,
-				Type: &genParamType{
-					namespace:    "",
-					name:         "uint32",
-					defaultValue: genDefaultValue{"0", true},
-					IsPrimitive:  true,
-					IsPointer:    false,
-					IsArray:      false,
-				},
-			})
+			e.ByRef = false
+
+			elType, err := g.elementType(typeDef.Ctx(), e)
+			if err != nil {
+				return nil, err
+			}
+			arrayParam := &genParam{
+				callerPackage:  curPackage,
+				varName:        cleanReservedWords(getParamName(params, uint16(i+1))),
+				IsOut:          param.Flags.Out(),
+				Type:           elType,
+				isReceiveArray: sizeIsOutParam,
+			}
+			genParams = append(genParams, arraySizeParam(curPackage, param.Name, arrayParam), arrayParam)
+			continue
+		}
+
+		if param.Flags.Out() {
+			// out params are always passed by reference, their BYREF
+			// marker is already represented by the IsOut flag.
+			e.ByRef = false
 		}
 
 		elType, err := g.elementType(typeDef.Ctx(), e)
@@ -725,6 +1466,26 @@ func (g *generator) getInParameters(curPackage string, typeDef *winmd.TypeDef, m
 	return genParams, nil
 }
 
+// arraySizeParam returns the synthetic param that holds the size of the given array param.
+// The size is an out param for receive arrays, and an in param otherwise.
+func arraySizeParam(curPackage, name string, arrayParam *genParam) *genParam {
+	return &genParam{
+		callerPackage: curPackage,
+		// Do not change this without also changing the code in the templates
+		varName: cleanReservedWords(name + "Size"),
+		IsOut:   arrayParam.isReceiveArray,
+		Type: &genParamType{
+			namespace:    "",
+			name:         "uint32",
+			defaultValue: genDefaultValue{"0", true},
+			IsPrimitive:  true,
+			IsPointer:    false,
+			IsArray:      false,
+		},
+		arrayParam: arrayParam,
+	}
+}
+
 func (g *generator) getReturnParameters(curPackage string, typeDef *winmd.TypeDef, methodDef *types.MethodDef) ([]*genParam, error) {
 	// the signature contains the parameter
 	// types and return type of the method
@@ -746,14 +1507,21 @@ func (g *generator) getReturnParameters(curPackage string, typeDef *winmd.TypeDe
 		return nil, err
 	}
 
-	genParams = append(genParams, &genParam{
+	retParam := &genParam{
 		// return param always has an index of zero
 		callerPackage: curPackage,
 		varName:       "out",
 		IsOut:         true,
 		Type:          elType,
-	})
+	}
 
+	if elType.IsArray {
+		// returned arrays are always receive arrays, preceded by their size
+		retParam.isReceiveArray = true
+		genParams = append(genParams, arraySizeParam(curPackage, retParam.varName, retParam))
+	}
+
+	genParams = append(genParams, retParam)
 	return genParams, nil
 }
 
@@ -776,6 +1544,47 @@ func getParamByIndex(params []types.Param, i uint16) *types.Param {
 }
 
 func (g *generator) elementType(ctx *types.Context, e types.Element) (*genParamType, error) {
+	// The type modifiers are not part of the element type kind. They are applied
+	// in the order they appear in the signature: BYREF, ARRAY and then PTR.
+	switch {
+	case e.ByRef:
+		// A reference to the element type, mapped to a Go pointer
+		inner := e
+		inner.ByRef = false
+		param, err := g.elementType(ctx, inner)
+		if err != nil {
+			return nil, err
+		}
+		return pointerType(param)
+	case e.IsArray:
+		// A multi-dimensional array, mapped to a flat Go slice
+		inner := e
+		inner.IsArray = false
+		param, err := g.elementType(ctx, inner)
+		if err != nil {
+			return nil, err
+		}
+		if param.isClass {
+			// the elements of the array are the WinRT objects, not the Go wrappers of the classes
+			param = objectType()
+		}
+
+		param.IsArray = true
+		// override default val
+		param.defaultValue = genDefaultValue{"nil", true}
+
+		return param, nil
+	case e.Pointers > 0:
+		// A typed pointer to the element type
+		inner := e
+		inner.Pointers--
+		param, err := g.elementType(ctx, inner)
+		if err != nil {
+			return nil, err
+		}
+		return pointerType(param)
+	}
+
 	switch e.Type.Kind {
 	case types.ELEMENT_TYPE_BOOLEAN:
 		return &genParamType{
@@ -787,9 +1596,10 @@ func (g *generator) elementType(ctx *types.Context, e types.Element) (*genParamT
 			defaultValue: g.elementDefaultValue(ctx, e),
 		}, nil
 	case types.ELEMENT_TYPE_CHAR:
+		// WinRT chars are UTF-16 code units
 		return &genParamType{
 			namespace:    "",
-			name:         "byte",
+			name:         "uint16",
 			IsPointer:    false,
 			IsPrimitive:  true,
 			IsArray:      false,
@@ -894,20 +1704,32 @@ func (g *generator) elementType(ctx *types.Context, e types.Element) (*genParamT
 			IsArray:      false,
 			defaultValue: g.elementDefaultValue(ctx, e),
 		}, nil
-	case types.ELEMENT_TYPE_GENERICINST:
-		fallthrough
-	case types.ELEMENT_TYPE_CLASS:
+	case types.ELEMENT_TYPE_GENERICINST, types.ELEMENT_TYPE_CLASS:
 		// return class name
 		namespace, name, err := ctx.ResolveTypeDefOrRefName(e.Type.TypeDef.Index)
 		if err != nil {
 			return nil, err
 		}
+
+		// without the interface cache, the classes have the layout of the WinRT object and are just cast
+		isClass := false
+		if e.Type.Kind == types.ELEMENT_TYPE_CLASS && !g.noInterfaceCache {
+			typeDef, err := g.mdStore.TypeDefByName(namespace + "." + name)
+			if err != nil {
+				return nil, err
+			}
+			isClass = typeDef.IsRuntimeClass() && !typeDef.IsInterface() && !typeDef.IsDelegate()
+		}
 		return &genParamType{
 			namespace:    namespace,
 			name:         name,
 			IsPointer:    true,
 			IsPrimitive:  false,
 			IsArray:      false,
+			isObject:     true,
+			isClass:      isClass,
+			genericArgs:  genericVarArgs(e.Type.TypeDef.Generics),
+			typeArgs:     e.Type.TypeDef.Generics,
 			defaultValue: g.elementDefaultValue(ctx, e),
 		}, nil
 	case types.ELEMENT_TYPE_VALUETYPE:
@@ -957,16 +1779,27 @@ func (g *generator) elementType(ctx *types.Context, e types.Element) (*genParamT
 			IsPointer:    false,
 			IsPrimitive:  false,
 			IsArray:      false,
+			IsGeneric:    true,
+			genericIndex: e.Type.GenericTypeVar.Index,
 			defaultValue: g.elementDefaultValue(ctx, e),
 		}, nil
-	case types.ELEMENT_TYPE_SZARRAY:
-		//A single-dimensional, zero lower-bound array type modifier
-
-		// e.Type.SZArray.Elem should be non-nil
-		param, err := g.elementType(ctx, *e.Type.SZArray.Elem)
+	case types.ELEMENT_TYPE_SZARRAY, types.ELEMENT_TYPE_ARRAY:
+		// A single-dimensional, zero lower-bound array type modifier.
+		// Multi-dimensional arrays are mapped to a flat Go slice.
+
+		// e.Type.SZArray.Elem (or e.Type.Array.Elem) should be non-nil
+		elem := e.Type.SZArray.Elem
+		if e.Type.Kind == types.ELEMENT_TYPE_ARRAY {
+			elem = e.Type.Array.Elem
+		}
+		param, err := g.elementType(ctx, *elem)
 		if err != nil {
 			return nil, err
 		}
+		if param.isClass {
+			// the elements of the array are the WinRT objects, not the Go wrappers of the classes
+			param = objectType()
+		}
 
 		param.IsArray = true
 		// override default val
@@ -975,19 +1808,106 @@ func (g *generator) elementType(ctx *types.Context, e types.Element) (*genParamT
 		return param, err
 	case types.ELEMENT_TYPE_OBJECT:
 		// This represents System.Object, so just use a pointer
+		return objectType(), nil
+	case types.ELEMENT_TYPE_FNPTR:
+		// Function pointers are opaque to Go, use the raw address
 		return &genParamType{
-			namespace:    "unsafe",
-			name:         "Pointer",
+			namespace:    "",
+			name:         "uintptr",
 			IsPointer:    false,
-			IsPrimitive:  false,
+			IsPrimitive:  true,
 			IsArray:      false,
-			defaultValue: genDefaultValue{"nil", true},
+			defaultValue: g.elementDefaultValue(ctx, e),
 		}, nil
 	default:
 		return nil, fmt.Errorf("unsupported element type: %v", e.Type.Kind)
 	}
 }
 
+// pointerType converts the given type into a pointer to that type.
+func pointerType(param *genParamType) (*genParamType, error) {
+	if param.IsArray {
+		return nil, fmt.Errorf("unsupported pointer to an array of %s", param.name)
+	}
+	if param.IsPrimitive && param.name == "string" {
+		return nil, fmt.Errorf("unsupported pointer to a string")
+	}
+
+	if param.IsPointer {
+		param.pointers++
+	}
+	param.IsPointer = true
+
+	// a pointer to an enum, a generic param or an object is just a pointer
+	param.isObject = false
+	param.isClass = false
+	param.IsEnum = false
+	param.UnderlyingEnumType = ""
+	param.IsGeneric = false
+	param.genericArgs = nil
+
+	param.defaultValue = genDefaultValue{"nil", true}
+	return param, nil
+}
+
+// objectType returns the type of System.Object, which is passed as a raw pointer to the object.
+func objectType() *genParamType {
+	return &genParamType{
+		namespace:    "unsafe",
+		name:         "Pointer",
+		IsPointer:    false,
+		IsPrimitive:  false,
+		IsArray:      false,
+		isObject:     true,
+		defaultValue: genDefaultValue{"nil", true},
+	}
+}
+
+// genericVarArgs returns the generic param indexes used as type arguments, or nil if
+// any of the type arguments is not a generic param.
+func genericVarArgs(generics []types.ElementType) []uint32 {
+	if len(generics) == 0 {
+		return nil
+	}
+
+	args := make([]uint32, 0, len(generics))
+	for _, arg := range generics {
+		if arg.Kind != types.ELEMENT_TYPE_VAR {
+			return nil
+		}
+		args = append(args, arg.GenericTypeVar.Index)
+	}
+	return args
+}
+
+// goTimeType returns the Go type used instead of the given type when generating the methods with the
+// Go time types: time.Time for Windows.Foundation.DateTime and time.Duration for Windows.Foundation.TimeSpan.
+// Any other type, and the arrays and pointers of these structs, are returned as is.
+func goTimeType(t *genParamType) *genParamType {
+	if t.namespace != "Windows.Foundation" || t.IsArray || t.IsPointer {
+		return t
+	}
+
+	switch t.name {
+	case "DateTime":
+		return &genParamType{
+			namespace:      "time",
+			name:           "Time",
+			timeConversion: "Time",
+			defaultValue:   genDefaultValue{"Time{}", false},
+		}
+	case "TimeSpan":
+		return &genParamType{
+			namespace:      "time",
+			name:           "Duration",
+			timeConversion: "Duration",
+			defaultValue:   genDefaultValue{"0", true},
+		}
+	default:
+		return t
+	}
+}
+
 func isSystemType(namespace, name string) (*genParamType, bool) {
 	if namespace != "System" {
 		return nil, false
@@ -1011,6 +1931,11 @@ func isSystemType(namespace, name string) (*genParamType, bool) {
 }
 
 func (g *generator) elementDefaultValue(ctx *types.Context, e types.Element) genDefaultValue {
+	if e.ByRef || e.IsArray || e.Pointers > 0 {
+		// pointers and slices
+		return genDefaultValue{"nil", true}
+	}
+
 	switch e.Type.Kind {
 	case types.ELEMENT_TYPE_BOOLEAN:
 		return genDefaultValue{"false", true}
@@ -1025,8 +1950,10 @@ func (g *generator) elementDefaultValue(ctx *types.Context, e types.Element) gen
 	case types.ELEMENT_TYPE_STRING:
 		return genDefaultValue{"\"\"", true}
 	case types.ELEMENT_TYPE_CLASS,
-		types.ELEMENT_TYPE_GENERICINST, types.ELEMENT_TYPE_SZARRAY:
+		types.ELEMENT_TYPE_GENERICINST, types.ELEMENT_TYPE_SZARRAY, types.ELEMENT_TYPE_ARRAY:
 		return genDefaultValue{"nil", true}
+	case types.ELEMENT_TYPE_FNPTR:
+		return genDefaultValue{"0", true}
 	case types.ELEMENT_TYPE_VALUETYPE:
 		// we need to get the underlying type (enum, struct, etc...)
 		namespace, name, err := ctx.ResolveTypeDefOrRefName(e.Type.TypeDef.Index)
@@ -1124,6 +2051,10 @@ func (g *generator) Signature(typeDef *winmd.TypeDef) (string, error) {
 			}
 
 			// Struct fields must be fundamental types, enums, or other structs
+			if fSig.Field.ByRef || fSig.Field.Pointers > 0 || fSig.Field.Type.Kind == types.ELEMENT_TYPE_FNPTR {
+				// pointers can not be part of a WinRT type signature
+				return "", fmt.Errorf("struct %s has a pointer field %s, which has no signature", typeDef.TypeName, f.Name)
+			}
 			if fSig.Field.Type.Kind == types.ELEMENT_TYPE_VALUETYPE {
 				// this is an struct or an enum
 				fieldType, err := g.elementType(typeDef.Ctx(), fSig.Field)
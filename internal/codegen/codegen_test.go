@@ -0,0 +1,743 @@
+package codegen
+
+import (
+	"bytes"
+	"flag"
+	"go/format"
+	"os"
+	"path/filepath"
+	"testing"
+	"text/template"
+
+	"github.com/go-kit/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tdakkota/win32metadata/types"
+	"github.com/waylyrics/winrt-go/internal/winmd"
+)
+
+var update = flag.Bool("update", false, "update the golden files")
+
+// Test the code generated for the element type modifiers, using a method with a single parameter.
+func TestElementTypeGolden(t *testing.T) {
+	u4 := types.ElementType{Kind: types.ELEMENT_TYPE_U4}
+
+	tests := []struct {
+		name  string
+		e     types.Element
+		isOut bool
+	}{
+		{name: "byref_in", e: types.Element{Type: u4, ByRef: true}},
+		{name: "ptr_in", e: types.Element{Type: u4, Pointers: 1}},
+		{name: "ptr_out", e: types.Element{Type: u4, Pointers: 1}, isOut: true},
+		{name: "ptr_ptr_in", e: types.Element{Type: u4, Pointers: 2}},
+		{name: "fnptr_in", e: types.Element{Type: types.ElementType{Kind: types.ELEMENT_TYPE_FNPTR}}},
+		{name: "fnptr_out", e: types.Element{Type: types.ElementType{Kind: types.ELEMENT_TYPE_FNPTR}}, isOut: true},
+		{name: "array_in", e: types.Element{Type: u4, IsArray: true}},
+		{name: "string_in", e: types.Element{Type: types.ElementType{Kind: types.ELEMENT_TYPE_STRING}}},
+		{name: "string_out", e: types.Element{Type: types.ElementType{Kind: types.ELEMENT_TYPE_STRING}}, isOut: true},
+		{name: "char_out", e: types.Element{Type: types.ElementType{Kind: types.ELEMENT_TYPE_CHAR}}, isOut: true},
+		{name: "float_in", e: types.Element{Type: types.ElementType{Kind: types.ELEMENT_TYPE_R8}}},
+	}
+
+	tmpl, err := getTemplates()
+	require.NoError(t, err)
+
+	g := &generator{logger: log.NewNopLogger()}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			paramType, err := g.elementType(nil, tt.e)
+			require.NoError(t, err)
+
+			f := genFunc{
+				Name:      "Test",
+				Implement: true,
+				FuncOwner: "ITest",
+				InParams: []*genParam{{
+					callerPackage: "test",
+					varName:       "value",
+					Type:          paramType,
+					IsOut:         tt.isOut,
+				}},
+			}
+
+			assertGolden(t, tmpl, f, filepath.Join("testdata", "elementtype", tt.name+".golden"))
+		})
+	}
+}
+
+// Test which element types are reference counted objects, kept alive by the delegates that run their callback later.
+func TestElementTypeIsObject(t *testing.T) {
+	object := types.ElementType{Kind: types.ELEMENT_TYPE_OBJECT}
+
+	tests := []struct {
+		name string
+		e    types.Element
+		want bool
+	}{
+		{name: "object", e: types.Element{Type: object}, want: true},
+		{name: "object_byref", e: types.Element{Type: object, ByRef: true}},
+		{name: "object_array", e: types.Element{Type: object, IsArray: true}},
+		{name: "u4", e: types.Element{Type: types.ElementType{Kind: types.ELEMENT_TYPE_U4}}},
+		{name: "string", e: types.Element{Type: types.ElementType{Kind: types.ELEMENT_TYPE_STRING}}},
+	}
+
+	g := &generator{logger: log.NewNopLogger()}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			paramType, err := g.elementType(nil, tt.e)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, paramType.IsObject())
+		})
+	}
+}
+
+// Test the code generated for the three array patterns: pass, fill and receive arrays.
+func TestArrayGolden(t *testing.T) {
+	u4 := &genParamType{name: "uint32", IsPrimitive: true, IsArray: true, defaultValue: genDefaultValue{"nil", true}}
+	str := &genParamType{name: "string", IsPrimitive: true, IsArray: true, defaultValue: genDefaultValue{"nil", true}}
+	itf := &genParamType{namespace: "Windows.Foundation", name: "IClosable", IsPointer: true, IsArray: true, defaultValue: genDefaultValue{"nil", true}}
+
+	tests := []struct {
+		name      string
+		paramType *genParamType
+		isOut     bool
+		isReceive bool
+		isReturn  bool
+	}{
+		{name: "pass_primitive", paramType: u4},
+		{name: "pass_string", paramType: str},
+		{name: "pass_interface", paramType: itf},
+		{name: "fill_primitive", paramType: u4, isOut: true},
+		{name: "fill_string", paramType: str, isOut: true},
+		{name: "receive_primitive", paramType: u4, isOut: true, isReceive: true},
+		{name: "receive_string", paramType: str, isOut: true, isReceive: true},
+		{name: "receive_interface", paramType: itf, isOut: true, isReceive: true},
+		{name: "return_interface", paramType: itf, isOut: true, isReceive: true, isReturn: true},
+	}
+
+	tmpl, err := getTemplates()
+	require.NoError(t, err)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			varName := "items"
+			if tt.isReturn {
+				varName = "out"
+			}
+			array := &genParam{
+				callerPackage:  "test",
+				varName:        varName,
+				Type:           tt.paramType,
+				IsOut:          tt.isOut,
+				isReceiveArray: tt.isReceive,
+			}
+			params := []*genParam{arraySizeParam("test", varName, array), array}
+
+			f := genFunc{
+				Name:      "Test",
+				Implement: true,
+				FuncOwner: "ITest",
+			}
+			if tt.isReturn {
+				f.ReturnParams = params
+			} else {
+				f.InParams = params
+			}
+
+			assertGolden(t, tmpl, f, filepath.Join("testdata", "array", tt.name+".golden"))
+		})
+	}
+}
+
+// Test the code generated for the type params of the typed wrappers, whose values are converted at runtime.
+func TestTypeParamGolden(t *testing.T) {
+	generic := types.ElementType{Kind: types.ELEMENT_TYPE_VAR}
+
+	tests := []struct {
+		name      string
+		e         types.Element
+		isOut     bool
+		isReceive bool
+	}{
+		{name: "in", e: types.Element{Type: generic}},
+		{name: "out", e: types.Element{Type: generic}, isOut: true},
+		{name: "pass_array", e: types.Element{Type: generic, IsArray: true}},
+		{name: "fill_array", e: types.Element{Type: generic, IsArray: true}, isOut: true},
+		{name: "receive_array", e: types.Element{Type: generic, IsArray: true}, isOut: true, isReceive: true},
+	}
+
+	tmpl, err := getTemplates()
+	require.NoError(t, err)
+
+	g := &generator{logger: log.NewNopLogger()}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			paramType, err := g.elementType(nil, tt.e)
+			require.NoError(t, err)
+
+			param := &genParam{callerPackage: "test", varName: "value", Type: paramType, IsOut: tt.isOut, isReceiveArray: tt.isReceive}
+			params := []*genParam{param}
+			if paramType.IsArray {
+				params = []*genParam{arraySizeParam("test", "value", param), param}
+			}
+
+			f := &genFunc{Name: "Test", Implement: true, FuncOwner: "ITest", InParams: params}
+			typed, ok := typedGenFunc(f, []string{"T"}, nil)
+			require.True(t, ok)
+
+			assertGolden(t, tmpl, *typed, filepath.Join("testdata", "typeparam", tt.name+".golden"))
+		})
+	}
+}
+
+// Test the code generated for enums and flag enums.
+func TestEnumGolden(t *testing.T) {
+	tests := []struct {
+		name string
+		enum genEnum
+	}{
+		{
+			name: "enum",
+			enum: genEnum{
+				Name:      "MediaPlaybackStatus",
+				Type:      "int32",
+				Signature: "enum(Windows.Media.MediaPlaybackStatus;i4)",
+				Values: []*genEnumValue{
+					{Name: "MediaPlaybackStatusClosed", Value: "0", FieldName: "Closed"},
+					{Name: "MediaPlaybackStatusPlaying", Value: "3", FieldName: "Playing"},
+				},
+			},
+		},
+		{
+			name: "flags",
+			enum: genEnum{
+				Name:      "FileAttributes",
+				Type:      "uint32",
+				Signature: "enum(Windows.Storage.FileAttributes;u4)",
+				Values: []*genEnumValue{
+					{Name: "FileAttributesNormal", Value: "0", FieldName: "Normal"},
+					{Name: "FileAttributesReadOnly", Value: "1", FieldName: "ReadOnly"},
+					{Name: "FileAttributesDirectory", Value: "16", FieldName: "Directory"},
+				},
+				IsFlags: true,
+			},
+		},
+		{
+			// the zero value has no name
+			name: "flags_no_zero",
+			enum: genEnum{
+				Name:      "SystemMediaTransportControlsButtons",
+				Type:      "uint32",
+				Signature: "enum(Windows.Test.SystemMediaTransportControlsButtons;u4)",
+				Values: []*genEnumValue{
+					{Name: "SystemMediaTransportControlsButtonsPlay", Value: "1", FieldName: "Play"},
+					{Name: "SystemMediaTransportControlsButtonsPause", Value: "2", FieldName: "Pause"},
+				},
+				IsFlags: true,
+			},
+		},
+	}
+
+	tmpl, err := getTemplates()
+	require.NoError(t, err)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertGoldenTemplate(t, tmpl, "enum.tmpl", tt.enum, filepath.Join("testdata", "enum", tt.name+".golden"))
+		})
+	}
+}
+
+// Test the code generated for the methods of activation and composable factories.
+func TestConstructorGolden(t *testing.T) {
+	str := &genParamType{name: "string", IsPrimitive: true, defaultValue: genDefaultValue{"\"\"", true}}
+	obj := &genParamType{namespace: "unsafe", name: "Pointer", defaultValue: genDefaultValue{"nil", true}}
+	class := factoryReturnType(t, "Windows.Foundation.IUriRuntimeClassFactory", "CreateUri")
+
+	tests := []struct {
+		name     string
+		inParams []*genParam
+	}{
+		{
+			name:     "activatable",
+			inParams: []*genParam{{callerPackage: "foundation", varName: "uri", Type: str}},
+		},
+		{
+			name: "composable",
+			inParams: []*genParam{
+				{callerPackage: "foundation", varName: "uri", Type: str},
+				{callerPackage: "foundation", varName: "baseInterface", Type: obj, isCompositionArg: true},
+				{callerPackage: "foundation", varName: "innerInterface", Type: obj, IsOut: true, isCompositionArg: true},
+			},
+		},
+	}
+
+	tmpl, err := getTemplates()
+	require.NoError(t, err)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := genFunc{
+				Name:               "CreateUri",
+				Implement:          true,
+				FuncOwner:          "IUriRuntimeClassFactory",
+				InParams:           tt.inParams,
+				ReturnParams:       []*genParam{{callerPackage: "foundation", varName: "value", Type: class, IsOut: true}},
+				ExclusiveTo:        "Windows.Foundation.Uri",
+				RequiresActivation: true,
+				Constructor:        "NewUri",
+			}
+
+			assertGolden(t, tmpl, f, filepath.Join("testdata", "constructor", tt.name+".golden"))
+		})
+	}
+}
+
+// factoryReturnType builds the return type of a factory method through the generator,
+// so the fixture matches what real generation passes to the templates.
+func factoryReturnType(t *testing.T, factory, method string) *genParamType {
+	t.Helper()
+
+	store, err := winmd.NewStore(log.NewNopLogger())
+	require.NoError(t, err)
+	g := &generator{logger: log.NewNopLogger(), mdStore: store, methodFilter: NewMethodFilter(nil)}
+
+	td, err := store.TypeDefByName(factory)
+	require.NoError(t, err)
+	methods, err := td.ResolveMethodList(td.Ctx())
+	require.NoError(t, err)
+	for _, m := range methods {
+		if m.Name != method {
+			continue
+		}
+		mr, err := m.Signature.Reader().Method(td.Ctx())
+		require.NoError(t, err)
+		elType, err := g.elementType(td.Ctx(), mr.Return)
+		require.NoError(t, err)
+		return elType
+	}
+	t.Fatalf("method %s not found in %s", method, factory)
+	return nil
+}
+
+// Test the layout of the runtime classes: with the interface cache they embed a winrt.Object,
+// without it they keep the layout of the WinRT object.
+func TestClassGolden(t *testing.T) {
+	str := &genParamType{name: "string", IsPrimitive: true, defaultValue: genDefaultValue{"\"\"", true}}
+
+	tmpl, err := getTemplates()
+	require.NoError(t, err)
+
+	for _, cache := range []bool{true, false} {
+		name := "cache"
+		if !cache {
+			name = "no_cache"
+		}
+		t.Run(name, func(t *testing.T) {
+			class := &genClass{
+				Name:                "Widget",
+				Signature:           "rc(Windows.Test.Widget;{99fa3ff4-1742-42a6-902e-087d41f965ec})",
+				FullyQualifiedName:  "Windows.Test.Widget",
+				HasEmptyConstructor: true,
+				CacheInterfaces:     cache,
+				ImplInterfaces: []*genInterface{{
+					Name: "IWidget",
+					Funcs: []*genFunc{{
+						Name:          "GetName",
+						Implement:     true,
+						FuncOwner:     "IWidget",
+						InheritedFrom: winmd.QualifiedID{Name: "IWidget"},
+						ReturnParams:  []*genParam{{callerPackage: "test", varName: "out", Type: str, IsOut: true}},
+					}},
+				}},
+			}
+
+			assertGoldenTemplate(t, tmpl, "class.tmpl", class, filepath.Join("testdata", "class", name+".golden"))
+		})
+	}
+}
+
+// Test the code generated for the params of runtime classes, which are wrapped in a winrt.Object.
+func TestClassParamGolden(t *testing.T) {
+	class := &genParamType{namespace: "Windows.Test", name: "Widget", IsPointer: true, isObject: true, isClass: true, defaultValue: genDefaultValue{"nil", true}}
+
+	tmpl, err := getTemplates()
+	require.NoError(t, err)
+
+	for _, isOut := range []bool{false, true} {
+		name := "in"
+		if isOut {
+			name = "out"
+		}
+		t.Run(name, func(t *testing.T) {
+			f := genFunc{
+				Name:      "Test",
+				Implement: true,
+				FuncOwner: "ITest",
+				InParams:  []*genParam{{callerPackage: "other", varName: "value", Type: class, IsOut: isOut}},
+			}
+
+			assertGolden(t, tmpl, f, filepath.Join("testdata", "class", name+".golden"))
+		})
+	}
+}
+
+// Test the code generated for delegates, whose callback is run inline or by a dispatcher.
+func TestDelegateGolden(t *testing.T) {
+	generic := func(index uint32) *genParamType {
+		return &genParamType{namespace: "unsafe", name: "Pointer", IsGeneric: true, genericIndex: index, defaultValue: genDefaultValue{"nil", true}}
+	}
+	class := &genParamType{namespace: "Windows.Test", name: "Widget", IsPointer: true, isObject: true, isClass: true, defaultValue: genDefaultValue{"nil", true}}
+	str := &genParamType{name: "string", IsPrimitive: true, defaultValue: genDefaultValue{"\"\"", true}}
+
+	tests := []struct {
+		name     string
+		inParams []*genParam
+	}{
+		{
+			name: "parameterized",
+			inParams: []*genParam{
+				{callerPackage: "test", varName: "sender", Type: generic(0)},
+				{callerPackage: "test", varName: "args", Type: generic(1)},
+			},
+		},
+		{
+			name: "objects",
+			inParams: []*genParam{
+				{callerPackage: "test", varName: "sender", Type: class},
+				{callerPackage: "test", varName: "value", Type: objectType()},
+				{callerPackage: "test", varName: "name", Type: str},
+			},
+		},
+	}
+
+	tmpl, err := getTemplates()
+	require.NoError(t, err)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			delegate := genDelegate{
+				Name:      "TestHandler",
+				GUID:      "9de1c534-6ae1-11e0-84e1-18a905bcc53f",
+				Signature: "delegate({9de1c534-6ae1-11e0-84e1-18a905bcc53f})",
+				InParams:  tt.inParams,
+			}
+
+			assertGoldenTemplate(t, tmpl, "delegate.tmpl", &delegate, filepath.Join("testdata", "delegate", tt.name+".golden"))
+		})
+	}
+}
+
+// Test the code generated for DateTime and TimeSpan, and for the methods using the Go time types instead.
+func TestTimeGolden(t *testing.T) {
+	dateTime := &genParamType{namespace: "Windows.Foundation", name: "DateTime", defaultValue: genDefaultValue{"DateTime{}", false}}
+	timeSpan := &genParamType{namespace: "Windows.Foundation", name: "TimeSpan", defaultValue: genDefaultValue{"TimeSpan{}", false}}
+
+	tmpl, err := getTemplates()
+	require.NoError(t, err)
+
+	funcs := []struct {
+		name      string
+		paramType *genParamType
+		isOut     bool
+	}{
+		{name: "in_struct", paramType: timeSpan},
+		{name: "in_time", paramType: goTimeType(dateTime)},
+		{name: "in_duration", paramType: goTimeType(timeSpan)},
+		{name: "return_time", paramType: goTimeType(dateTime), isOut: true},
+		{name: "return_duration", paramType: goTimeType(timeSpan), isOut: true},
+	}
+	for _, tt := range funcs {
+		t.Run(tt.name, func(t *testing.T) {
+			p := &genParam{callerPackage: "test", varName: "value", Type: tt.paramType, IsOut: tt.isOut}
+			f := genFunc{
+				Name:      "Test",
+				Implement: true,
+				FuncOwner: "ITest",
+			}
+			if tt.isOut {
+				f.ReturnParams = []*genParam{p}
+			} else {
+				f.InParams = []*genParam{p}
+			}
+
+			assertGolden(t, tmpl, f, filepath.Join("testdata", "time", tt.name+".golden"))
+		})
+	}
+
+	structs := []genStruct{
+		{
+			Name:           "DateTime",
+			Signature:      "struct(Windows.Foundation.DateTime;i8)",
+			Fields:         []*genParam{{callerPackage: "foundation", varName: "UniversalTime", Type: &genParamType{name: "int64", IsPrimitive: true}}},
+			TimeConversion: "Time",
+		},
+		{
+			Name:           "TimeSpan",
+			Signature:      "struct(Windows.Foundation.TimeSpan;i8)",
+			Fields:         []*genParam{{callerPackage: "foundation", varName: "Duration", Type: &genParamType{name: "int64", IsPrimitive: true}}},
+			TimeConversion: "Duration",
+		},
+	}
+	for _, s := range structs {
+		t.Run(s.Name, func(t *testing.T) {
+			assertGoldenTemplate(t, tmpl, "struct.tmpl", s, filepath.Join("testdata", "time", s.Name+".golden"))
+		})
+	}
+}
+
+// Test the code generated for the methods that add event handlers when generating Go-style accessors.
+func TestEventGolden(t *testing.T) {
+	handler := &genParamType{namespace: "Windows.Foundation", name: "TypedEventHandler", IsPointer: true, defaultValue: genDefaultValue{"nil", true}}
+	token := &genParamType{namespace: "Windows.Foundation", name: "EventRegistrationToken", defaultValue: genDefaultValue{"EventRegistrationToken{}", false}}
+
+	tests := []struct {
+		name               string
+		requiresActivation bool
+	}{
+		{name: "instance"},
+		{name: "static", requiresActivation: true},
+	}
+
+	tmpl, err := getTemplates()
+	require.NoError(t, err)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			remover := &genFunc{
+				Name:               "OffChanged",
+				Implement:          true,
+				FuncOwner:          "ITest",
+				InParams:           []*genParam{{callerPackage: "test", varName: "token", Type: token}},
+				ExclusiveTo:        "Windows.Test.Widget",
+				RequiresActivation: tt.requiresActivation,
+			}
+			f := genFunc{
+				Name:               "OnChanged",
+				Implement:          true,
+				FuncOwner:          "ITest",
+				InParams:           []*genParam{{callerPackage: "test", varName: "handler", Type: handler}},
+				ReturnParams:       []*genParam{{callerPackage: "test", varName: "out", Type: token, IsOut: true, isEventToken: true}},
+				ExclusiveTo:        "Windows.Test.Widget",
+				RequiresActivation: tt.requiresActivation,
+				EventRemover:       remover,
+			}
+
+			assertGolden(t, tmpl, f, filepath.Join("testdata", "event", tt.name+".golden"))
+		})
+	}
+}
+
+func TestCreateGenEvents(t *testing.T) {
+	store, err := winmd.NewStore(log.NewNopLogger())
+	require.NoError(t, err)
+	g := &generator{logger: log.NewNopLogger(), mdStore: store, methodFilter: NewMethodFilter(nil)}
+
+	td, err := store.TypeDefByName("Windows.Media.ISystemMediaTransportControls")
+	require.NoError(t, err)
+	iface, err := g.createGenInterface(td, false)
+	require.NoError(t, err)
+
+	require.Len(t, iface.Events, 2)
+	event := iface.Events[0]
+	assert.Equal(t, "ButtonPressed", event.Name)
+	assert.Equal(t, "add_ButtonPressed", event.Adder.Name)
+	assert.Equal(t, "remove_ButtonPressed", event.Remover.Name)
+	assert.Equal(t, "TypedEventHandler", event.Delegate)
+	assert.Equal(t, "foundation", event.DelegatePackage)
+	// TypedEventHandler<SystemMediaTransportControls, SystemMediaTransportControlsButtonPressedEventArgs>
+	assert.Equal(t, "{0557E996-7B23-5BAE-AA81-EA0D671143A4}", event.IID)
+
+	require.Len(t, event.Params, 2)
+	assert.Equal(t, "SystemMediaTransportControls", event.Params[0].Typed.GoTypeName())
+	assert.Equal(t, "SystemMediaTransportControlsButtonPressedEventArgs", event.Params[1].Typed.GoTypeName())
+	assert.True(t, event.Params[1].IsCast())
+	assert.Equal(t, []bool{true, true}, event.ObjectTypeArgs)
+}
+
+func TestInterfaceIsSupported(t *testing.T) {
+	store, err := winmd.NewStore(log.NewNopLogger())
+	require.NoError(t, err)
+	g := &generator{logger: log.NewNopLogger(), mdStore: store, methodFilter: NewMethodFilter(nil)}
+
+	tmpl, err := getTemplates()
+	require.NoError(t, err)
+
+	render := func(name string, requiresActivation bool) string {
+		td, err := store.TypeDefByName(name)
+		require.NoError(t, err)
+		iface, err := g.createGenInterface(td, requiresActivation)
+		require.NoError(t, err)
+
+		var buf bytes.Buffer
+		require.NoError(t, tmpl.ExecuteTemplate(&buf, "interface.tmpl", iface))
+		return buf.String()
+	}
+
+	// exclusive interfaces are unexported, but their probe is named like the Has method of the class
+	code := render("Windows.Media.ISystemMediaTransportControls2", false)
+	assert.Contains(t, code, "func IsSupportedISystemMediaTransportControls2(obj *ole.IUnknown) bool {")
+	assert.Contains(t, code, "winrt.IsSupported(obj, &IIDiSystemMediaTransportControls2)")
+
+	// statics interfaces are called on the activation factory, there's no object to probe
+	code = render("Windows.Media.ISystemMediaTransportControlsStatics", true)
+	assert.NotContains(t, code, "func IsSupported")
+}
+
+// Test which dependencies of the generated files are generated too.
+func TestDependencies(t *testing.T) {
+	store, err := winmd.NewStore(log.NewNopLogger())
+	require.NoError(t, err)
+
+	param := func(namespace, name string) *genParam {
+		return &genParam{callerPackage: "test", varName: "value", Type: &genParamType{namespace: namespace, name: name}}
+	}
+	u4 := &genParam{callerPackage: "test", varName: "value", Type: &genParamType{name: "uint32", IsPrimitive: true}}
+	g := &generator{
+		class:   "Windows.Test.Widget",
+		logger:  log.NewNopLogger(),
+		mdStore: store,
+		genDataFiles: []*genDataFile{{Data: genData{Delegates: []*genDelegate{{InParams: []*genParam{
+			param("Windows.Foundation", "Uri"),
+			param("Windows.Storage", "StorageFile"),
+			param("Windows.Media", "ISystemMediaTransportControls"),
+			param("unsafe", "Pointer"),
+			u4,
+		}}}}}},
+	}
+
+	deps := g.dependencies()
+	assert.Equal(t, []string{
+		"Windows.Foundation.Uri",
+		"Windows.Storage.StorageFile",
+		"Windows.Media.ISystemMediaTransportControls",
+		"unsafe.Pointer",
+	}, deps)
+
+	tests := []struct {
+		name   string
+		denied []string
+		want   []string
+	}{
+		{name: "no_deny_list", want: []string{"Windows.Foundation.Uri", "Windows.Storage.StorageFile"}},
+		{name: "exact", denied: []string{"Windows.Foundation.Uri"}, want: []string{"Windows.Storage.StorageFile"}},
+		{name: "prefix", denied: []string{"Windows.Storage.*"}, want: []string{"Windows.Foundation.Uri"}},
+		{name: "all", denied: []string{"Windows.*"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := NewConfig()
+			for _, class := range tt.denied {
+				cfg.AddDeniedClass(class)
+			}
+
+			var required []string
+			for _, dep := range deps {
+				if g.isDependencyRequired(cfg, dep) {
+					required = append(required, dep)
+				}
+			}
+			assert.Equal(t, tt.want, required)
+		})
+	}
+}
+
+func TestAccessorName(t *testing.T) {
+	tests := []struct {
+		semantics types.MethodSemanticsAttributes
+		expected  string
+	}{
+		{semantics: 0x2, expected: "Title"},     // getter
+		{semantics: 0x1, expected: "SetTitle"},  // setter
+		{semantics: 0x8, expected: "OnTitle"},   // add on
+		{semantics: 0x10, expected: "OffTitle"}, // remove on
+		{semantics: 0x4, expected: "get_Title"}, // other
+	}
+
+	for _, tt := range tests {
+		name := accessorName(winmd.MethodSemantics{Attributes: tt.semantics, Name: "Title"}, "get_Title")
+		assert.Equal(t, tt.expected, name)
+	}
+}
+
+func TestConstructorName(t *testing.T) {
+	tests := []struct {
+		overload string
+		used     map[string]bool
+		expected string
+	}{
+		{overload: "CreateInstance", expected: "NewWidget"},
+		{overload: "CreateWidget", expected: "NewWidget"},
+		{overload: "CreateWithName", expected: "NewWidgetWithName"},
+		{overload: "CreateFromFile", expected: "NewWidgetWithFromFile"},
+		// the empty constructor takes precedence
+		{overload: "CreateInstance", used: map[string]bool{"NewWidget": true}, expected: "NewWidgetWithCreateInstance"},
+		{overload: "CreateWithName", used: map[string]bool{"NewWidgetWithName": true}, expected: "NewWidgetWithCreateWithName"},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.expected, constructorName("Widget", tt.overload, tt.used), tt.overload)
+	}
+}
+
+// assertGolden renders the given function and compares it with the contents of the golden file.
+func assertGolden(t *testing.T, tmpl *template.Template, f genFunc, golden string) {
+	t.Helper()
+	assertGoldenTemplate(t, tmpl, "func.tmpl", f, golden)
+}
+
+// assertGoldenTemplate renders the given template and compares it with the contents of the golden file.
+func assertGoldenTemplate(t *testing.T, tmpl *template.Template, name string, data any, golden string) {
+	t.Helper()
+
+	buf := bytes.NewBufferString("package test\n")
+	require.NoError(t, tmpl.ExecuteTemplate(buf, name, data))
+	got, err := format.Source(buf.Bytes())
+	require.NoError(t, err)
+
+	if *update {
+		require.NoError(t, os.MkdirAll(filepath.Dir(golden), 0o750))
+		require.NoError(t, os.WriteFile(golden, got, 0o600))
+	}
+
+	expected, err := os.ReadFile(golden)
+	require.NoError(t, err)
+	assert.Equal(t, string(expected), string(got))
+}
+
+func TestElementTypeUnsupported(t *testing.T) {
+	g := &generator{logger: log.NewNopLogger()}
+
+	_, err := g.elementType(nil, types.Element{Type: types.ElementType{Kind: types.ELEMENT_TYPE_TYPEDBYREF}})
+	assert.Error(t, err)
+
+	// pointers to strings (HSTRING) have no Go representation
+	_, err = g.elementType(nil, types.Element{Type: types.ElementType{Kind: types.ELEMENT_TYPE_STRING}, Pointers: 1})
+	assert.Error(t, err)
+}
+
+func TestGUIDLiteral(t *testing.T) {
+	tests := []struct {
+		guid string
+		want string
+	}{
+		{
+			guid: "99fa3ff4-1742-42a6-902e-087d41f965ec",
+			want: "ole.GUID{Data1: 0x99fa3ff4, Data2: 0x1742, Data3: 0x42a6, Data4: [8]byte{0x90, 0x2e, 0x08, 0x7d, 0x41, 0xf9, 0x65, 0xec}}",
+		},
+		{
+			// the IIDs of the parameterized interfaces are formatted with braces
+			guid: "{0557E996-7B23-5BAE-AA81-EA0D671143A4}",
+			want: "ole.GUID{Data1: 0x0557e996, Data2: 0x7b23, Data3: 0x5bae, Data4: [8]byte{0xaa, 0x81, 0xea, 0x0d, 0x67, 0x11, 0x43, 0xa4}}",
+		},
+	}
+	for _, tt := range tests {
+		literal, err := guidLiteral(tt.guid)
+		require.NoError(t, err)
+		assert.Equal(t, tt.want, literal)
+	}
+
+	_, err := guidLiteral("not a guid")
+	assert.Error(t, err)
+}
@@ -1,17 +1,125 @@
 package codegen
 
-import "fmt"
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/waylyrics/winrt-go/internal/winmd"
+)
 
 // Config is the configuration for the code generation.
 type Config struct {
-	Debug         bool
-	Class         string
+	Debug bool
+
+	// WithDeps enables the generation of all the types the generated classes depend on.
+	WithDeps bool
+
+	// NoInterfaceCache disables the interface cache of the generated runtime classes, so their methods
+	// query and release the interface they forward to on every call.
+	NoInterfaceCache bool
+
+	// GoAccessors generates the methods of properties and events with Go-style names: X for property getters,
+	// SetX for setters, and OnX for events, which return a winrt.EventToken to remove the handler.
+	GoAccessors bool
+
+	// GoTime uses time.Time and time.Duration in the method signatures instead of the
+	// Windows.Foundation.DateTime and Windows.Foundation.TimeSpan structs.
+	GoTime bool
+
+	classes       []string
 	methodFilters []string
+	denyList      []string
+	winmdPaths    []string
+
+	// maxContracts holds the newest version allowed for each API contract, see AddMaxContract.
+	maxContracts map[string]uint32
+
+	// classMethodFilters holds the method filters that only apply to a single class.
+	classMethodFilters map[string][]string
 }
 
 // NewConfig returns a new Config with default values.
 func NewConfig() *Config {
-	return &Config{}
+	return &Config{
+		classMethodFilters: make(map[string][]string),
+		maxContracts:       make(map[string]uint32),
+	}
+}
+
+// AddClass adds a class to the list of classes to generate. Classes that were already added are ignored.
+func (cfg *Config) AddClass(class string) {
+	for _, c := range cfg.classes {
+		if c == class {
+			return
+		}
+	}
+	cfg.classes = append(cfg.classes, class)
+}
+
+// Classes returns the classes to generate, in the order they were added.
+func (cfg *Config) Classes() []string {
+	return cfg.classes
+}
+
+// AddWinMDPath adds a .winmd file, or a directory containing .winmd files, to load in addition to the
+// embedded windows metadata. The types defined in these files take precedence over the embedded ones.
+func (cfg *Config) AddWinMDPath(path string) {
+	cfg.winmdPaths = append(cfg.winmdPaths, path)
+}
+
+// WinMDPaths returns the additional metadata paths, in the order they were added.
+func (cfg *Config) WinMDPaths() []string {
+	return cfg.winmdPaths
+}
+
+// AddMaxContract limits the generated code to the given version of an API contract. The value has the format
+// 'Contract=Major[.Minor]', e.g. 'Windows.Foundation.UniversalApiContract=10'. The types and methods
+// introduced in a newer version of the contract are not generated.
+func (cfg *Config) AddMaxContract(value string) error {
+	contract, version, ok := strings.Cut(value, "=")
+	if !ok || contract == "" {
+		return fmt.Errorf("invalid max contract %q, expected 'Contract=Major[.Minor]'", value)
+	}
+
+	majorStr, minorStr, hasMinor := strings.Cut(version, ".")
+	major, err := strconv.ParseUint(majorStr, 10, 16)
+	if err != nil {
+		return fmt.Errorf("invalid major version of max contract %q: %w", value, err)
+	}
+	var minor uint64
+	if hasMinor {
+		minor, err = strconv.ParseUint(minorStr, 10, 16)
+		if err != nil {
+			return fmt.Errorf("invalid minor version of max contract %q: %w", value, err)
+		}
+	}
+
+	cfg.maxContracts[contract] = winmd.NewContractVersion(contract, uint16(major), uint16(minor)).Version
+	return nil
+}
+
+// AddDeniedClass adds a class to the list of classes that must never be generated as a dependency.
+// A trailing '*' matches any class starting with the given prefix, e.g. 'Windows.Storage.*'.
+func (cfg *Config) AddDeniedClass(class string) {
+	cfg.denyList = append(cfg.denyList, class)
+}
+
+// IsDenied returns true if the given class matches any of the denied classes.
+func (cfg *Config) IsDenied(class string) bool {
+	for _, denied := range cfg.denyList {
+		if prefix := strings.TrimSuffix(denied, "*"); prefix != denied {
+			if strings.HasPrefix(class, prefix) {
+				return true
+			}
+		} else if denied == class {
+			return true
+		}
+	}
+	return false
 }
 
 // AddMethodFilter adds a method to the list of methodFilters to generate.
@@ -19,9 +127,55 @@ func (cfg *Config) AddMethodFilter(methodFilter string) {
 	cfg.methodFilters = append(cfg.methodFilters, methodFilter)
 }
 
-// MethodFilter creates and returns a new method filter for the current config.
-func (cfg *Config) MethodFilter() *MethodFilter {
-	return NewMethodFilter(cfg.methodFilters)
+// AddClassMethodFilter adds a method filter that only applies to the given class.
+func (cfg *Config) AddClassMethodFilter(class, methodFilter string) {
+	cfg.classMethodFilters[class] = append(cfg.classMethodFilters[class], methodFilter)
+}
+
+// MethodFilter creates and returns a new method filter for the given class.
+// The filters specific to the class are applied before the global ones.
+func (cfg *Config) MethodFilter(class string) *MethodFilter {
+	filters := make([]string, 0, len(cfg.classMethodFilters[class])+len(cfg.methodFilters))
+	filters = append(filters, cfg.classMethodFilters[class]...)
+	filters = append(filters, cfg.methodFilters...)
+	return NewMethodFilter(filters)
+}
+
+// LoadManifest reads the given manifest file and adds all the classes it contains to the Config.
+//
+// Every non empty line of the manifest contains a class name, optionally followed by the method
+// filters that apply to that class, separated by spaces. Lines starting with '#' are ignored:
+//
+//	# smtc
+//	Windows.Media.SystemMediaTransportControls
+//	Windows.Media.SystemMediaTransportControlsDisplayUpdater !CopyFromFileAsync !get_Thumbnail
+func (cfg *Config) LoadManifest(path string) error {
+	f, err := os.Open(filepath.Clean(path))
+	if err != nil {
+		return err
+	}
+	defer func() { _ = f.Close() }()
+
+	scanner := bufio.NewScanner(f)
+	for lineNo := 1; scanner.Scan(); lineNo++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		fields := strings.Fields(line)
+		class := fields[0]
+		if strings.HasPrefix(class, "!") {
+			return fmt.Errorf("%s:%d: expected a class name but found the method filter %s", path, lineNo, class)
+		}
+
+		cfg.AddClass(class)
+		for _, methodFilter := range fields[1:] {
+			cfg.AddClassMethodFilter(class, methodFilter)
+		}
+	}
+
+	return scanner.Err()
 }
 
 // Validate validates the Config and returns an error if there's any problem.
@@ -30,7 +184,7 @@ func (cfg *Config) Validate() error {
 		return fmt.Errorf("config is nil")
 	}
 
-	if cfg.Class == "" {
+	if len(cfg.classes) == 0 {
 		return fmt.Errorf("generated classes may not be empty")
 	}
 
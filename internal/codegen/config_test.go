@@ -0,0 +1,90 @@
+package codegen
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/waylyrics/winrt-go/internal/winmd"
+)
+
+func TestAddMaxContract(t *testing.T) {
+	cfg := NewConfig()
+	require.NoError(t, cfg.AddMaxContract("Windows.Foundation.UniversalApiContract=10"))
+	require.NoError(t, cfg.AddMaxContract("Windows.Foundation.FoundationContract=3.1"))
+
+	for _, value := range []string{"", "Windows.Foundation.UniversalApiContract", "=10", "Contract=ten", "Contract=10.x", "Contract=70000"} {
+		assert.Error(t, cfg.AddMaxContract(value), value)
+	}
+
+	g := &generator{maxContracts: cfg.maxContracts}
+	assert.True(t, g.isContractAllowed(nil))
+	assert.True(t, g.isContractAllowed(&winmd.ContractVersion{Contract: "Windows.Foundation.UniversalApiContract", Version: 10 << 16}))
+	assert.False(t, g.isContractAllowed(&winmd.ContractVersion{Contract: "Windows.Foundation.UniversalApiContract", Version: 11 << 16}))
+	assert.True(t, g.isContractAllowed(&winmd.ContractVersion{Contract: "Windows.Foundation.FoundationContract", Version: 3<<16 | 1}))
+	assert.False(t, g.isContractAllowed(&winmd.ContractVersion{Contract: "Windows.Foundation.FoundationContract", Version: 3<<16 | 2}))
+	assert.True(t, g.isContractAllowed(&winmd.ContractVersion{Contract: "Windows.Media.AppBroadcastContract", Version: 99 << 16}))
+}
+
+func TestLoadManifest(t *testing.T) {
+	manifest := `# comment
+
+Windows.Foundation.Uri !Equals !CombineUri
+	Windows.Media.MediaPlaybackType
+
+# comment after a blank line
+Windows.Foundation.Uri GetRawUri
+`
+	path := filepath.Join(t.TempDir(), "test.manifest")
+	require.NoError(t, os.WriteFile(path, []byte(manifest), 0o600))
+
+	cfg := NewConfig()
+	cfg.AddMethodFilter("!*")
+	require.NoError(t, cfg.LoadManifest(path))
+
+	assert.Equal(t, []string{"Windows.Foundation.Uri", "Windows.Media.MediaPlaybackType"}, cfg.Classes())
+
+	uri := cfg.MethodFilter("Windows.Foundation.Uri")
+	assert.False(t, uri.Filter("Equals"))
+	assert.False(t, uri.Filter("CombineUri"))
+	assert.True(t, uri.Filter("GetRawUri"))
+	assert.False(t, uri.Filter("GetHost"), "the global filters still apply")
+
+	assert.False(t, cfg.MethodFilter("Windows.Media.MediaPlaybackType").Filter("Equals"), "the filters only apply to their class")
+}
+
+func TestLoadManifestErrors(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "test.manifest")
+	require.NoError(t, os.WriteFile(path, []byte("Windows.Foundation.Uri\n!Equals Windows.Media.MediaPlaybackType\n"), 0o600))
+
+	err := NewConfig().LoadManifest(path)
+	assert.ErrorContains(t, err, "test.manifest:2: expected a class name but found the method filter !Equals")
+
+	assert.Error(t, NewConfig().LoadManifest(filepath.Join(t.TempDir(), "missing.manifest")))
+}
+
+func TestIsDenied(t *testing.T) {
+	cfg := NewConfig()
+	cfg.AddDeniedClass("Windows.Foundation.Uri")
+	cfg.AddDeniedClass("Windows.Storage.*")
+
+	tests := []struct {
+		class string
+		want  bool
+	}{
+		{class: "Windows.Foundation.Uri", want: true},
+		{class: "Windows.Foundation.UriRuntimeClass"},
+		{class: "Windows.Storage.StorageFile", want: true},
+		{class: "Windows.Storage.Streams.IBuffer", want: true},
+		{class: "Windows.StorageFile"},
+		{class: "Windows.Media.MediaPlaybackType"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.class, func(t *testing.T) {
+			assert.Equal(t, tt.want, cfg.IsDenied(tt.class))
+		})
+	}
+}
@@ -2,9 +2,13 @@ package codegen
 
 import (
 	"embed"
+	"fmt"
 	"strings"
 	"text/template"
 
+	"github.com/go-ole/go-ole"
+	"github.com/tdakkota/win32metadata/types"
+
 	"github.com/waylyrics/winrt-go/internal/winmd"
 )
 
@@ -44,21 +48,169 @@ func (g *genData) ComputeImports(typeDef *winmd.TypeDef) {
 	}
 }
 
+// Dependencies returns all the non primitive types referenced by the generated code.
+func (g *genData) Dependencies() []*genImport {
+	deps := make([]*genImport, 0)
+	for _, c := range g.Classes {
+		deps = append(deps, c.GetRequiredImports()...)
+		for _, i := range c.ImplInterfaces {
+			deps = append(deps, i.GetRequiredImports()...)
+		}
+	}
+	for _, i := range g.Interfaces {
+		deps = append(deps, i.GetRequiredImports()...)
+	}
+	for _, d := range g.Delegates {
+		deps = append(deps, paramDependencies(d.InParams)...)
+	}
+	for _, s := range g.Structs {
+		deps = append(deps, paramDependencies(s.Fields)...)
+	}
+	return deps
+}
+
+func paramDependencies(params []*genParam) []*genImport {
+	deps := make([]*genImport, 0)
+	for _, p := range params {
+		if !p.Type.IsPrimitive {
+			deps = append(deps, &genImport{p.Type.namespace, p.Type.name})
+		}
+	}
+	return deps
+}
+
 type genInterface struct {
-	Name      string
-	GUID      string
-	Signature string
-	Funcs     []*genFunc
+	Name            string
+	GUID            string
+	Signature       string
+	RequiresImports []*genImport
+	Funcs           []*genFunc
+
+	// RequiredInterfaces are the interfaces this interface extends. Their methods
+	// are forwarded using QueryInterface.
+	RequiredInterfaces []*genInterface
+
+	// Package is the package used to reference the interface from the class that implements it.
+	// It is empty if both belong to the same package.
+	Package string
+
+	// IsParameterized is true for generic interfaces. Their instance IID depends on
+	// the type arguments, so it needs to be provided by the caller.
+	IsParameterized bool
+
+	// RequiresActivation is true for the activation and statics interfaces of a class. They are
+	// called on the activation factory, so there's no object to probe for them.
+	RequiresActivation bool
+
+	// GenericParams holds the names of the generic params of parameterized interfaces.
+	// A typed wrapper is generated for these interfaces, using TypedFuncs as its methods.
+	GenericParams []string
+	TypedFuncs    []*genTypedFunc
+
+	// Contract is the API contract version that introduced the interface, if any.
+	Contract *winmd.ContractVersion
+
+	// Events are the typed subscription methods of the events of the interface.
+	Events []*genEvent
+
+	// instanceArgs holds the generic params of the requiring interface used to instantiate
+	// this interface, when this is a parameterized parent interface.
+	// It is nil if any of the type arguments is not a generic param.
+	instanceArgs []uint32
+}
+
+// TypeParams returns the type parameter list of the typed wrapper, e.g. "K any, V any".
+func (g *genInterface) TypeParams() string {
+	params := make([]string, 0, len(g.GenericParams))
+	for _, p := range g.GenericParams {
+		params = append(params, p+" any")
+	}
+	return strings.Join(params, ", ")
+}
+
+// TypeArgs returns the type arguments used to reference the typed wrapper, e.g. "K, V".
+func (g *genInterface) TypeArgs() string {
+	return strings.Join(g.GenericParams, ", ")
+}
+
+// genTypedFunc is a method of the typed wrapper of a parameterized interface.
+type genTypedFunc struct {
+	// Func is the wrapped function. When Direct is true, the generic params of the
+	// function have been replaced by the type parameters of the wrapper.
+	Func *genFunc
+
+	// Direct is true if the function uses the generic params, in which case the wrapper
+	// needs to call the vtable directly.
+	Direct bool
+
+	// ParentIID is the expression used to compute the IID of the parameterized parent
+	// interface the function is inherited from (if any).
+	ParentIID string
+
+	// ReturnType and ReturnWrap are used to return the typed wrapper of a parameterized
+	// interface instead of the raw interface.
+	ReturnType string
+	ReturnWrap string
 }
 
 func (g *genInterface) GetRequiredImports() []*genImport {
 	imports := make([]*genImport, 0)
+	if g.RequiresImports != nil {
+		imports = append(imports, g.RequiresImports...)
+	}
 	for _, f := range g.Funcs {
 		imports = append(imports, f.RequiresImports...)
 	}
+	for _, i := range g.RequiredInterfaces {
+		imports = append(imports, i.GetRequiredImports()...)
+	}
+	for _, e := range g.Events {
+		imports = append(imports, e.requiresImports...)
+	}
 	return imports
 }
 
+// genEvent is the typed subscription method of an event. The method wraps a Go func into
+// the handler delegate, whose IID is computed from the type arguments of the event.
+type genEvent struct {
+	Name string
+
+	// Adder and Remover are the methods that add and remove the handlers of the event.
+	Adder, Remover *genFunc
+
+	// IID is the IID of the handler delegate, computed from the Signature of its instance.
+	IID, Signature string
+
+	// Delegate is the name of the handler delegate, and DelegatePackage its package.
+	// DelegatePackage is empty if the delegate belongs to the package of the event.
+	Delegate, DelegatePackage string
+
+	Params []*genEventParam
+
+	// ObjectTypeArgs tells which of the type arguments of the delegate are objects, which the delegates
+	// created with a dispatcher keep alive until the handler returns.
+	ObjectTypeArgs []bool
+
+	requiresImports []*genImport
+}
+
+// TokenType returns the type of the token used to remove the handlers.
+func (e *genEvent) TokenType() string {
+	return e.Remover.InParams[0].GoTypeName()
+}
+
+// genEventParam is a param of the handler of an event.
+type genEventParam struct {
+	// Raw is the param of the delegate callback, and Typed is the param of the Go func.
+	// They only differ for the generic params of the delegate.
+	Raw, Typed *genParam
+}
+
+// IsCast returns true if the raw param needs to be converted to the type of the Go func param.
+func (p *genEventParam) IsCast() bool {
+	return p.Raw != p.Typed
+}
+
 type genClass struct {
 	Name                string
 	Signature           string
@@ -68,6 +220,10 @@ type genClass struct {
 	ExclusiveInterfaces []*genInterface
 	HasEmptyConstructor bool
 	IsAbstract          bool
+
+	// CacheInterfaces is true if the interfaces queried by the methods of the class are cached,
+	// instead of being queried and released on every call.
+	CacheInterfaces bool
 }
 
 func (g *genClass) GetRequiredImports() []*genImport {
@@ -92,15 +248,32 @@ type genDelegate struct {
 	ReturnParam *genParam // this may be nil
 }
 
+// IsParameterized returns true if the params of the delegate use its generic params. The delegates created
+// with a dispatcher need to know which type arguments are objects, to keep them alive.
+func (g *genDelegate) IsParameterized() bool {
+	for _, p := range g.InParams {
+		if p.Type.IsGeneric {
+			return true
+		}
+	}
+	return false
+}
+
 type genEnum struct {
 	Name      string
 	Type      string
 	Signature string
 	Values    []*genEnumValue
+
+	// IsFlags is true for enums marked with the FlagsAttribute, whose values can be combined.
+	IsFlags bool
 }
 type genEnumValue struct {
 	Name  string
 	Value string
+
+	// FieldName is the name of the value in the metadata, without the enum name prefix.
+	FieldName string
 }
 
 type genFunc struct {
@@ -112,11 +285,64 @@ type genFunc struct {
 	ReturnParams    []*genParam // this may be empty
 
 	// ExclusiveTo is the name of the class that this function is exclusive to.
-	// The funcion will be called statically using the RoGetActivationFactory function.
+	// The function will be called statically on the activation factory of the class, see winrt.GetActivationFactory.
 	ExclusiveTo        string
 	RequiresActivation bool
 
 	InheritedFrom winmd.QualifiedID
+
+	// Constructor is the name of the constructor generated for the methods of factory interfaces.
+	// It is empty for the rest of the functions.
+	Constructor string
+
+	// EventRemover is set for the methods that add event handlers when generating Go-style accessors.
+	// These methods return a winrt.EventToken, which removes the handler using the EventRemover.
+	EventRemover *genFunc
+
+	// semantics is the property or event of the method, only set when generating Go-style accessors.
+	semantics *winmd.MethodSemantics
+
+	// Contract is the API contract version that introduced the function, if any.
+	Contract *winmd.ContractVersion
+}
+
+// syscalls are the fixed-arity syscall functions, by the maximum number of args they take.
+var syscalls = []struct {
+	name    string
+	maxArgs int
+}{
+	{"Syscall", 3},
+	{"Syscall6", 6},
+	{"Syscall9", 9},
+	{"Syscall12", 12},
+	{"Syscall15", 15},
+	{"Syscall18", 18},
+}
+
+// genSyscall is the syscall function used to call a method. Unlike syscall.SyscallN, the fixed-arity
+// functions (syscall.Syscall, syscall.Syscall6...) do not take their args in a slice, so their args
+// are padded with zeros.
+type genSyscall struct {
+	Name string
+
+	// NArgs is the number of args of the method, including the this pointer. It is zero for SyscallN,
+	// which takes the args without their count.
+	NArgs int
+
+	// Padding has an element for each zero that pads the args.
+	Padding []struct{}
+}
+
+// Syscall returns the syscall function used to call the method.
+func (f genFunc) Syscall() genSyscall {
+	// each param is passed as a single arg, after the this pointer
+	nargs := 1 + len(f.InParams) + len(f.ReturnParams)
+	for _, s := range syscalls {
+		if nargs <= s.maxArgs {
+			return genSyscall{Name: s.name, NArgs: nargs, Padding: make([]struct{}, s.maxArgs-nargs)}
+		}
+	}
+	return genSyscall{Name: "SyscallN"}
 }
 
 type genImport struct {
@@ -152,9 +378,73 @@ type genParamType struct {
 	IsEnum             bool
 	UnderlyingEnumType string
 
+	// pointers holds the number of additional pointer indirections of pointer types
+	// (ELEMENT_TYPE_PTR and ELEMENT_TYPE_BYREF), e.g. 1 for a pointer to a class.
+	pointers int
+
+	// timeConversion is the Go time type (Time or Duration) that replaces the DateTime and TimeSpan structs
+	// in the method signatures, see goTimeType. These values are passed to the methods as 100-nanosecond ticks.
+	timeConversion string
+
+	// isObject is true for the WinRT objects (classes, interfaces, delegates and System.Object).
+	isObject bool
+
+	// isClass is true for the runtime classes, which are wrapped in a winrt.Object instead of being cast.
+	isClass bool
+
+	// IsGeneric is true for the generic params of parameterized types (ELEMENT_TYPE_VAR).
+	IsGeneric    bool
+	genericIndex uint32
+
+	// isTypeParam is true for the generic params replaced by a type parameter of a typed wrapper,
+	// whose values are converted at runtime depending on the type argument.
+	isTypeParam bool
+
+	// genericArgs holds the generic params used to instantiate a parameterized type
+	// (ELEMENT_TYPE_GENERICINST). It is nil if any of the type arguments is not a generic param.
+	genericArgs []uint32
+
+	// typeArgs holds the type arguments of instantiated parameterized types (ELEMENT_TYPE_GENERICINST).
+	typeArgs []types.ElementType
+
 	defaultValue genDefaultValue
 }
 
+// TimeConversion returns the name of the Go time type that replaces a DateTime or TimeSpan struct, which
+// is also the suffix of the winrt functions converting its ticks (e.g. winrt.TicksFromTime), or an empty string.
+func (t *genParamType) TimeConversion() string {
+	return t.timeConversion
+}
+
+// IsObject returns true if the type is a reference counted WinRT object.
+func (t *genParamType) IsObject() bool {
+	return t.isObject && !t.IsArray
+}
+
+// IsClass returns true if the type is a runtime class. The generated classes embed a winrt.Object holding
+// the WinRT object, so they are created using winrt.NewObject (or winrt.BorrowObject) instead of a cast.
+func (t *genParamType) IsClass() bool {
+	return t.isClass && !t.IsArray
+}
+
+// IsTypeParam returns true if the type is a type parameter of a typed wrapper, see isTypeParam.
+func (t *genParamType) IsTypeParam() bool {
+	return t.isTypeParam
+}
+
+// GenericIndex returns the index of the generic param, see IsGeneric.
+func (t *genParamType) GenericIndex() uint32 {
+	return t.genericIndex
+}
+
+// PointerPrefix returns the pointer indirections of the Go type.
+func (t *genParamType) PointerPrefix() string {
+	if !t.IsPointer {
+		return ""
+	}
+	return strings.Repeat("*", t.pointers+1)
+}
+
 // some of the variables are not public to avoid using them
 // by mistake in the code.
 type genParam struct {
@@ -165,6 +455,66 @@ type genParam struct {
 	Type *genParamType
 
 	IsOut bool
+
+	// arrayParam is set for the synthetic size params of arrays, and references the array param.
+	arrayParam *genParam
+
+	// isReceiveArray is true for out arrays carrying the BYREF marker, which are allocated by the callee.
+	isReceiveArray bool
+
+	// isCompositionArg is true for the outer and inner object params of composable factory methods.
+	isCompositionArg bool
+
+	// isEventToken is true for the registration tokens returned to the Go code as a winrt.EventToken.
+	isEventToken bool
+}
+
+// IsEventToken returns true for the event registration tokens that are returned as a *winrt.EventToken,
+// see genFunc.EventRemover. Their GoTypeName is the type of the registration token used in the call.
+func (g *genParam) IsEventToken() bool {
+	return g.isEventToken
+}
+
+// IsCompositionArg returns true for the outer (in) and inner (out) object params of composable factory
+// methods. These params are not part of the Go API: the constructors create non-aggregated objects.
+func (g *genParam) IsCompositionArg() bool {
+	return g.isCompositionArg
+}
+
+// IsArraySize returns true if the param is the size of an array param. These params are
+// not part of the Go API, the size is taken from the length of the array.
+func (g *genParam) IsArraySize() bool {
+	return g.arrayParam != nil
+}
+
+// ArrayParam returns the array param whose size is defined by this param.
+func (g *genParam) ArrayParam() *genParam {
+	return g.arrayParam
+}
+
+// IsPassArray returns true for arrays provided by the caller and read by the callee.
+func (g *genParam) IsPassArray() bool {
+	return g.Type.IsArray && !g.IsOut
+}
+
+// IsFillArray returns true for arrays provided by the caller and written by the callee.
+func (g *genParam) IsFillArray() bool {
+	return g.Type.IsArray && g.IsOut && !g.isReceiveArray
+}
+
+// IsReceiveArray returns true for arrays allocated by the callee and returned to the caller.
+func (g *genParam) IsReceiveArray() bool {
+	return g.Type.IsArray && g.isReceiveArray
+}
+
+// IsGoParam returns true if the param is a parameter of the generated Go method.
+func (g *genParam) IsGoParam() bool {
+	return !g.IsArraySize() && !g.isCompositionArg && (!g.IsOut || g.IsFillArray())
+}
+
+// IsGoReturn returns true if the param is a return value of the generated Go method.
+func (g *genParam) IsGoReturn() bool {
+	return !g.IsArraySize() && !g.isCompositionArg && g.IsOut && !g.IsFillArray()
 }
 
 func (g *genParam) GoVarName() string {
@@ -187,6 +537,10 @@ func (g *genParam) GoTypeName() string {
 }
 
 func (g *genParam) GoDefaultValue() string {
+	if g.isEventToken {
+		return "nil"
+	}
+
 	if g.Type.defaultValue.isPrimitive {
 		return g.Type.defaultValue.value
 	}
@@ -203,6 +557,10 @@ type genStruct struct {
 	Name      string
 	Signature string
 	Fields    []*genParam
+
+	// TimeConversion is set for the Windows.Foundation.DateTime (Time) and TimeSpan (Duration) structs,
+	// which get methods converting them to the Go time types.
+	TimeConversion string
 }
 
 //go:embed templates/*
@@ -223,7 +581,26 @@ func funcs() template.FuncMap {
 		"toLower": func(s string) string {
 			return strings.ToLower(s[:1]) + s[1:]
 		},
+		"toUpper": func(s string) string {
+			return strings.ToUpper(s[:1]) + s[1:]
+		},
+		"guidLiteral": guidLiteral,
+	}
+}
+
+// guidLiteral returns the ole.GUID composite literal of the given GUID, so the generated code does not parse it at runtime.
+func guidLiteral(guid string) (string, error) {
+	g := ole.NewGUID(guid)
+	if g == nil {
+		return "", fmt.Errorf("invalid GUID %q", guid)
+	}
+
+	data4 := make([]string, len(g.Data4))
+	for i, b := range g.Data4 {
+		data4[i] = fmt.Sprintf("0x%02x", b)
 	}
+	return fmt.Sprintf("ole.GUID{Data1: 0x%08x, Data2: 0x%04x, Data3: 0x%04x, Data4: [8]byte{%s}}",
+		g.Data1, g.Data2, g.Data3, strings.Join(data4, ", ")), nil
 }
 
 // funcName is used to generate the name of a function.
@@ -0,0 +1,97 @@
+package winmd
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/tdakkota/win32metadata/types"
+)
+
+// ContractVersion is the version of the API contract that introduced a type or a method.
+type ContractVersion struct {
+	// Contract is the full name of the API contract, e.g. Windows.Foundation.UniversalApiContract.
+	Contract string
+	// Version holds the major version in the high 16 bits, and the minor version in the low 16 bits.
+	Version uint32
+}
+
+// NewContractVersion returns the ContractVersion of the given contract with the given major and minor versions.
+func NewContractVersion(contract string, major, minor uint16) ContractVersion {
+	return ContractVersion{Contract: contract, Version: uint32(major)<<16 | uint32(minor)}
+}
+
+// Major returns the major version of the contract.
+func (cv ContractVersion) Major() uint16 {
+	return uint16(cv.Version >> 16)
+}
+
+// Minor returns the minor version of the contract.
+func (cv ContractVersion) Minor() uint16 {
+	return uint16(cv.Version)
+}
+
+func (cv ContractVersion) String() string {
+	return fmt.Sprintf("%s v%d.%d", cv.Contract, cv.Major(), cv.Minor())
+}
+
+// ContractVersion returns the API contract version that introduced the type. It returns nil if the type
+// does not belong to a contract.
+func (typeDef *TypeDef) ContractVersion() (*ContractVersion, error) {
+	return contractVersion(typeDef.idx.typeAttributesOf(typeDef.fullName()))
+}
+
+// MethodContractVersion returns the API contract version that introduced the given method of the type.
+// Methods usually belong to the contract of their type, unless they define their own ContractVersionAttribute.
+func (typeDef *TypeDef) MethodContractVersion(methodDef *types.MethodDef) (*ContractVersion, error) {
+	cv, err := contractVersion(typeDef.idx.methodAttributesOf(&typeDef.TypeDef, methodDef))
+	if err != nil || cv != nil {
+		return cv, err
+	}
+	return typeDef.ContractVersion()
+}
+
+func contractVersion(attrs []attribute) (*ContractVersion, error) {
+	for _, blob := range attributesWithType(attrs, AttributeTypeContractVersion) {
+		cv, ok, err := parseContractVersion(blob)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			return cv, nil
+		}
+	}
+	return nil, nil
+}
+
+// parseContractVersion parses the value of a ContractVersionAttribute. The attribute has three constructors:
+//
+//	ContractVersion(Type contract, uint version)
+//	ContractVersion(string contract, uint version)
+//	ContractVersion(uint version)
+//
+// The last one is only used by the contracts themselves, so it is ignored.
+func parseContractVersion(blob []byte) (*ContractVersion, bool, error) {
+	// the blob contains a two byte header
+	// 01 00
+	// followed by a byte with the size of the contract name (if any)
+	// XX
+	// followed by the contract name, the version (4 bytes, little endian) and
+	// the number of named arguments (2 bytes)
+	if len(blob) < 3 || blob[0] != 0x01 || blob[1] != 0x00 {
+		return nil, false, fmt.Errorf("invalid ContractVersionAttribute blob header: %x", blob)
+	}
+	if len(blob) == 8 {
+		// ContractVersion(uint version)
+		return nil, false, nil
+	}
+
+	size := int(blob[2])
+	if size >= 0x80 || len(blob) != 3+size+4+2 {
+		return nil, false, fmt.Errorf("invalid ContractVersionAttribute blob: %x", blob)
+	}
+
+	return &ContractVersion{
+		Contract: string(blob[3 : 3+size]),
+		Version:  binary.LittleEndian.Uint32(blob[3+size:]),
+	}, true, nil
+}
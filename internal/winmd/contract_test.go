@@ -0,0 +1,48 @@
+package winmd
+
+import (
+	"testing"
+
+	"github.com/go-kit/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseContractVersion(t *testing.T) {
+	// ContractVersion(typeof(Windows.Foundation.UniversalApiContract), 655360)
+	blob := append([]byte{0x01, 0x00, 39}, "Windows.Foundation.UniversalApiContract"...)
+	blob = append(blob, 0x00, 0x00, 0x0a, 0x00, 0x00, 0x00)
+
+	cv, ok, err := parseContractVersion(blob)
+	require.NoError(t, err)
+	require.True(t, ok)
+	assert.Equal(t, NewContractVersion("Windows.Foundation.UniversalApiContract", 10, 0), *cv)
+	assert.Equal(t, "Windows.Foundation.UniversalApiContract v10.0", cv.String())
+
+	// ContractVersion(65536), used by the contracts themselves
+	_, ok, err = parseContractVersion([]byte{0x01, 0x00, 0x00, 0x00, 0x01, 0x00, 0x00, 0x00})
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	_, _, err = parseContractVersion(blob[:len(blob)-3])
+	assert.Error(t, err)
+}
+
+func TestContractVersion(t *testing.T) {
+	store, err := NewStore(log.NewNopLogger())
+	require.NoError(t, err)
+
+	td, err := store.TypeDefByName("Windows.Foundation.Collections.IVector`1")
+	require.NoError(t, err)
+	cv, err := td.ContractVersion()
+	require.NoError(t, err)
+	assert.Equal(t, &ContractVersion{Contract: "Windows.Foundation.FoundationContract", Version: 0x10000}, cv)
+
+	// methods belong to the contract of their type
+	methods, err := td.ResolveMethodList(td.Ctx())
+	require.NoError(t, err)
+	require.NotEmpty(t, methods)
+	methodCV, err := td.MethodContractVersion(&methods[0])
+	require.NoError(t, err)
+	assert.Equal(t, cv, methodCV)
+}
@@ -0,0 +1,313 @@
+package winmd
+
+import (
+	"sync"
+
+	"github.com/tdakkota/win32metadata/md"
+	"github.com/tdakkota/win32metadata/types"
+)
+
+// index holds the lookup tables of a metadata context, so the rest of the package can use map lookups
+// instead of scanning the metadata tables.
+//
+// The type definitions are indexed when the file is loaded. The custom attributes and the interface
+// implementations are indexed the first time the context is queried: most of the loaded files are never
+// used by the generator, and decoding all their attributes would slow down the load of the Store.
+type index struct {
+	ctx *types.Context
+
+	// typeDefs maps the full name of a type to its definition.
+	typeDefs map[string]types.TypeDef
+	// typeDefNames holds the full name of the type defined in each row of the TypeDef table.
+	typeDefNames []string
+
+	once sync.Once
+	// typeAttributes maps the full name of a type to its custom attributes.
+	typeAttributes map[string][]attribute
+	// methodKeys holds the name and the signature of the method defined in each row of the MethodDef table.
+	methodKeys []methodKey
+	// methodAttributes maps the row of a method to its custom attributes.
+	methodAttributes map[uint32][]attribute
+	// interfaceImpls maps the full name of a type to the interfaces it implements.
+	interfaceImpls map[string][]types.InterfaceImpl
+	// methodSemantics maps the rows of the property and event methods to their property or event.
+	methodSemantics map[uint32]MethodSemantics
+}
+
+// attribute is a custom attribute with its type already resolved.
+type attribute struct {
+	// typeName is the full name of the attribute type.
+	typeName string
+	value    types.Blob
+}
+
+// methodKey identifies a method inside its type. A type cannot define two methods with the same name and
+// signature, but methods of different types can, so the key is only used to find the row of a method
+// among the methods of its type.
+type methodKey struct {
+	name      string
+	signature string
+}
+
+func newIndex(ctx *types.Context) *index {
+	idx := &index{
+		ctx:      ctx,
+		typeDefs: make(map[string]types.TypeDef),
+	}
+	idx.indexTypeDefs()
+	return idx
+}
+
+// load indexes the custom attributes and the interface implementations, the first time it is called.
+func (idx *index) load() {
+	idx.once.Do(func() {
+		idx.typeAttributes = make(map[string][]attribute)
+		idx.methodAttributes = make(map[uint32][]attribute)
+		idx.interfaceImpls = make(map[string][]types.InterfaceImpl)
+		idx.methodSemantics = make(map[uint32]MethodSemantics)
+
+		idx.indexMethodKeys()
+		idx.indexCustomAttributes()
+		idx.indexInterfaceImpls()
+		idx.indexMethodSemantics()
+	})
+}
+
+// typeAttributesOf returns the custom attributes of the given type.
+func (idx *index) typeAttributesOf(typeName string) []attribute {
+	idx.load()
+	return idx.typeAttributes[typeName]
+}
+
+// methodAttributesOf returns the custom attributes of the given method of the type.
+func (idx *index) methodAttributesOf(typeDef *types.TypeDef, methodDef *types.MethodDef) []attribute {
+	idx.load()
+	row, ok := idx.methodRow(typeDef, methodDef)
+	if !ok {
+		return nil
+	}
+	return idx.methodAttributes[row]
+}
+
+// interfaceImplsOf returns the interface implementations of the given type.
+func (idx *index) interfaceImplsOf(typeName string) []types.InterfaceImpl {
+	idx.load()
+	return idx.interfaceImpls[typeName]
+}
+
+// methodSemanticsOf returns the property or event the given method of the type belongs to.
+func (idx *index) methodSemanticsOf(typeDef *types.TypeDef, methodDef *types.MethodDef) (MethodSemantics, bool) {
+	idx.load()
+	row, ok := idx.methodRow(typeDef, methodDef)
+	if !ok {
+		return MethodSemantics{}, false
+	}
+	ms, ok := idx.methodSemantics[row]
+	return ms, ok
+}
+
+// methodRow returns the row of the given method in the MethodDef table, looking it up among the
+// methods of the type that defines it.
+func (idx *index) methodRow(typeDef *types.TypeDef, methodDef *types.MethodDef) (uint32, bool) {
+	if typeDef.MethodList.Empty() {
+		return 0, false
+	}
+
+	key := methodKey{name: methodDef.Name, signature: string(methodDef.Signature)}
+	for row := typeDef.MethodList.Start(); row < typeDef.MethodList.End(); row++ {
+		if row < uint32(len(idx.methodKeys)) && idx.methodKeys[row] == key {
+			return row, true
+		}
+	}
+	return 0, false
+}
+
+// indexTypeDefs adds all the type definitions to the index. The other tables reference the TypeDef rows,
+// so the name of each row is kept to resolve them without decoding the row again.
+func (idx *index) indexTypeDefs() {
+	table := idx.ctx.Table(md.TypeDef)
+	idx.typeDefNames = make([]string, table.RowCount())
+	for i := uint32(0); i < table.RowCount(); i++ {
+		var typeDef types.TypeDef
+		if err := typeDef.FromRow(table.Row(i)); err != nil {
+			continue // skip the row instead of failing
+		}
+
+		name := typeDef.TypeNamespace + "." + typeDef.TypeName
+		idx.typeDefNames[i] = name
+		if _, ok := idx.typeDefs[name]; !ok {
+			// keep the first definition of the type
+			idx.typeDefs[name] = typeDef
+		}
+	}
+}
+
+// indexMethodKeys keeps the key of each row of the MethodDef table, to find the row of a method without
+// decoding the rows of its type again.
+func (idx *index) indexMethodKeys() {
+	table := idx.ctx.Table(md.MethodDef)
+	idx.methodKeys = make([]methodKey, table.RowCount())
+	for i := uint32(0); i < table.RowCount(); i++ {
+		var methodDef types.MethodDef
+		if err := methodDef.FromRow(table.Row(i)); err != nil {
+			continue
+		}
+		idx.methodKeys[i] = methodKey{name: methodDef.Name, signature: string(methodDef.Signature)}
+	}
+}
+
+// indexCustomAttributes adds the custom attributes owned by types and methods to the index.
+func (idx *index) indexCustomAttributes() {
+	// many attributes share the same constructor, resolve each of them only once
+	attrTypeNames := make(map[types.CustomAttributeType]string)
+
+	table := idx.ctx.Table(md.CustomAttribute)
+	for i := uint32(0); i < table.RowCount(); i++ {
+		var cAttr types.CustomAttribute
+		if err := cAttr.FromRow(table.Row(i)); err != nil {
+			continue
+		}
+
+		// - Parent: we only look up the attributes of types and methods
+		parentTable, _ := cAttr.Parent.Table()
+		if parentTable != md.TypeDef && parentTable != md.MethodDef {
+			continue
+		}
+
+		typeName, ok := attrTypeNames[cAttr.Type]
+		if !ok {
+			typeName, ok = idx.attributeTypeName(cAttr.Type)
+			if !ok {
+				continue
+			}
+			attrTypeNames[cAttr.Type] = typeName
+		}
+		attr := attribute{typeName: typeName, value: cAttr.Value}
+
+		row := cAttr.Parent.TableIndex()
+		switch parentTable {
+		case md.TypeDef:
+			if row < uint32(len(idx.typeDefNames)) && idx.typeDefNames[row] != "" {
+				name := idx.typeDefNames[row]
+				idx.typeAttributes[name] = append(idx.typeAttributes[name], attr)
+			}
+		case md.MethodDef:
+			if row < uint32(len(idx.methodKeys)) && idx.methodKeys[row].name != "" {
+				idx.methodAttributes[row] = append(idx.methodAttributes[row], attr)
+			}
+		}
+	}
+}
+
+// attributeTypeName returns the full name of the given attribute type.
+func (idx *index) attributeTypeName(attrType types.CustomAttributeType) (string, bool) {
+	// the cAttr.Type table can be either a MemberRef or a MethodRef.
+	// Since we are looking for a type, we will only consider the MemberRef.
+	if cAttrTypeTable, _ := attrType.Table(); cAttrTypeTable != md.MemberRef {
+		return "", false
+	}
+
+	var attrTypeMemberRef types.MemberRef
+	row, ok := attrType.Row(idx.ctx)
+	if !ok {
+		return "", false
+	}
+	if err := attrTypeMemberRef.FromRow(row); err != nil {
+		return "", false
+	}
+
+	// we need to check the MemberRef Class
+	// the value can belong to several tables, but we are only going to check for TypeRef
+	if classTable, _ := attrTypeMemberRef.Class.Table(); classTable != md.TypeRef {
+		return "", false
+	}
+
+	var attrTypeRef types.TypeRef
+	row, ok = attrTypeMemberRef.Class.Row(idx.ctx)
+	if !ok {
+		return "", false
+	}
+	if err := attrTypeRef.FromRow(row); err != nil {
+		return "", false
+	}
+
+	return attrTypeRef.TypeNamespace + "." + attrTypeRef.TypeName, true
+}
+
+// indexInterfaceImpls adds the interfaces implemented by each type to the index.
+func (idx *index) indexInterfaceImpls() {
+	table := idx.ctx.Table(md.InterfaceImpl)
+	for i := uint32(0); i < table.RowCount(); i++ {
+		var interfaceImpl types.InterfaceImpl
+		if err := interfaceImpl.FromRow(table.Row(i)); err != nil {
+			continue
+		}
+
+		// the class is a 1-based index into the TypeDef table
+		row := uint32(interfaceImpl.Class) - 1
+		if row >= uint32(len(idx.typeDefNames)) || idx.typeDefNames[row] == "" {
+			continue
+		}
+		name := idx.typeDefNames[row]
+		idx.interfaceImpls[name] = append(idx.interfaceImpls[name], interfaceImpl)
+	}
+}
+
+// indexMethodSemantics adds the methods of the properties and the events to the index.
+func (idx *index) indexMethodSemantics() {
+	table := idx.ctx.Table(md.MethodSemantics)
+	for i := uint32(0); i < table.RowCount(); i++ {
+		var semantics types.MethodSemantics
+		if err := semantics.FromRow(table.Row(i)); err != nil {
+			continue
+		}
+
+		// the method is a 1-based index into the MethodDef table
+		row := uint32(semantics.Method) - 1
+		if row >= uint32(len(idx.methodKeys)) || idx.methodKeys[row].name == "" {
+			continue
+		}
+
+		name, ok := idx.associationName(semantics.Association)
+		if !ok {
+			continue
+		}
+		idx.methodSemantics[row] = MethodSemantics{Attributes: semantics.Semantics, Name: name}
+	}
+}
+
+// associationName returns the name of the given property or event.
+func (idx *index) associationName(association types.HasSemantics) (string, bool) {
+	row, ok := association.Row(idx.ctx)
+	if !ok {
+		return "", false
+	}
+
+	switch table, _ := association.Table(); table {
+	case md.Property:
+		var property types.Property
+		if err := property.FromRow(row); err != nil {
+			return "", false
+		}
+		return property.Name, true
+	case md.Event:
+		var event types.Event
+		if err := event.FromRow(row); err != nil {
+			return "", false
+		}
+		return event.Name, true
+	default:
+		return "", false
+	}
+}
+
+// attributesWithType returns the values of the given attributes that match the given type.
+func attributesWithType(attrs []attribute, lookupAttrTypeClass string) [][]byte {
+	result := make([][]byte, 0)
+	for _, attr := range attrs {
+		if attr.typeName == lookupAttrTypeClass {
+			result = append(result, attr.value)
+		}
+	}
+	return result
+}
@@ -0,0 +1,39 @@
+package winmd
+
+import (
+	"testing"
+
+	"github.com/go-kit/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetMethodOverloadName(t *testing.T) {
+	store, err := NewStore(log.NewNopLogger())
+	require.NoError(t, err)
+
+	// both interfaces define GetDeviceSelector(string) string, with a different overload name
+	tests := []struct {
+		typeName string
+		want     string
+	}{
+		{typeName: "Windows.Devices.I2c.II2cDeviceStatics", want: "GetDeviceSelectorFromFriendlyName"},
+		{typeName: "Windows.Devices.SerialCommunication.ISerialDeviceStatics", want: "GetDeviceSelectorFromPortName"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.typeName, func(t *testing.T) {
+			td, err := store.TypeDefByName(tt.typeName)
+			require.NoError(t, err)
+			methods, err := td.ResolveMethodList(td.Ctx())
+			require.NoError(t, err)
+
+			var overloads []string
+			for i := range methods {
+				if methods[i].Name == "GetDeviceSelector" {
+					overloads = append(overloads, td.GetMethodOverloadName(&methods[i]))
+				}
+			}
+			assert.Contains(t, overloads, tt.want)
+		})
+	}
+}
@@ -0,0 +1,23 @@
+package winmd
+
+import (
+	"github.com/tdakkota/win32metadata/types"
+)
+
+// MethodSemantics describes the role of a method in a property or an event.
+type MethodSemantics struct {
+	// Attributes tells whether the method is a getter or a setter of a property,
+	// or the method that adds or removes the handlers of an event.
+	Attributes types.MethodSemanticsAttributes
+	// Name is the name of the property or the event.
+	Name string
+}
+
+// GetMethodSemantics returns the property or the event the given method of the type belongs to.
+// It returns false for regular methods.
+func (typeDef *TypeDef) GetMethodSemantics(methodDef *types.MethodDef) (MethodSemantics, bool) {
+	if !methodDef.Flags.SpecialName() {
+		return MethodSemantics{}, false
+	}
+	return typeDef.idx.methodSemanticsOf(&typeDef.TypeDef, methodDef)
+}
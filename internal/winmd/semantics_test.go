@@ -0,0 +1,50 @@
+package winmd
+
+import (
+	"testing"
+
+	"github.com/go-kit/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetMethodSemantics(t *testing.T) {
+	store, err := NewStore(log.NewNopLogger())
+	require.NoError(t, err)
+
+	iface, err := store.TypeDefByName("Windows.Media.ISystemMediaTransportControls")
+	require.NoError(t, err)
+	methods, err := iface.ResolveMethodList(iface.Ctx())
+	require.NoError(t, err)
+
+	semantics := make(map[string]MethodSemantics)
+	for i := range methods {
+		if ms, ok := iface.GetMethodSemantics(&methods[i]); ok {
+			semantics[methods[i].Name] = ms
+		}
+	}
+
+	getter := semantics["get_PlaybackStatus"]
+	assert.True(t, getter.Attributes.Getter())
+	assert.Equal(t, "PlaybackStatus", getter.Name)
+
+	setter := semantics["put_PlaybackStatus"]
+	assert.True(t, setter.Attributes.Setter())
+	assert.Equal(t, "PlaybackStatus", setter.Name)
+
+	adder := semantics["add_ButtonPressed"]
+	assert.True(t, adder.Attributes.AddOn())
+	assert.Equal(t, "ButtonPressed", adder.Name)
+
+	remover := semantics["remove_ButtonPressed"]
+	assert.True(t, remover.Attributes.RemoveOn())
+	assert.Equal(t, "ButtonPressed", remover.Name)
+
+	// regular methods do not belong to a property or an event
+	uri, err := store.TypeDefByName("Windows.Foundation.IUriRuntimeClassFactory")
+	require.NoError(t, err)
+	methods, err = uri.ResolveMethodList(uri.Ctx())
+	require.NoError(t, err)
+	_, ok := uri.GetMethodSemantics(&methods[0])
+	assert.False(t, ok)
+}
@@ -0,0 +1,79 @@
+package winmd
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Source is a set of windows metadata (.winmd) files.
+type Source struct {
+	// name is used to identify the source in logs and errors.
+	name  string
+	fsys  fs.FS
+	files []string
+}
+
+// String returns the name of the source.
+func (src Source) String() string {
+	return src.name
+}
+
+// FSSource returns a Source with all the .winmd files in the root of the given file system.
+// The extension of the files is case-insensitive, like on Windows.
+func FSSource(name string, fsys fs.FS) (Source, error) {
+	entries, err := fs.ReadDir(fsys, ".")
+	if err != nil {
+		return Source{}, err
+	}
+
+	var files []string
+	for _, entry := range entries {
+		if !entry.IsDir() && isWinMD(entry.Name()) {
+			files = append(files, entry.Name())
+		}
+	}
+
+	return Source{name: name, fsys: fsys, files: files}, nil
+}
+
+// isWinMD returns true if the given file name has the .winmd extension, in any case.
+func isWinMD(name string) bool {
+	return strings.EqualFold(filepath.Ext(name), ".winmd")
+}
+
+// PathSource returns a Source for the given path, which can be either a single .winmd
+// file or a directory containing .winmd files.
+func PathSource(p string) (Source, error) {
+	info, err := os.Stat(p)
+	if err != nil {
+		return Source{}, err
+	}
+
+	if info.IsDir() {
+		src, err := FSSource(p, os.DirFS(p))
+		if err != nil {
+			return Source{}, err
+		}
+		if len(src.files) == 0 {
+			return Source{}, fmt.Errorf("no .winmd files found in %s", p)
+		}
+		return src, nil
+	}
+
+	if !isWinMD(p) {
+		return Source{}, fmt.Errorf("%s is not a .winmd file", p)
+	}
+	return Source{name: p, fsys: os.DirFS(filepath.Dir(p)), files: []string{filepath.Base(p)}}, nil
+}
+
+// embeddedSource returns the Source of the windows metadata files embedded in the binary.
+func embeddedSource() (Source, error) {
+	fsys, err := fs.Sub(files, "metadata")
+	if err != nil {
+		return Source{}, err
+	}
+	return FSSource("embedded", fsys)
+}
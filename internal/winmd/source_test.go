@@ -0,0 +1,88 @@
+package winmd
+
+import (
+	"io/fs"
+	"os"
+	"path/filepath"
+	"testing"
+	"testing/fstest"
+
+	"github.com/go-kit/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// copyEmbedded copies an embedded metadata file into the given directory.
+func copyEmbedded(t *testing.T, dir, name string) string {
+	t.Helper()
+
+	data, err := files.ReadFile("metadata/" + name)
+	require.NoError(t, err)
+
+	path := filepath.Join(dir, name)
+	require.NoError(t, os.WriteFile(path, data, 0o600))
+	return path
+}
+
+func TestPathSource(t *testing.T) {
+	dir := t.TempDir()
+	file := copyEmbedded(t, dir, "Windows.Foundation.winmd")
+
+	src, err := PathSource(dir)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Windows.Foundation.winmd"}, src.files)
+
+	src, err = PathSource(file)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Windows.Foundation.winmd"}, src.files)
+
+	_, err = PathSource(t.TempDir())
+	assert.Error(t, err, "directories without .winmd files are not valid sources")
+
+	notWinMD := filepath.Join(dir, "notes.txt")
+	require.NoError(t, os.WriteFile(notWinMD, nil, 0o600))
+	_, err = PathSource(notWinMD)
+	assert.Error(t, err)
+
+	_, err = PathSource(filepath.Join(dir, "missing.winmd"))
+	assert.Error(t, err)
+}
+
+// Test that both the file and the directory sources accept the .winmd extension in any case.
+func TestSourceExtensionCase(t *testing.T) {
+	fsys := fstest.MapFS{
+		"Windows.Foundation.winmd": {},
+		"Windows.Media.WinMD":      {},
+		"Windows.Storage.WINMD":    {},
+		"notes.txt":                {},
+		"sub.winmd":                {Mode: fs.ModeDir},
+	}
+	src, err := FSSource("test", fsys)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Windows.Foundation.winmd", "Windows.Media.WinMD", "Windows.Storage.WINMD"}, src.files)
+
+	dir := t.TempDir()
+	upper := filepath.Join(dir, "Windows.Foundation.WINMD")
+	require.NoError(t, os.Rename(copyEmbedded(t, dir, "Windows.Foundation.winmd"), upper))
+
+	src, err = PathSource(dir)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Windows.Foundation.WINMD"}, src.files)
+
+	src, err = PathSource(upper)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Windows.Foundation.WINMD"}, src.files)
+}
+
+func TestStoreSourcePrecedence(t *testing.T) {
+	src, err := PathSource(copyEmbedded(t, t.TempDir(), "Windows.Foundation.winmd"))
+	require.NoError(t, err)
+
+	store, err := NewStore(log.NewNopLogger(), src)
+	require.NoError(t, err)
+
+	// the user given file is loaded before the embedded ones
+	td, err := store.TypeDefByName("Windows.Foundation.IAsyncInfo")
+	require.NoError(t, err)
+	assert.Same(t, store.contexts[0], td.Ctx())
+}
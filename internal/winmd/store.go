@@ -2,9 +2,10 @@ package winmd
 
 import (
 	"fmt"
+	"io/fs"
 
 	"github.com/go-kit/log"
-	"github.com/tdakkota/win32metadata/md"
+	"github.com/go-kit/log/level"
 	"github.com/tdakkota/win32metadata/types"
 )
 
@@ -19,36 +20,59 @@ func (e *ClassNotFoundError) Error() string {
 
 // Store holds the windows metadata contexts. It can be used to get the metadata across multiple files.
 type Store struct {
-	contexts map[string]*types.Context
+	// contexts are sorted by precedence: the files of the sources given by the user go first.
+	contexts []*types.Context
+	// typeDefs maps the full name of each type to its definition in the context with the highest precedence.
+	typeDefs map[string]*TypeDef
 	logger   log.Logger
 }
 
-// NewStore loads all windows metadata files and returns a new Store.
-func NewStore(logger log.Logger) (*Store, error) {
-	contexts := make(map[string]*types.Context)
-
-	winmdFiles, err := allFiles()
+// NewStore loads the windows metadata files of the given sources, followed by the ones
+// embedded in the binary, and returns a new Store. When a type is defined in more than one
+// file, the definition of the first source takes precedence.
+func NewStore(logger log.Logger, sources ...Source) (*Store, error) {
+	embedded, err := embeddedSource()
 	if err != nil {
 		return nil, err
 	}
+	sources = append(sources, embedded)
 
 	// parse and store all files in memory
-	for _, f := range winmdFiles {
-		winmdCtx, err := parseWinMDFile(f.Name())
-		if err != nil {
-			return nil, err
+	var contexts []*types.Context
+	typeDefs := make(map[string]*TypeDef)
+	for _, src := range sources {
+		for _, f := range src.files {
+			winmdCtx, err := parseWinMDFile(src.fsys, f)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %s: %w", src, f, err)
+			}
+			contexts = append(contexts, winmdCtx)
+
+			idx := newIndex(winmdCtx)
+			for name, typeDef := range idx.typeDefs {
+				if _, ok := typeDefs[name]; ok {
+					continue // a previous source takes precedence
+				}
+				typeDefs[name] = &TypeDef{
+					TypeDef:    typeDef,
+					HasContext: HasContext{winmdCtx},
+					idx:        idx,
+					logger:     logger,
+				}
+			}
 		}
-		contexts[f.Name()] = winmdCtx
+		_ = level.Debug(logger).Log("msg", "loaded metadata source", "source", src, "files", len(src.files))
 	}
 
 	return &Store{
 		contexts: contexts,
+		typeDefs: typeDefs,
 		logger:   logger,
 	}, nil
 }
 
-func parseWinMDFile(path string) (*types.Context, error) {
-	f, err := open(path)
+func parseWinMDFile(fsys fs.FS, path string) (*types.Context, error) {
+	f, err := open(fsys, path)
 	if err != nil {
 		return nil, err
 	}
@@ -59,31 +83,12 @@ func parseWinMDFile(path string) (*types.Context, error) {
 
 // TypeDefByName returns a type definition that matches the given name.
 func (mds *Store) TypeDefByName(class string) (*TypeDef, error) {
-	// the type can belong to any of the contexts
-	for _, ctx := range mds.contexts {
-		if td := mds.typeDefByNameAndCtx(class, ctx); td != nil {
-			return td, nil // return the first match
-		}
-	}
-	return nil, &ClassNotFoundError{Class: class}
-}
-
-func (mds *Store) typeDefByNameAndCtx(class string, ctx *types.Context) *TypeDef {
-	typeDefTable := ctx.Table(md.TypeDef)
-	for i := uint32(0); i < typeDefTable.RowCount(); i++ {
-		var typeDef types.TypeDef
-		if err := typeDef.FromRow(typeDefTable.Row(i)); err != nil {
-			continue // keep searching instead of failing
-		}
-
-		if typeDef.TypeNamespace+"."+typeDef.TypeName == class {
-			return &TypeDef{
-				TypeDef:    typeDef,
-				HasContext: HasContext{ctx},
-				logger:     mds.logger,
-			}
-		}
+	typeDef, ok := mds.typeDefs[class]
+	if !ok {
+		return nil, &ClassNotFoundError{Class: class}
 	}
 
-	return nil
+	// return a copy, so callers cannot modify the stored definition
+	td := *typeDef
+	return &td, nil
 }
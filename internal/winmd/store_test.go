@@ -0,0 +1,87 @@
+package winmd
+
+import (
+	"testing"
+
+	"github.com/go-kit/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const benchmarkClass = "Windows.Media.SystemMediaTransportControls"
+
+func TestStoreLookups(t *testing.T) {
+	store, err := NewStore(log.NewNopLogger())
+	require.NoError(t, err)
+
+	_, err = store.TypeDefByName("Windows.Media.Missing")
+	assert.ErrorAs(t, err, new(*ClassNotFoundError))
+
+	td, err := store.TypeDefByName(benchmarkClass)
+	require.NoError(t, err)
+
+	ifaces, err := td.GetImplementedInterfaces()
+	require.NoError(t, err)
+	assert.Contains(t, ifaces, QualifiedID{Namespace: "Windows.Media", Name: "ISystemMediaTransportControls"})
+	assert.Contains(t, ifaces, QualifiedID{Namespace: "Windows.Media", Name: "ISystemMediaTransportControls2"})
+
+	iface, err := store.TypeDefByName("Windows.Media.ISystemMediaTransportControls")
+	require.NoError(t, err)
+	guid, err := iface.GUID()
+	require.NoError(t, err)
+	assert.Equal(t, "99fa3ff4-1742-42a6-902e-087d41f965ec", guid)
+
+	// methods without the overload attribute keep their name
+	methods, err := iface.ResolveMethodList(iface.Ctx())
+	require.NoError(t, err)
+	require.NotEmpty(t, methods)
+	assert.Equal(t, methods[0].Name, iface.GetMethodOverloadName(&methods[0]))
+
+	// overloaded methods are renamed
+	uri, err := store.TypeDefByName("Windows.Foundation.IUriRuntimeClassFactory")
+	require.NoError(t, err)
+	methods, err = uri.ResolveMethodList(uri.Ctx())
+	require.NoError(t, err)
+	var names []string
+	for i := range methods {
+		names = append(names, uri.GetMethodOverloadName(&methods[i]))
+	}
+	assert.Equal(t, []string{"CreateUri", "CreateWithRelativeUri"}, names)
+}
+
+// BenchmarkStoreLookups runs the lookups done by the generator for a class with many interfaces:
+// the class, the interfaces it implements, their GUIDs and the names of their methods.
+func BenchmarkStoreLookups(b *testing.B) {
+	store, err := NewStore(log.NewNopLogger())
+	require.NoError(b, err)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		td, err := store.TypeDefByName(benchmarkClass)
+		require.NoError(b, err)
+
+		ifaces, err := td.GetImplementedInterfaces()
+		require.NoError(b, err)
+
+		for _, iface := range ifaces {
+			itd, err := store.TypeDefByName(iface.Namespace + "." + iface.Name)
+			require.NoError(b, err)
+
+			_, err = itd.GUID()
+			require.NoError(b, err)
+
+			methods, err := itd.ResolveMethodList(itd.Ctx())
+			require.NoError(b, err)
+			for j := range methods {
+				_ = itd.GetMethodOverloadName(&methods[j])
+			}
+		}
+	}
+}
+
+func BenchmarkNewStore(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		_, err := NewStore(log.NewNopLogger())
+		require.NoError(b, err)
+	}
+}
@@ -16,6 +16,8 @@ type TypeDef struct {
 	types.TypeDef
 	HasContext
 
+	// idx holds the lookup tables of the original context
+	idx    *index
 	logger log.Logger
 }
 
@@ -74,109 +76,91 @@ func (typeDef *TypeDef) GetAttributeWithType(lookupAttrTypeClass string) ([]byte
 
 // GetTypeDefAttributesWithType returns the values of all the attributes that match the given type.
 func (typeDef *TypeDef) GetTypeDefAttributesWithType(lookupAttrTypeClass string) [][]byte {
-	result := make([][]byte, 0)
-	cAttrTable := typeDef.Ctx().Table(md.CustomAttribute)
-	for i := uint32(0); i < cAttrTable.RowCount(); i++ {
-		var cAttr types.CustomAttribute
-		if err := cAttr.FromRow(cAttrTable.Row(i)); err != nil {
-			continue
-		}
+	return attributesWithType(typeDef.idx.typeAttributesOf(typeDef.fullName()), lookupAttrTypeClass)
+}
 
-		// - Parent: The owner of the Attribute must be the given typeDef
-		if cAttrParentTable, _ := cAttr.Parent.Table(); cAttrParentTable != md.TypeDef {
-			continue
-		}
+// GetImplementedInterfaces returns the interfaces implemented by the type.
+func (typeDef *TypeDef) GetImplementedInterfaces() ([]QualifiedID, error) {
+	interfaces := make([]QualifiedID, 0)
 
-		var parentTypeDef TypeDef
-		row, ok := cAttr.Parent.Row(typeDef.Ctx())
-		if !ok {
-			continue
-		}
-		if err := parentTypeDef.FromRow(row); err != nil {
+	for _, interfaceImpl := range typeDef.idx.interfaceImplsOf(typeDef.fullName()) {
+		if t, ok := interfaceImpl.Interface.Table(); ok && t == md.TypeSpec {
+			// ignore type spec rows
 			continue
 		}
 
-		// does the blob belong to the type we're looking for?
-		if parentTypeDef.TypeNamespace != typeDef.TypeNamespace || parentTypeDef.TypeName != typeDef.TypeName {
-			continue
+		ifaceNS, ifaceName, err := typeDef.Ctx().ResolveTypeDefOrRefName(interfaceImpl.Interface)
+		if err != nil {
+			return nil, err
 		}
 
-		// - Type: the attribute type must be the given type
-		// the cAttr.Type table can be either a MemberRef or a MethodRef.
-		// Since we are looking for a type, we will only consider the MemberRef.
-		if cAttrTypeTable, _ := cAttr.Type.Table(); cAttrTypeTable != md.MemberRef {
-			continue
-		}
+		interfaces = append(interfaces, QualifiedID{Namespace: ifaceNS, Name: ifaceName})
+	}
 
-		var attrTypeMemberRef types.MemberRef
-		row, ok = cAttr.Type.Row(typeDef.Ctx())
-		if !ok {
-			continue
-		}
-		if err := attrTypeMemberRef.FromRow(row); err != nil {
-			continue
-		}
+	return interfaces, nil
+}
 
-		// we need to check the MemberRef Class
-		// the value can belong to several tables, but we are only going to check for TypeRef
-		if classTable, _ := attrTypeMemberRef.Class.Table(); classTable != md.TypeRef {
-			continue
-		}
+// RequiredInterface holds an interface required by another interface.
+// Parameterized interfaces also include the type arguments used to instantiate them,
+// these may reference the generic params of the requiring interface (ELEMENT_TYPE_VAR).
+type RequiredInterface struct {
+	QualifiedID
+	Generics []types.ElementType
+}
 
-		var attrTypeRef types.TypeRef
-		row, ok = attrTypeMemberRef.Class.Row(typeDef.Ctx())
-		if !ok {
-			continue
-		}
-		if err := attrTypeRef.FromRow(row); err != nil {
+// GetRequiredInterfaces returns the interfaces required by the type. Unlike GetImplementedInterfaces,
+// this includes the instances of parameterized interfaces (like IIterable<T>).
+func (typeDef *TypeDef) GetRequiredInterfaces() ([]RequiredInterface, error) {
+	interfaces := make([]RequiredInterface, 0)
+
+	for _, interfaceImpl := range typeDef.idx.interfaceImplsOf(typeDef.fullName()) {
+		if t, ok := interfaceImpl.Interface.Table(); !ok || t != md.TypeSpec {
+			ifaceNS, ifaceName, err := typeDef.Ctx().ResolveTypeDefOrRefName(interfaceImpl.Interface)
+			if err != nil {
+				return nil, err
+			}
+
+			interfaces = append(interfaces, RequiredInterface{QualifiedID: QualifiedID{Namespace: ifaceNS, Name: ifaceName}})
 			continue
 		}
 
-		if attrTypeRef.TypeNamespace+"."+attrTypeRef.TypeName == lookupAttrTypeClass {
-			result = append(result, cAttr.Value)
+		// type spec rows contain the signature of a generic instance
+		row, ok := interfaceImpl.Interface.Row(typeDef.Ctx())
+		if !ok {
+			return nil, fmt.Errorf("type spec %v not found", interfaceImpl.Interface)
 		}
-	}
-
-	return result
-}
-
-// GetImplementedInterfaces returns the interfaces implemented by the type.
-func (typeDef *TypeDef) GetImplementedInterfaces() ([]QualifiedID, error) {
-	interfaces := make([]QualifiedID, 0)
-
-	tableInterfaceImpl := typeDef.Ctx().Table(md.InterfaceImpl)
-	for i := uint32(0); i < tableInterfaceImpl.RowCount(); i++ {
-		var interfaceImpl types.InterfaceImpl
-		if err := interfaceImpl.FromRow(tableInterfaceImpl.Row(i)); err != nil {
+		var typeSpec types.TypeSpec
+		if err := typeSpec.FromRow(row); err != nil {
 			return nil, err
 		}
 
-		classTd, err := interfaceImpl.ResolveClass(typeDef.Ctx())
+		el, err := typeSpec.Signature.Reader().NextElement(typeDef.Ctx())
 		if err != nil {
 			return nil, err
 		}
-
-		if classTd.TypeNamespace+"."+classTd.TypeName != typeDef.TypeNamespace+"."+typeDef.TypeName {
-			// not the class we are looking for
-			continue
-		}
-
-		if t, ok := interfaceImpl.Interface.Table(); ok && t == md.TypeSpec {
-			// ignore type spec rows
+		if el.Type.Kind != types.ELEMENT_TYPE_GENERICINST {
 			continue
 		}
 
-		ifaceNS, ifaceName, err := typeDef.Ctx().ResolveTypeDefOrRefName(interfaceImpl.Interface)
+		ifaceNS, ifaceName, err := typeDef.Ctx().ResolveTypeDefOrRefName(el.Type.TypeDef.Index)
 		if err != nil {
 			return nil, err
 		}
 
-		interfaces = append(interfaces, QualifiedID{Namespace: ifaceNS, Name: ifaceName})
+		interfaces = append(interfaces, RequiredInterface{
+			QualifiedID: QualifiedID{Namespace: ifaceNS, Name: ifaceName},
+			Generics:    el.Type.TypeDef.Generics,
+		})
 	}
 
 	return interfaces, nil
 }
 
+// fullName returns the namespace and the name of the type.
+func (typeDef *TypeDef) fullName() string {
+	return typeDef.TypeNamespace + "." + typeDef.TypeName
+}
+
 // Extends returns true if the type extends the given class
 func (typeDef *TypeDef) Extends(class string) (bool, error) {
 	ns, name, err := typeDef.Ctx().ResolveTypeDefOrRefName(typeDef.TypeDef.Extends)
@@ -16,8 +16,11 @@ const (
 	AttributeTypeExclusiveTo          = "Windows.Foundation.Metadata.ExclusiveToAttribute"
 	AttributeTypeStaticAttribute      = "Windows.Foundation.Metadata.StaticAttribute"
 	AttributeTypeActivatableAttribute = "Windows.Foundation.Metadata.ActivatableAttribute"
+	AttributeTypeComposableAttribute  = "Windows.Foundation.Metadata.ComposableAttribute"
 	AttributeTypeDefaultAttribute     = "Windows.Foundation.Metadata.DefaultAttribute"
 	AttributeTypeOverloadAttribute    = "Windows.Foundation.Metadata.OverloadAttribute"
+	AttributeTypeContractVersion      = "Windows.Foundation.Metadata.ContractVersionAttribute"
+	AttributeTypeFlags                = "System.FlagsAttribute"
 )
 
 // HasContext is a helper struct that holds the original context of a metadata element.
@@ -33,15 +36,10 @@ func (hctx *HasContext) Ctx() *types.Context {
 //go:embed metadata/*.winmd
 var files embed.FS
 
-// allFiles returns all winmd files embedded in the binary.
-func allFiles() ([]fs.DirEntry, error) {
-	return files.ReadDir("metadata")
-}
-
 // open reads the given file and returns a pe.File instance.
 // The user should close the returned instance once he is done working with it.
-func open(path string) (*pe.File, error) {
-	f, err := files.Open("metadata/" + path)
+func open(fsys fs.FS, path string) (*pe.File, error) {
+	f, err := fsys.Open(path)
 	if err != nil {
 		return nil, err
 	}
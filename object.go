@@ -0,0 +1,139 @@
+package winrt
+
+import (
+	"sync"
+	"unsafe"
+
+	"github.com/go-ole/go-ole"
+)
+
+// queryObjectInterface is replaced by the tests, which use fake objects.
+var queryObjectInterface = QueryInterface
+
+// Object is a reference to a WinRT object, embedded by all the generated runtime classes. The methods of
+// a class forward their calls to the interfaces of the object, which are queried the first time they are
+// used and cached until the object is released.
+//
+// The runtime classes are pointers to an Object, so a class is created from a WinRT object with a cast:
+//
+//	controls := (*media.SystemMediaTransportControls)(unsafe.Pointer(winrt.NewObject(unk)))
+type Object struct {
+	*ole.IUnknown
+
+	// borrowed objects are owned by someone else, like the arguments of a delegate, so they do not cache
+	// the interfaces: nothing would release them.
+	borrowed bool
+
+	mu         sync.RWMutex
+	interfaces map[ole.GUID]*ole.IUnknown
+}
+
+// NewObject returns an Object holding the given reference, which is released by the Release method of the
+// Object. A nil reference (a null object) returns nil.
+func NewObject(unk *ole.IUnknown) *Object {
+	if unk == nil {
+		return nil
+	}
+	return &Object{IUnknown: unk}
+}
+
+// BorrowObject returns an Object for a reference owned by someone else, like the arguments of a delegate,
+// which are only valid until the delegate returns. A borrowed Object queries the interfaces on every call
+// instead of caching them, and it must not be released unless a reference was added using AddRef.
+// A nil reference (a null object) returns nil.
+func BorrowObject(unk *ole.IUnknown) *Object {
+	if unk == nil {
+		return nil
+	}
+	return &Object{IUnknown: unk, borrowed: true}
+}
+
+// ObjectABI returns the value used to pass a runtime class to a vtable call, given the pointer to the
+// class (or its Object). A nil class is passed as a null object.
+func ObjectABI(class unsafe.Pointer) uintptr {
+	o := (*Object)(class)
+	if o == nil {
+		return 0
+	}
+	return uintptr(unsafe.Pointer(o.IUnknown))
+}
+
+// objectWrapper is implemented by the Object and the runtime classes embedding it.
+type objectWrapper interface {
+	object() *Object
+}
+
+func (o *Object) object() *Object {
+	return o
+}
+
+func (o *Object) cached(iid *ole.GUID) (*ole.IUnknown, bool) {
+	o.mu.RLock()
+	defer o.mu.RUnlock()
+
+	itf, ok := o.interfaces[*iid]
+	return itf, ok
+}
+
+// Interface returns the interface of the object with the given IID, like QueryInterface. The interface is
+// cached, so only the first call queries it. The returned interface must be passed to ReleaseInterface
+// once the caller is done with it.
+func (o *Object) Interface(iid *ole.GUID) (*ole.IUnknown, error) {
+	if o.borrowed {
+		return queryObjectInterface(o.IUnknown, iid)
+	}
+	if itf, ok := o.cached(iid); ok {
+		return itf, nil
+	}
+
+	itf, err := queryObjectInterface(o.IUnknown, iid)
+	if err != nil {
+		return nil, err
+	}
+
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	if cached, ok := o.interfaces[*iid]; ok {
+		// another goroutine queried the same interface in the meantime
+		releaseObject(itf)
+		return cached, nil
+	}
+	if o.interfaces == nil {
+		o.interfaces = make(map[ole.GUID]*ole.IUnknown)
+	}
+	o.interfaces[*iid] = itf
+	return itf, nil
+}
+
+// ReleaseInterface releases an interface returned by Interface. The cached interfaces are kept until the
+// object is released, so this only releases the interfaces of the borrowed objects.
+func (o *Object) ReleaseInterface(itf *ole.IUnknown) {
+	if o.borrowed {
+		releaseObject(itf)
+	}
+}
+
+// HasInterface returns true if the object implements the interface with the given IID.
+// The interface is cached like the ones returned by Interface.
+func (o *Object) HasInterface(iid *ole.GUID) bool {
+	itf, err := o.Interface(iid)
+	if err != nil {
+		return false
+	}
+	o.ReleaseInterface(itf)
+	return true
+}
+
+// Release releases the cached interfaces, and then the object itself.
+func (o *Object) Release() int32 {
+	o.mu.Lock()
+	interfaces := o.interfaces
+	o.interfaces = nil
+	o.mu.Unlock()
+
+	for _, itf := range interfaces {
+		releaseObject(itf)
+	}
+	return releaseObject(o.IUnknown)
+}
@@ -0,0 +1,111 @@
+package winrt
+
+import (
+	"testing"
+	"unsafe"
+
+	"github.com/go-ole/go-ole"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	iidFake1 = ole.NewGUID("99fa3ff4-1742-42a6-902e-087d41f965ec")
+	iidFake2 = ole.NewGUID("ea98d2f6-7f3c-4af2-a586-72889808efb1")
+)
+
+// fakeQueries replaces QueryInterface with a fake that returns a new reference to itf for iidFake1 and
+// iidFake2, and counts the queries. It must be used with fakeRefs.
+func fakeQueries(t *testing.T, itf *ole.IUnknown) *int {
+	queries := 0
+	queryObjectInterface = func(_ *ole.IUnknown, iid *ole.GUID) (*ole.IUnknown, error) {
+		queries++
+		if !ole.IsEqualGUID(iid, iidFake1) && !ole.IsEqualGUID(iid, iidFake2) {
+			return nil, queryInterfaceError(iid, ole.NewError(ole.E_NOINTERFACE))
+		}
+		addRefObject(itf)
+		return itf, nil
+	}
+	t.Cleanup(func() {
+		queryObjectInterface = QueryInterface
+	})
+	return &queries
+}
+
+func TestObjectInterfaceCache(t *testing.T) {
+	refs := fakeRefs(t)
+	unk, itf := &ole.IUnknown{}, &ole.IUnknown{}
+	queries := fakeQueries(t, itf)
+
+	obj := NewObject(unk)
+	for i := 0; i < 3; i++ {
+		got, err := obj.Interface(ole.NewGUID(iidFake1.String()))
+		require.NoError(t, err)
+		assert.Same(t, itf, got)
+		obj.ReleaseInterface(got)
+	}
+	assert.Equal(t, 1, *queries, "the interface is only queried once")
+	assert.EqualValues(t, 1, refs(itf), "the cached interface holds a reference")
+
+	assert.True(t, obj.HasInterface(iidFake2))
+	assert.Equal(t, 2, *queries)
+
+	// errors are not cached
+	for i := 0; i < 2; i++ {
+		_, err := obj.Interface(ole.NewGUID("00000000-0000-0000-0000-000000000001"))
+		assert.ErrorIs(t, err, ErrInterfaceNotSupported)
+	}
+	assert.Equal(t, 4, *queries)
+
+	obj.Release()
+	assert.EqualValues(t, 0, refs(itf), "the cached interfaces are released with the object")
+	assert.EqualValues(t, -1, refs(unk))
+}
+
+// Test that two objects holding the same reference do not share their cache.
+func TestObjectCachePerWrapper(t *testing.T) {
+	refs := fakeRefs(t)
+	unk, itf := &ole.IUnknown{}, &ole.IUnknown{}
+	queries := fakeQueries(t, itf)
+
+	obj, other := NewObject(unk), NewObject(unk)
+	_, err := obj.Interface(iidFake1)
+	require.NoError(t, err)
+	_, err = other.Interface(iidFake1)
+	require.NoError(t, err)
+	assert.Equal(t, 2, *queries)
+
+	obj.Release()
+	assert.EqualValues(t, 1, refs(itf), "the interface cached by the other object is still alive")
+
+	_, err = other.Interface(iidFake1)
+	require.NoError(t, err)
+	assert.Equal(t, 2, *queries)
+}
+
+// Test that the borrowed objects release the queried interfaces instead of caching them.
+func TestBorrowedObject(t *testing.T) {
+	refs := fakeRefs(t)
+	unk, itf := &ole.IUnknown{}, &ole.IUnknown{}
+	queries := fakeQueries(t, itf)
+
+	obj := BorrowObject(unk)
+	for i := 0; i < 2; i++ {
+		got, err := obj.Interface(iidFake1)
+		require.NoError(t, err)
+		obj.ReleaseInterface(got)
+	}
+	assert.True(t, obj.HasInterface(iidFake1))
+	assert.Equal(t, 3, *queries)
+	assert.EqualValues(t, 0, refs(itf))
+	assert.EqualValues(t, 0, refs(unk), "borrowed objects are not released")
+}
+
+func TestNilObject(t *testing.T) {
+	assert.Nil(t, NewObject(nil))
+	assert.Nil(t, BorrowObject(nil))
+	assert.Zero(t, ObjectABI(nil))
+
+	unk := &ole.IUnknown{}
+	assert.Equal(t, uintptr(unsafe.Pointer(unk)), ObjectABI(unsafe.Pointer(NewObject(unk))))
+}
@@ -0,0 +1,64 @@
+package winrt
+
+import (
+	"errors"
+	"fmt"
+	"unsafe"
+
+	"github.com/go-ole/go-ole"
+)
+
+// ErrInterfaceNotSupported is returned when an object does not implement the requested interface (E_NOINTERFACE).
+// This is common with interfaces added in newer versions of Windows, callers can check it using errors.Is
+// to fall back to older APIs.
+var ErrInterfaceNotSupported = errors.New("interface not supported")
+
+// InterfaceNotSupportedError is the error returned by QueryInterface when the object does not implement
+// the requested interface. It matches ErrInterfaceNotSupported, and unwraps into the original *ole.OleError.
+type InterfaceNotSupportedError struct {
+	IID *ole.GUID
+	Err *ole.OleError
+}
+
+func (e *InterfaceNotSupportedError) Error() string {
+	return fmt.Sprintf("interface %s not supported: %s", e.IID, e.Err)
+}
+
+// Is reports whether the target is ErrInterfaceNotSupported.
+func (e *InterfaceNotSupportedError) Is(target error) bool {
+	return target == ErrInterfaceNotSupported
+}
+
+func (e *InterfaceNotSupportedError) Unwrap() error {
+	return e.Err
+}
+
+// QueryInterface returns the requested interface of the given object. Unlike ole.IUnknown.MustQueryInterface
+// it does not panic: when the object does not implement the interface, an InterfaceNotSupportedError is returned.
+// The caller must release the returned interface.
+func QueryInterface(unk *ole.IUnknown, iid *ole.GUID) (*ole.IUnknown, error) {
+	itf, err := unk.QueryInterface(iid)
+	if err != nil {
+		return nil, queryInterfaceError(iid, err)
+	}
+	return (*ole.IUnknown)(unsafe.Pointer(itf)), nil
+}
+
+// queryInterfaceError converts E_NOINTERFACE errors into an InterfaceNotSupportedError.
+func queryInterfaceError(iid *ole.GUID, err error) error {
+	var oleErr *ole.OleError
+	if errors.As(err, &oleErr) && uint32(oleErr.Code()) == ole.E_NOINTERFACE {
+		return &InterfaceNotSupportedError{IID: iid, Err: oleErr}
+	}
+	return err
+}
+
+// IsSupported returns true if the given object implements the interface with the given IID.
+func IsSupported(unk *ole.IUnknown, iid *ole.GUID) bool {
+	itf, err := unk.QueryInterface(iid)
+	if err != nil {
+		return false
+	}
+	itf.Release()
+	return true
+}
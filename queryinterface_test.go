@@ -0,0 +1,27 @@
+package winrt
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/go-ole/go-ole"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQueryInterfaceError(t *testing.T) {
+	iid := ole.NewGUID("99fa3ff4-1742-42a6-902e-087d41f965ec")
+
+	err := queryInterfaceError(iid, ole.NewError(ole.E_NOINTERFACE))
+	assert.ErrorIs(t, err, ErrInterfaceNotSupported)
+
+	var oleErr *ole.OleError
+	if assert.ErrorAs(t, err, &oleErr) {
+		assert.Equal(t, uintptr(ole.E_NOINTERFACE), oleErr.Code())
+	}
+
+	// other errors are returned as they are
+	other := ole.NewError(ole.E_FAIL)
+	err = queryInterfaceError(iid, other)
+	assert.Same(t, other, err)
+	assert.False(t, errors.Is(err, ErrInterfaceNotSupported))
+}
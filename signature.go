@@ -34,8 +34,13 @@ const (
 // Checkout the following link for documentation on how the signatures are generated:
 // https://docs.microsoft.com/en-us/uwp/winrt-cref/winrt-type-system#guid-generation-for-parameterized-types
 func ParameterizedInstanceGUID(baseGUID string, signatures ...string) string {
-	res := fmt.Sprintf("pinterface({%s};%s)", baseGUID, strings.Join(signatures, ";"))
-	return guidFromSignature(res)
+	return guidFromSignature(ParameterizedInstanceSignature(baseGUID, signatures...))
+}
+
+// ParameterizedInstanceSignature returns the signature of an instance of a "generic" WinRT delegate or interface.
+// This signature can be used as a type argument of another parameterized type.
+func ParameterizedInstanceSignature(baseGUID string, signatures ...string) string {
+	return fmt.Sprintf("pinterface({%s};%s)", baseGUID, strings.Join(signatures, ";"))
 }
 
 func guidFromSignature(signature string) string {
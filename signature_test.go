@@ -29,3 +29,11 @@ func TestParametrizedStoppedEvent(t *testing.T) {
 
 	assert.Equal(t, expected, guid)
 }
+
+// IVector<UInt32>
+func TestParameterizedInstanceSignature(t *testing.T) {
+	expected := "pinterface({913337e9-11a1-4345-a3a2-4e7f956e222d};u4)"
+	signature := ParameterizedInstanceSignature("913337e9-11a1-4345-a3a2-4e7f956e222d", SignatureUInt32)
+
+	assert.Equal(t, expected, signature)
+}
@@ -0,0 +1,45 @@
+package winrt
+
+import (
+	"math"
+	"time"
+)
+
+// ticksPerSecond is the number of 100-nanosecond ticks in a second, the unit of DateTime and TimeSpan.
+const ticksPerSecond = 10000000
+
+// epochSeconds is the number of seconds between the DateTime epoch (1601-01-01) and the Unix epoch (1970-01-01).
+const epochSeconds = 11644473600
+
+// TimeFromTicks returns the time of a Windows.Foundation.DateTime, given as the number of 100-nanosecond ticks
+// since 1601-01-01 UTC. The returned time is in UTC.
+func TimeFromTicks(ticks int64) time.Time {
+	// time.Unix normalizes the negative remainders of the ticks before 1601
+	return time.Unix(ticks/ticksPerSecond-epochSeconds, ticks%ticksPerSecond*100).UTC()
+}
+
+// TicksFromTime returns the value of the Windows.Foundation.DateTime of the given time, as the number of
+// 100-nanosecond ticks since 1601-01-01 UTC. The nanoseconds are truncated to the tick.
+func TicksFromTime(t time.Time) int64 {
+	return (t.Unix()+epochSeconds)*ticksPerSecond + int64(t.Nanosecond())/100
+}
+
+// DurationFromTicks returns the duration of a Windows.Foundation.TimeSpan, given as a number of 100-nanosecond
+// ticks. The TimeSpans that overflow a time.Duration (about 292 years) are clamped to its bounds.
+func DurationFromTicks(ticks int64) time.Duration {
+	const maxTicks = math.MaxInt64 / 100
+	switch {
+	case ticks > maxTicks:
+		return math.MaxInt64
+	case ticks < -maxTicks:
+		return math.MinInt64
+	default:
+		return time.Duration(ticks) * 100
+	}
+}
+
+// TicksFromDuration returns the value of the Windows.Foundation.TimeSpan of the given duration, as a number of
+// 100-nanosecond ticks. The nanoseconds are truncated to the tick.
+func TicksFromDuration(d time.Duration) int64 {
+	return int64(d / 100)
+}
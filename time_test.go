@@ -0,0 +1,48 @@
+package winrt
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTimeFromTicks(t *testing.T) {
+	tests := []struct {
+		ticks int64
+		want  time.Time
+	}{
+		{ticks: 0, want: time.Date(1601, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{ticks: 116444736000000000, want: time.Unix(0, 0).UTC()},
+		{ticks: 133000000001234567, want: time.Date(2022, 6, 18, 4, 26, 40, 123456700, time.UTC)},
+		{ticks: -1, want: time.Date(1600, 12, 31, 23, 59, 59, 999999900, time.UTC)},
+	}
+	for _, tt := range tests {
+		got := TimeFromTicks(tt.ticks)
+		assert.True(t, tt.want.Equal(got), "%d: got %v, want %v", tt.ticks, got, tt.want)
+		assert.Equal(t, time.UTC, got.Location())
+		assert.Equal(t, tt.ticks, TicksFromTime(tt.want), tt.want)
+	}
+}
+
+func TestTicksFromTime(t *testing.T) {
+	// the nanoseconds are truncated to the tick, in any time zone
+	local := time.Date(2022, 6, 18, 6, 26, 40, 123456789, time.FixedZone("CEST", 2*60*60))
+	assert.Equal(t, int64(133000000001234567), TicksFromTime(local))
+
+	// the zero time.Time is before the DateTime epoch
+	assert.True(t, time.Time{}.Equal(TimeFromTicks(TicksFromTime(time.Time{}))))
+}
+
+func TestDurationFromTicks(t *testing.T) {
+	assert.Equal(t, time.Duration(0), DurationFromTicks(0))
+	assert.Equal(t, 1500*time.Millisecond, DurationFromTicks(15000000))
+	assert.Equal(t, -100*time.Nanosecond, DurationFromTicks(-1))
+	assert.Equal(t, time.Duration(math.MaxInt64), DurationFromTicks(math.MaxInt64))
+	assert.Equal(t, time.Duration(math.MinInt64), DurationFromTicks(math.MinInt64))
+
+	assert.Equal(t, int64(15000000), TicksFromDuration(1500*time.Millisecond))
+	assert.Equal(t, int64(1), TicksFromDuration(199*time.Nanosecond))
+	assert.Equal(t, int64(-1), TicksFromDuration(-199*time.Nanosecond))
+}
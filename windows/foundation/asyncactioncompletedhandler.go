@@ -0,0 +1,235 @@
+// Code generated by winrt-go-gen. DO NOT EDIT.
+
+//go:build windows
+
+//nolint:all
+package foundation
+
+import (
+	"sync"
+	"time"
+	"unsafe"
+
+	"github.com/go-ole/go-ole"
+	"github.com/waylyrics/winrt-go"
+	"github.com/waylyrics/winrt-go/internal/delegate"
+	"github.com/waylyrics/winrt-go/internal/kernel32"
+)
+
+const GUIDAsyncActionCompletedHandler string = "a4ed5c81-76c9-40bd-8be6-b1d90fb20ae7"
+const SignatureAsyncActionCompletedHandler string = "delegate({a4ed5c81-76c9-40bd-8be6-b1d90fb20ae7})"
+
+var IIDAsyncActionCompletedHandler = ole.GUID{Data1: 0xa4ed5c81, Data2: 0x76c9, Data3: 0x40bd, Data4: [8]byte{0x8b, 0xe6, 0xb1, 0xd9, 0x0f, 0xb2, 0x0a, 0xe7}}
+
+type AsyncActionCompletedHandler struct {
+	ole.IUnknown
+	sync.Mutex
+	refs uint64
+	IID  ole.GUID
+}
+
+type AsyncActionCompletedHandlerVtbl struct {
+	ole.IUnknownVtbl
+	Invoke uintptr
+}
+
+type AsyncActionCompletedHandlerCallback func(instance *AsyncActionCompletedHandler, asyncInfo *IAsyncAction, asyncStatus AsyncStatus)
+
+// asyncActionCompletedHandlerEntry is the callback of a AsyncActionCompletedHandler, and the dispatcher that runs it.
+type asyncActionCompletedHandlerEntry struct {
+	callback   AsyncActionCompletedHandlerCallback
+	dispatcher *winrt.Dispatcher // nil for the callbacks that run inline
+}
+
+var callbacksAsyncActionCompletedHandler = &asyncActionCompletedHandlerCallbacks{
+	mu:        &sync.Mutex{},
+	callbacks: make(map[unsafe.Pointer]asyncActionCompletedHandlerEntry),
+}
+
+var releaseChannelsAsyncActionCompletedHandler = &asyncActionCompletedHandlerReleaseChannels{
+	mu:    &sync.Mutex{},
+	chans: make(map[unsafe.Pointer]chan struct{}),
+}
+
+func NewAsyncActionCompletedHandler(iid *ole.GUID, callback AsyncActionCompletedHandlerCallback) *AsyncActionCompletedHandler {
+	return newAsyncActionCompletedHandler(iid, asyncActionCompletedHandlerEntry{callback: callback})
+}
+
+// NewAsyncActionCompletedHandlerWithDispatcher is like NewAsyncActionCompletedHandler, but the callback is run by the given dispatcher.
+// A nil dispatcher runs the callback inline.
+func NewAsyncActionCompletedHandlerWithDispatcher(iid *ole.GUID, dispatcher *winrt.Dispatcher, callback AsyncActionCompletedHandlerCallback) *AsyncActionCompletedHandler {
+	return newAsyncActionCompletedHandler(iid, asyncActionCompletedHandlerEntry{
+		callback:   callback,
+		dispatcher: dispatcher,
+	})
+}
+
+func newAsyncActionCompletedHandler(iid *ole.GUID, entry asyncActionCompletedHandlerEntry) *AsyncActionCompletedHandler {
+	size := unsafe.Sizeof(*(*AsyncActionCompletedHandler)(nil))
+	instPtr := kernel32.Malloc(size)
+	inst := (*AsyncActionCompletedHandler)(instPtr)
+
+	callbacks := delegate.RegisterCallbacks(instPtr, inst)
+
+	// Initialize all properties: the malloc may contain garbage
+	inst.RawVTable = (*interface{})(unsafe.Pointer(&AsyncActionCompletedHandlerVtbl{
+		IUnknownVtbl: ole.IUnknownVtbl{
+			QueryInterface: callbacks.QueryInterface,
+			AddRef:         callbacks.AddRef,
+			Release:        callbacks.Release,
+		},
+		Invoke: callbacks.Invoke,
+	}))
+	inst.IID = *iid // copy contents
+	inst.Mutex = sync.Mutex{}
+	inst.refs = 0
+
+	callbacksAsyncActionCompletedHandler.add(unsafe.Pointer(inst), entry)
+
+	// See the docs in the releaseChannelsAsyncActionCompletedHandler struct
+	releaseChannelsAsyncActionCompletedHandler.acquire(unsafe.Pointer(inst))
+
+	inst.addRef()
+	return inst
+}
+
+func (r *AsyncActionCompletedHandler) GetIID() *ole.GUID {
+	return &r.IID
+}
+
+// addRef increments the reference counter by one
+func (r *AsyncActionCompletedHandler) addRef() uint64 {
+	r.Lock()
+	defer r.Unlock()
+	r.refs++
+	return r.refs
+}
+
+// removeRef decrements the reference counter by one. If it was already zero, it will just return zero.
+func (r *AsyncActionCompletedHandler) removeRef() uint64 {
+	r.Lock()
+	defer r.Unlock()
+
+	if r.refs > 0 {
+		r.refs--
+	}
+
+	return r.refs
+}
+
+func (instance *AsyncActionCompletedHandler) Invoke(instancePtr, rawArgs0, rawArgs1, rawArgs2, rawArgs3, rawArgs4, rawArgs5, rawArgs6, rawArgs7, rawArgs8 unsafe.Pointer) uintptr {
+	asyncInfoPtr := rawArgs0
+	asyncStatusRaw := (int32)(uintptr(rawArgs1))
+
+	// See the quote above.
+	asyncInfo := (*IAsyncAction)(asyncInfoPtr)
+	asyncStatus := (AsyncStatus)(asyncStatusRaw)
+	entry, ok := callbacksAsyncActionCompletedHandler.get(instancePtr)
+	if !ok {
+		return ole.S_OK
+	}
+	if entry.dispatcher == nil {
+		entry.callback(instance, asyncInfo, asyncStatus)
+		return ole.S_OK
+	}
+
+	// the delegate and the objects it received must outlive the call if the callback runs later
+	objects := []*ole.IUnknown{
+		&instance.IUnknown,
+		(*ole.IUnknown)(asyncInfoPtr),
+	}
+	entry.dispatcher.Dispatch(func() {
+		entry.callback(instance, asyncInfo, asyncStatus)
+	}, objects...)
+	return ole.S_OK
+}
+
+func (instance *AsyncActionCompletedHandler) AddRef() uint64 {
+	return instance.addRef()
+}
+
+func (instance *AsyncActionCompletedHandler) Release() uint64 {
+	rem := instance.removeRef()
+	if rem == 0 {
+		// We're done.
+		instancePtr := unsafe.Pointer(instance)
+		callbacksAsyncActionCompletedHandler.delete(instancePtr)
+
+		// stop release channels used to avoid
+		// https://github.com/golang/go/issues/55015
+		releaseChannelsAsyncActionCompletedHandler.release(instancePtr)
+
+		kernel32.Free(instancePtr)
+	}
+	return rem
+}
+
+type asyncActionCompletedHandlerCallbacks struct {
+	mu        *sync.Mutex
+	callbacks map[unsafe.Pointer]asyncActionCompletedHandlerEntry
+}
+
+func (m *asyncActionCompletedHandlerCallbacks) add(p unsafe.Pointer, v asyncActionCompletedHandlerEntry) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.callbacks[p] = v
+}
+
+func (m *asyncActionCompletedHandlerCallbacks) get(p unsafe.Pointer) (asyncActionCompletedHandlerEntry, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	v, ok := m.callbacks[p]
+	return v, ok
+}
+
+func (m *asyncActionCompletedHandlerCallbacks) delete(p unsafe.Pointer) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	delete(m.callbacks, p)
+}
+
+// typedEventHandlerReleaseChannels keeps a map with channels
+// used to keep a goroutine alive during the lifecycle of this object.
+// This is required to avoid causing a deadlock error.
+// See this: https://github.com/golang/go/issues/55015
+type asyncActionCompletedHandlerReleaseChannels struct {
+	mu    *sync.Mutex
+	chans map[unsafe.Pointer]chan struct{}
+}
+
+func (m *asyncActionCompletedHandlerReleaseChannels) acquire(p unsafe.Pointer) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	c := make(chan struct{})
+	m.chans[p] = c
+
+	go func() {
+		// we need a timer to trick the go runtime into
+		// thinking there's still something going on here
+		// but we are only really interested in <-c
+		t := time.NewTimer(time.Minute)
+		for {
+			select {
+			case <-t.C:
+				t.Reset(time.Minute)
+			case <-c:
+				t.Stop()
+				return
+			}
+		}
+	}()
+}
+
+func (m *asyncActionCompletedHandlerReleaseChannels) release(p unsafe.Pointer) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if c, ok := m.chans[p]; ok {
+		close(c)
+		delete(m.chans, p)
+	}
+}
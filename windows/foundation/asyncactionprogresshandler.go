@@ -0,0 +1,242 @@
+// Code generated by winrt-go-gen. DO NOT EDIT.
+
+//go:build windows
+
+//nolint:all
+package foundation
+
+import (
+	"sync"
+	"time"
+	"unsafe"
+
+	"github.com/go-ole/go-ole"
+	"github.com/waylyrics/winrt-go"
+	"github.com/waylyrics/winrt-go/internal/delegate"
+	"github.com/waylyrics/winrt-go/internal/kernel32"
+)
+
+const GUIDAsyncActionProgressHandler string = "6d844858-0cff-4590-ae89-95a5a5c8b4b8"
+const SignatureAsyncActionProgressHandler string = "delegate({6d844858-0cff-4590-ae89-95a5a5c8b4b8})"
+
+var IIDAsyncActionProgressHandler = ole.GUID{Data1: 0x6d844858, Data2: 0x0cff, Data3: 0x4590, Data4: [8]byte{0xae, 0x89, 0x95, 0xa5, 0xa5, 0xc8, 0xb4, 0xb8}}
+
+type AsyncActionProgressHandler struct {
+	ole.IUnknown
+	sync.Mutex
+	refs uint64
+	IID  ole.GUID
+}
+
+type AsyncActionProgressHandlerVtbl struct {
+	ole.IUnknownVtbl
+	Invoke uintptr
+}
+
+type AsyncActionProgressHandlerCallback func(instance *AsyncActionProgressHandler, asyncInfo *IAsyncActionWithProgress, progressInfo unsafe.Pointer)
+
+// asyncActionProgressHandlerEntry is the callback of a AsyncActionProgressHandler, and the dispatcher that runs it.
+type asyncActionProgressHandlerEntry struct {
+	callback       AsyncActionProgressHandlerCallback
+	dispatcher     *winrt.Dispatcher // nil for the callbacks that run inline
+	objectTypeArgs []bool
+}
+
+var callbacksAsyncActionProgressHandler = &asyncActionProgressHandlerCallbacks{
+	mu:        &sync.Mutex{},
+	callbacks: make(map[unsafe.Pointer]asyncActionProgressHandlerEntry),
+}
+
+var releaseChannelsAsyncActionProgressHandler = &asyncActionProgressHandlerReleaseChannels{
+	mu:    &sync.Mutex{},
+	chans: make(map[unsafe.Pointer]chan struct{}),
+}
+
+func NewAsyncActionProgressHandler(iid *ole.GUID, callback AsyncActionProgressHandlerCallback) *AsyncActionProgressHandler {
+	return newAsyncActionProgressHandler(iid, asyncActionProgressHandlerEntry{callback: callback})
+}
+
+// NewAsyncActionProgressHandlerWithDispatcher is like NewAsyncActionProgressHandler, but the callback is run by the given dispatcher.
+// A nil dispatcher runs the callback inline.
+// The type arguments of the delegate are passed as unsafe pointers: objectTypeArgs tells which of them
+// are objects, which are kept alive until the callback returns.
+func NewAsyncActionProgressHandlerWithDispatcher(iid *ole.GUID, dispatcher *winrt.Dispatcher, objectTypeArgs []bool, callback AsyncActionProgressHandlerCallback) *AsyncActionProgressHandler {
+	return newAsyncActionProgressHandler(iid, asyncActionProgressHandlerEntry{
+		callback:       callback,
+		dispatcher:     dispatcher,
+		objectTypeArgs: objectTypeArgs,
+	})
+}
+
+func newAsyncActionProgressHandler(iid *ole.GUID, entry asyncActionProgressHandlerEntry) *AsyncActionProgressHandler {
+	size := unsafe.Sizeof(*(*AsyncActionProgressHandler)(nil))
+	instPtr := kernel32.Malloc(size)
+	inst := (*AsyncActionProgressHandler)(instPtr)
+
+	callbacks := delegate.RegisterCallbacks(instPtr, inst)
+
+	// Initialize all properties: the malloc may contain garbage
+	inst.RawVTable = (*interface{})(unsafe.Pointer(&AsyncActionProgressHandlerVtbl{
+		IUnknownVtbl: ole.IUnknownVtbl{
+			QueryInterface: callbacks.QueryInterface,
+			AddRef:         callbacks.AddRef,
+			Release:        callbacks.Release,
+		},
+		Invoke: callbacks.Invoke,
+	}))
+	inst.IID = *iid // copy contents
+	inst.Mutex = sync.Mutex{}
+	inst.refs = 0
+
+	callbacksAsyncActionProgressHandler.add(unsafe.Pointer(inst), entry)
+
+	// See the docs in the releaseChannelsAsyncActionProgressHandler struct
+	releaseChannelsAsyncActionProgressHandler.acquire(unsafe.Pointer(inst))
+
+	inst.addRef()
+	return inst
+}
+
+func (r *AsyncActionProgressHandler) GetIID() *ole.GUID {
+	return &r.IID
+}
+
+// addRef increments the reference counter by one
+func (r *AsyncActionProgressHandler) addRef() uint64 {
+	r.Lock()
+	defer r.Unlock()
+	r.refs++
+	return r.refs
+}
+
+// removeRef decrements the reference counter by one. If it was already zero, it will just return zero.
+func (r *AsyncActionProgressHandler) removeRef() uint64 {
+	r.Lock()
+	defer r.Unlock()
+
+	if r.refs > 0 {
+		r.refs--
+	}
+
+	return r.refs
+}
+
+func (instance *AsyncActionProgressHandler) Invoke(instancePtr, rawArgs0, rawArgs1, rawArgs2, rawArgs3, rawArgs4, rawArgs5, rawArgs6, rawArgs7, rawArgs8 unsafe.Pointer) uintptr {
+	asyncInfoPtr := rawArgs0
+	progressInfoPtr := rawArgs1
+
+	// See the quote above.
+	asyncInfo := (*IAsyncActionWithProgress)(asyncInfoPtr)
+	progressInfo := (unsafe.Pointer)(progressInfoPtr)
+	entry, ok := callbacksAsyncActionProgressHandler.get(instancePtr)
+	if !ok {
+		return ole.S_OK
+	}
+	if entry.dispatcher == nil {
+		entry.callback(instance, asyncInfo, progressInfo)
+		return ole.S_OK
+	}
+
+	// the delegate and the objects it received must outlive the call if the callback runs later
+	objects := []*ole.IUnknown{
+		&instance.IUnknown,
+		(*ole.IUnknown)(asyncInfoPtr),
+	}
+	if 0 < len(entry.objectTypeArgs) && entry.objectTypeArgs[0] {
+		objects = append(objects, (*ole.IUnknown)(progressInfo))
+	}
+	entry.dispatcher.Dispatch(func() {
+		entry.callback(instance, asyncInfo, progressInfo)
+	}, objects...)
+	return ole.S_OK
+}
+
+func (instance *AsyncActionProgressHandler) AddRef() uint64 {
+	return instance.addRef()
+}
+
+func (instance *AsyncActionProgressHandler) Release() uint64 {
+	rem := instance.removeRef()
+	if rem == 0 {
+		// We're done.
+		instancePtr := unsafe.Pointer(instance)
+		callbacksAsyncActionProgressHandler.delete(instancePtr)
+
+		// stop release channels used to avoid
+		// https://github.com/golang/go/issues/55015
+		releaseChannelsAsyncActionProgressHandler.release(instancePtr)
+
+		kernel32.Free(instancePtr)
+	}
+	return rem
+}
+
+type asyncActionProgressHandlerCallbacks struct {
+	mu        *sync.Mutex
+	callbacks map[unsafe.Pointer]asyncActionProgressHandlerEntry
+}
+
+func (m *asyncActionProgressHandlerCallbacks) add(p unsafe.Pointer, v asyncActionProgressHandlerEntry) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.callbacks[p] = v
+}
+
+func (m *asyncActionProgressHandlerCallbacks) get(p unsafe.Pointer) (asyncActionProgressHandlerEntry, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	v, ok := m.callbacks[p]
+	return v, ok
+}
+
+func (m *asyncActionProgressHandlerCallbacks) delete(p unsafe.Pointer) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	delete(m.callbacks, p)
+}
+
+// typedEventHandlerReleaseChannels keeps a map with channels
+// used to keep a goroutine alive during the lifecycle of this object.
+// This is required to avoid causing a deadlock error.
+// See this: https://github.com/golang/go/issues/55015
+type asyncActionProgressHandlerReleaseChannels struct {
+	mu    *sync.Mutex
+	chans map[unsafe.Pointer]chan struct{}
+}
+
+func (m *asyncActionProgressHandlerReleaseChannels) acquire(p unsafe.Pointer) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	c := make(chan struct{})
+	m.chans[p] = c
+
+	go func() {
+		// we need a timer to trick the go runtime into
+		// thinking there's still something going on here
+		// but we are only really interested in <-c
+		t := time.NewTimer(time.Minute)
+		for {
+			select {
+			case <-t.C:
+				t.Reset(time.Minute)
+			case <-c:
+				t.Stop()
+				return
+			}
+		}
+	}()
+}
+
+func (m *asyncActionProgressHandlerReleaseChannels) release(p unsafe.Pointer) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if c, ok := m.chans[p]; ok {
+		close(c)
+		delete(m.chans, p)
+	}
+}
@@ -0,0 +1,235 @@
+// Code generated by winrt-go-gen. DO NOT EDIT.
+
+//go:build windows
+
+//nolint:all
+package foundation
+
+import (
+	"sync"
+	"time"
+	"unsafe"
+
+	"github.com/go-ole/go-ole"
+	"github.com/waylyrics/winrt-go"
+	"github.com/waylyrics/winrt-go/internal/delegate"
+	"github.com/waylyrics/winrt-go/internal/kernel32"
+)
+
+const GUIDAsyncActionWithProgressCompletedHandler string = "9c029f91-cc84-44fd-ac26-0a6c4e555281"
+const SignatureAsyncActionWithProgressCompletedHandler string = "delegate({9c029f91-cc84-44fd-ac26-0a6c4e555281})"
+
+var IIDAsyncActionWithProgressCompletedHandler = ole.GUID{Data1: 0x9c029f91, Data2: 0xcc84, Data3: 0x44fd, Data4: [8]byte{0xac, 0x26, 0x0a, 0x6c, 0x4e, 0x55, 0x52, 0x81}}
+
+type AsyncActionWithProgressCompletedHandler struct {
+	ole.IUnknown
+	sync.Mutex
+	refs uint64
+	IID  ole.GUID
+}
+
+type AsyncActionWithProgressCompletedHandlerVtbl struct {
+	ole.IUnknownVtbl
+	Invoke uintptr
+}
+
+type AsyncActionWithProgressCompletedHandlerCallback func(instance *AsyncActionWithProgressCompletedHandler, asyncInfo *IAsyncActionWithProgress, asyncStatus AsyncStatus)
+
+// asyncActionWithProgressCompletedHandlerEntry is the callback of a AsyncActionWithProgressCompletedHandler, and the dispatcher that runs it.
+type asyncActionWithProgressCompletedHandlerEntry struct {
+	callback   AsyncActionWithProgressCompletedHandlerCallback
+	dispatcher *winrt.Dispatcher // nil for the callbacks that run inline
+}
+
+var callbacksAsyncActionWithProgressCompletedHandler = &asyncActionWithProgressCompletedHandlerCallbacks{
+	mu:        &sync.Mutex{},
+	callbacks: make(map[unsafe.Pointer]asyncActionWithProgressCompletedHandlerEntry),
+}
+
+var releaseChannelsAsyncActionWithProgressCompletedHandler = &asyncActionWithProgressCompletedHandlerReleaseChannels{
+	mu:    &sync.Mutex{},
+	chans: make(map[unsafe.Pointer]chan struct{}),
+}
+
+func NewAsyncActionWithProgressCompletedHandler(iid *ole.GUID, callback AsyncActionWithProgressCompletedHandlerCallback) *AsyncActionWithProgressCompletedHandler {
+	return newAsyncActionWithProgressCompletedHandler(iid, asyncActionWithProgressCompletedHandlerEntry{callback: callback})
+}
+
+// NewAsyncActionWithProgressCompletedHandlerWithDispatcher is like NewAsyncActionWithProgressCompletedHandler, but the callback is run by the given dispatcher.
+// A nil dispatcher runs the callback inline.
+func NewAsyncActionWithProgressCompletedHandlerWithDispatcher(iid *ole.GUID, dispatcher *winrt.Dispatcher, callback AsyncActionWithProgressCompletedHandlerCallback) *AsyncActionWithProgressCompletedHandler {
+	return newAsyncActionWithProgressCompletedHandler(iid, asyncActionWithProgressCompletedHandlerEntry{
+		callback:   callback,
+		dispatcher: dispatcher,
+	})
+}
+
+func newAsyncActionWithProgressCompletedHandler(iid *ole.GUID, entry asyncActionWithProgressCompletedHandlerEntry) *AsyncActionWithProgressCompletedHandler {
+	size := unsafe.Sizeof(*(*AsyncActionWithProgressCompletedHandler)(nil))
+	instPtr := kernel32.Malloc(size)
+	inst := (*AsyncActionWithProgressCompletedHandler)(instPtr)
+
+	callbacks := delegate.RegisterCallbacks(instPtr, inst)
+
+	// Initialize all properties: the malloc may contain garbage
+	inst.RawVTable = (*interface{})(unsafe.Pointer(&AsyncActionWithProgressCompletedHandlerVtbl{
+		IUnknownVtbl: ole.IUnknownVtbl{
+			QueryInterface: callbacks.QueryInterface,
+			AddRef:         callbacks.AddRef,
+			Release:        callbacks.Release,
+		},
+		Invoke: callbacks.Invoke,
+	}))
+	inst.IID = *iid // copy contents
+	inst.Mutex = sync.Mutex{}
+	inst.refs = 0
+
+	callbacksAsyncActionWithProgressCompletedHandler.add(unsafe.Pointer(inst), entry)
+
+	// See the docs in the releaseChannelsAsyncActionWithProgressCompletedHandler struct
+	releaseChannelsAsyncActionWithProgressCompletedHandler.acquire(unsafe.Pointer(inst))
+
+	inst.addRef()
+	return inst
+}
+
+func (r *AsyncActionWithProgressCompletedHandler) GetIID() *ole.GUID {
+	return &r.IID
+}
+
+// addRef increments the reference counter by one
+func (r *AsyncActionWithProgressCompletedHandler) addRef() uint64 {
+	r.Lock()
+	defer r.Unlock()
+	r.refs++
+	return r.refs
+}
+
+// removeRef decrements the reference counter by one. If it was already zero, it will just return zero.
+func (r *AsyncActionWithProgressCompletedHandler) removeRef() uint64 {
+	r.Lock()
+	defer r.Unlock()
+
+	if r.refs > 0 {
+		r.refs--
+	}
+
+	return r.refs
+}
+
+func (instance *AsyncActionWithProgressCompletedHandler) Invoke(instancePtr, rawArgs0, rawArgs1, rawArgs2, rawArgs3, rawArgs4, rawArgs5, rawArgs6, rawArgs7, rawArgs8 unsafe.Pointer) uintptr {
+	asyncInfoPtr := rawArgs0
+	asyncStatusRaw := (int32)(uintptr(rawArgs1))
+
+	// See the quote above.
+	asyncInfo := (*IAsyncActionWithProgress)(asyncInfoPtr)
+	asyncStatus := (AsyncStatus)(asyncStatusRaw)
+	entry, ok := callbacksAsyncActionWithProgressCompletedHandler.get(instancePtr)
+	if !ok {
+		return ole.S_OK
+	}
+	if entry.dispatcher == nil {
+		entry.callback(instance, asyncInfo, asyncStatus)
+		return ole.S_OK
+	}
+
+	// the delegate and the objects it received must outlive the call if the callback runs later
+	objects := []*ole.IUnknown{
+		&instance.IUnknown,
+		(*ole.IUnknown)(asyncInfoPtr),
+	}
+	entry.dispatcher.Dispatch(func() {
+		entry.callback(instance, asyncInfo, asyncStatus)
+	}, objects...)
+	return ole.S_OK
+}
+
+func (instance *AsyncActionWithProgressCompletedHandler) AddRef() uint64 {
+	return instance.addRef()
+}
+
+func (instance *AsyncActionWithProgressCompletedHandler) Release() uint64 {
+	rem := instance.removeRef()
+	if rem == 0 {
+		// We're done.
+		instancePtr := unsafe.Pointer(instance)
+		callbacksAsyncActionWithProgressCompletedHandler.delete(instancePtr)
+
+		// stop release channels used to avoid
+		// https://github.com/golang/go/issues/55015
+		releaseChannelsAsyncActionWithProgressCompletedHandler.release(instancePtr)
+
+		kernel32.Free(instancePtr)
+	}
+	return rem
+}
+
+type asyncActionWithProgressCompletedHandlerCallbacks struct {
+	mu        *sync.Mutex
+	callbacks map[unsafe.Pointer]asyncActionWithProgressCompletedHandlerEntry
+}
+
+func (m *asyncActionWithProgressCompletedHandlerCallbacks) add(p unsafe.Pointer, v asyncActionWithProgressCompletedHandlerEntry) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.callbacks[p] = v
+}
+
+func (m *asyncActionWithProgressCompletedHandlerCallbacks) get(p unsafe.Pointer) (asyncActionWithProgressCompletedHandlerEntry, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	v, ok := m.callbacks[p]
+	return v, ok
+}
+
+func (m *asyncActionWithProgressCompletedHandlerCallbacks) delete(p unsafe.Pointer) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	delete(m.callbacks, p)
+}
+
+// typedEventHandlerReleaseChannels keeps a map with channels
+// used to keep a goroutine alive during the lifecycle of this object.
+// This is required to avoid causing a deadlock error.
+// See this: https://github.com/golang/go/issues/55015
+type asyncActionWithProgressCompletedHandlerReleaseChannels struct {
+	mu    *sync.Mutex
+	chans map[unsafe.Pointer]chan struct{}
+}
+
+func (m *asyncActionWithProgressCompletedHandlerReleaseChannels) acquire(p unsafe.Pointer) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	c := make(chan struct{})
+	m.chans[p] = c
+
+	go func() {
+		// we need a timer to trick the go runtime into
+		// thinking there's still something going on here
+		// but we are only really interested in <-c
+		t := time.NewTimer(time.Minute)
+		for {
+			select {
+			case <-t.C:
+				t.Reset(time.Minute)
+			case <-c:
+				t.Stop()
+				return
+			}
+		}
+	}()
+}
+
+func (m *asyncActionWithProgressCompletedHandlerReleaseChannels) release(p unsafe.Pointer) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if c, ok := m.chans[p]; ok {
+		close(c)
+		delete(m.chans, p)
+	}
+}
@@ -0,0 +1,235 @@
+// Code generated by winrt-go-gen. DO NOT EDIT.
+
+//go:build windows
+
+//nolint:all
+package foundation
+
+import (
+	"sync"
+	"time"
+	"unsafe"
+
+	"github.com/go-ole/go-ole"
+	"github.com/waylyrics/winrt-go"
+	"github.com/waylyrics/winrt-go/internal/delegate"
+	"github.com/waylyrics/winrt-go/internal/kernel32"
+)
+
+const GUIDAsyncOperationCompletedHandler string = "fcdcf02c-e5d8-4478-915a-4d90b74b83a5"
+const SignatureAsyncOperationCompletedHandler string = "delegate({fcdcf02c-e5d8-4478-915a-4d90b74b83a5})"
+
+var IIDAsyncOperationCompletedHandler = ole.GUID{Data1: 0xfcdcf02c, Data2: 0xe5d8, Data3: 0x4478, Data4: [8]byte{0x91, 0x5a, 0x4d, 0x90, 0xb7, 0x4b, 0x83, 0xa5}}
+
+type AsyncOperationCompletedHandler struct {
+	ole.IUnknown
+	sync.Mutex
+	refs uint64
+	IID  ole.GUID
+}
+
+type AsyncOperationCompletedHandlerVtbl struct {
+	ole.IUnknownVtbl
+	Invoke uintptr
+}
+
+type AsyncOperationCompletedHandlerCallback func(instance *AsyncOperationCompletedHandler, asyncInfo *IAsyncOperation, asyncStatus AsyncStatus)
+
+// asyncOperationCompletedHandlerEntry is the callback of a AsyncOperationCompletedHandler, and the dispatcher that runs it.
+type asyncOperationCompletedHandlerEntry struct {
+	callback   AsyncOperationCompletedHandlerCallback
+	dispatcher *winrt.Dispatcher // nil for the callbacks that run inline
+}
+
+var callbacksAsyncOperationCompletedHandler = &asyncOperationCompletedHandlerCallbacks{
+	mu:        &sync.Mutex{},
+	callbacks: make(map[unsafe.Pointer]asyncOperationCompletedHandlerEntry),
+}
+
+var releaseChannelsAsyncOperationCompletedHandler = &asyncOperationCompletedHandlerReleaseChannels{
+	mu:    &sync.Mutex{},
+	chans: make(map[unsafe.Pointer]chan struct{}),
+}
+
+func NewAsyncOperationCompletedHandler(iid *ole.GUID, callback AsyncOperationCompletedHandlerCallback) *AsyncOperationCompletedHandler {
+	return newAsyncOperationCompletedHandler(iid, asyncOperationCompletedHandlerEntry{callback: callback})
+}
+
+// NewAsyncOperationCompletedHandlerWithDispatcher is like NewAsyncOperationCompletedHandler, but the callback is run by the given dispatcher.
+// A nil dispatcher runs the callback inline.
+func NewAsyncOperationCompletedHandlerWithDispatcher(iid *ole.GUID, dispatcher *winrt.Dispatcher, callback AsyncOperationCompletedHandlerCallback) *AsyncOperationCompletedHandler {
+	return newAsyncOperationCompletedHandler(iid, asyncOperationCompletedHandlerEntry{
+		callback:   callback,
+		dispatcher: dispatcher,
+	})
+}
+
+func newAsyncOperationCompletedHandler(iid *ole.GUID, entry asyncOperationCompletedHandlerEntry) *AsyncOperationCompletedHandler {
+	size := unsafe.Sizeof(*(*AsyncOperationCompletedHandler)(nil))
+	instPtr := kernel32.Malloc(size)
+	inst := (*AsyncOperationCompletedHandler)(instPtr)
+
+	callbacks := delegate.RegisterCallbacks(instPtr, inst)
+
+	// Initialize all properties: the malloc may contain garbage
+	inst.RawVTable = (*interface{})(unsafe.Pointer(&AsyncOperationCompletedHandlerVtbl{
+		IUnknownVtbl: ole.IUnknownVtbl{
+			QueryInterface: callbacks.QueryInterface,
+			AddRef:         callbacks.AddRef,
+			Release:        callbacks.Release,
+		},
+		Invoke: callbacks.Invoke,
+	}))
+	inst.IID = *iid // copy contents
+	inst.Mutex = sync.Mutex{}
+	inst.refs = 0
+
+	callbacksAsyncOperationCompletedHandler.add(unsafe.Pointer(inst), entry)
+
+	// See the docs in the releaseChannelsAsyncOperationCompletedHandler struct
+	releaseChannelsAsyncOperationCompletedHandler.acquire(unsafe.Pointer(inst))
+
+	inst.addRef()
+	return inst
+}
+
+func (r *AsyncOperationCompletedHandler) GetIID() *ole.GUID {
+	return &r.IID
+}
+
+// addRef increments the reference counter by one
+func (r *AsyncOperationCompletedHandler) addRef() uint64 {
+	r.Lock()
+	defer r.Unlock()
+	r.refs++
+	return r.refs
+}
+
+// removeRef decrements the reference counter by one. If it was already zero, it will just return zero.
+func (r *AsyncOperationCompletedHandler) removeRef() uint64 {
+	r.Lock()
+	defer r.Unlock()
+
+	if r.refs > 0 {
+		r.refs--
+	}
+
+	return r.refs
+}
+
+func (instance *AsyncOperationCompletedHandler) Invoke(instancePtr, rawArgs0, rawArgs1, rawArgs2, rawArgs3, rawArgs4, rawArgs5, rawArgs6, rawArgs7, rawArgs8 unsafe.Pointer) uintptr {
+	asyncInfoPtr := rawArgs0
+	asyncStatusRaw := (int32)(uintptr(rawArgs1))
+
+	// See the quote above.
+	asyncInfo := (*IAsyncOperation)(asyncInfoPtr)
+	asyncStatus := (AsyncStatus)(asyncStatusRaw)
+	entry, ok := callbacksAsyncOperationCompletedHandler.get(instancePtr)
+	if !ok {
+		return ole.S_OK
+	}
+	if entry.dispatcher == nil {
+		entry.callback(instance, asyncInfo, asyncStatus)
+		return ole.S_OK
+	}
+
+	// the delegate and the objects it received must outlive the call if the callback runs later
+	objects := []*ole.IUnknown{
+		&instance.IUnknown,
+		(*ole.IUnknown)(asyncInfoPtr),
+	}
+	entry.dispatcher.Dispatch(func() {
+		entry.callback(instance, asyncInfo, asyncStatus)
+	}, objects...)
+	return ole.S_OK
+}
+
+func (instance *AsyncOperationCompletedHandler) AddRef() uint64 {
+	return instance.addRef()
+}
+
+func (instance *AsyncOperationCompletedHandler) Release() uint64 {
+	rem := instance.removeRef()
+	if rem == 0 {
+		// We're done.
+		instancePtr := unsafe.Pointer(instance)
+		callbacksAsyncOperationCompletedHandler.delete(instancePtr)
+
+		// stop release channels used to avoid
+		// https://github.com/golang/go/issues/55015
+		releaseChannelsAsyncOperationCompletedHandler.release(instancePtr)
+
+		kernel32.Free(instancePtr)
+	}
+	return rem
+}
+
+type asyncOperationCompletedHandlerCallbacks struct {
+	mu        *sync.Mutex
+	callbacks map[unsafe.Pointer]asyncOperationCompletedHandlerEntry
+}
+
+func (m *asyncOperationCompletedHandlerCallbacks) add(p unsafe.Pointer, v asyncOperationCompletedHandlerEntry) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.callbacks[p] = v
+}
+
+func (m *asyncOperationCompletedHandlerCallbacks) get(p unsafe.Pointer) (asyncOperationCompletedHandlerEntry, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	v, ok := m.callbacks[p]
+	return v, ok
+}
+
+func (m *asyncOperationCompletedHandlerCallbacks) delete(p unsafe.Pointer) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	delete(m.callbacks, p)
+}
+
+// typedEventHandlerReleaseChannels keeps a map with channels
+// used to keep a goroutine alive during the lifecycle of this object.
+// This is required to avoid causing a deadlock error.
+// See this: https://github.com/golang/go/issues/55015
+type asyncOperationCompletedHandlerReleaseChannels struct {
+	mu    *sync.Mutex
+	chans map[unsafe.Pointer]chan struct{}
+}
+
+func (m *asyncOperationCompletedHandlerReleaseChannels) acquire(p unsafe.Pointer) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	c := make(chan struct{})
+	m.chans[p] = c
+
+	go func() {
+		// we need a timer to trick the go runtime into
+		// thinking there's still something going on here
+		// but we are only really interested in <-c
+		t := time.NewTimer(time.Minute)
+		for {
+			select {
+			case <-t.C:
+				t.Reset(time.Minute)
+			case <-c:
+				t.Stop()
+				return
+			}
+		}
+	}()
+}
+
+func (m *asyncOperationCompletedHandlerReleaseChannels) release(p unsafe.Pointer) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if c, ok := m.chans[p]; ok {
+		close(c)
+		delete(m.chans, p)
+	}
+}
@@ -0,0 +1,242 @@
+// Code generated by winrt-go-gen. DO NOT EDIT.
+
+//go:build windows
+
+//nolint:all
+package foundation
+
+import (
+	"sync"
+	"time"
+	"unsafe"
+
+	"github.com/go-ole/go-ole"
+	"github.com/waylyrics/winrt-go"
+	"github.com/waylyrics/winrt-go/internal/delegate"
+	"github.com/waylyrics/winrt-go/internal/kernel32"
+)
+
+const GUIDAsyncOperationProgressHandler string = "55690902-0aab-421a-8778-f8ce5026d758"
+const SignatureAsyncOperationProgressHandler string = "delegate({55690902-0aab-421a-8778-f8ce5026d758})"
+
+var IIDAsyncOperationProgressHandler = ole.GUID{Data1: 0x55690902, Data2: 0x0aab, Data3: 0x421a, Data4: [8]byte{0x87, 0x78, 0xf8, 0xce, 0x50, 0x26, 0xd7, 0x58}}
+
+type AsyncOperationProgressHandler struct {
+	ole.IUnknown
+	sync.Mutex
+	refs uint64
+	IID  ole.GUID
+}
+
+type AsyncOperationProgressHandlerVtbl struct {
+	ole.IUnknownVtbl
+	Invoke uintptr
+}
+
+type AsyncOperationProgressHandlerCallback func(instance *AsyncOperationProgressHandler, asyncInfo *IAsyncOperationWithProgress, progressInfo unsafe.Pointer)
+
+// asyncOperationProgressHandlerEntry is the callback of a AsyncOperationProgressHandler, and the dispatcher that runs it.
+type asyncOperationProgressHandlerEntry struct {
+	callback       AsyncOperationProgressHandlerCallback
+	dispatcher     *winrt.Dispatcher // nil for the callbacks that run inline
+	objectTypeArgs []bool
+}
+
+var callbacksAsyncOperationProgressHandler = &asyncOperationProgressHandlerCallbacks{
+	mu:        &sync.Mutex{},
+	callbacks: make(map[unsafe.Pointer]asyncOperationProgressHandlerEntry),
+}
+
+var releaseChannelsAsyncOperationProgressHandler = &asyncOperationProgressHandlerReleaseChannels{
+	mu:    &sync.Mutex{},
+	chans: make(map[unsafe.Pointer]chan struct{}),
+}
+
+func NewAsyncOperationProgressHandler(iid *ole.GUID, callback AsyncOperationProgressHandlerCallback) *AsyncOperationProgressHandler {
+	return newAsyncOperationProgressHandler(iid, asyncOperationProgressHandlerEntry{callback: callback})
+}
+
+// NewAsyncOperationProgressHandlerWithDispatcher is like NewAsyncOperationProgressHandler, but the callback is run by the given dispatcher.
+// A nil dispatcher runs the callback inline.
+// The type arguments of the delegate are passed as unsafe pointers: objectTypeArgs tells which of them
+// are objects, which are kept alive until the callback returns.
+func NewAsyncOperationProgressHandlerWithDispatcher(iid *ole.GUID, dispatcher *winrt.Dispatcher, objectTypeArgs []bool, callback AsyncOperationProgressHandlerCallback) *AsyncOperationProgressHandler {
+	return newAsyncOperationProgressHandler(iid, asyncOperationProgressHandlerEntry{
+		callback:       callback,
+		dispatcher:     dispatcher,
+		objectTypeArgs: objectTypeArgs,
+	})
+}
+
+func newAsyncOperationProgressHandler(iid *ole.GUID, entry asyncOperationProgressHandlerEntry) *AsyncOperationProgressHandler {
+	size := unsafe.Sizeof(*(*AsyncOperationProgressHandler)(nil))
+	instPtr := kernel32.Malloc(size)
+	inst := (*AsyncOperationProgressHandler)(instPtr)
+
+	callbacks := delegate.RegisterCallbacks(instPtr, inst)
+
+	// Initialize all properties: the malloc may contain garbage
+	inst.RawVTable = (*interface{})(unsafe.Pointer(&AsyncOperationProgressHandlerVtbl{
+		IUnknownVtbl: ole.IUnknownVtbl{
+			QueryInterface: callbacks.QueryInterface,
+			AddRef:         callbacks.AddRef,
+			Release:        callbacks.Release,
+		},
+		Invoke: callbacks.Invoke,
+	}))
+	inst.IID = *iid // copy contents
+	inst.Mutex = sync.Mutex{}
+	inst.refs = 0
+
+	callbacksAsyncOperationProgressHandler.add(unsafe.Pointer(inst), entry)
+
+	// See the docs in the releaseChannelsAsyncOperationProgressHandler struct
+	releaseChannelsAsyncOperationProgressHandler.acquire(unsafe.Pointer(inst))
+
+	inst.addRef()
+	return inst
+}
+
+func (r *AsyncOperationProgressHandler) GetIID() *ole.GUID {
+	return &r.IID
+}
+
+// addRef increments the reference counter by one
+func (r *AsyncOperationProgressHandler) addRef() uint64 {
+	r.Lock()
+	defer r.Unlock()
+	r.refs++
+	return r.refs
+}
+
+// removeRef decrements the reference counter by one. If it was already zero, it will just return zero.
+func (r *AsyncOperationProgressHandler) removeRef() uint64 {
+	r.Lock()
+	defer r.Unlock()
+
+	if r.refs > 0 {
+		r.refs--
+	}
+
+	return r.refs
+}
+
+func (instance *AsyncOperationProgressHandler) Invoke(instancePtr, rawArgs0, rawArgs1, rawArgs2, rawArgs3, rawArgs4, rawArgs5, rawArgs6, rawArgs7, rawArgs8 unsafe.Pointer) uintptr {
+	asyncInfoPtr := rawArgs0
+	progressInfoPtr := rawArgs1
+
+	// See the quote above.
+	asyncInfo := (*IAsyncOperationWithProgress)(asyncInfoPtr)
+	progressInfo := (unsafe.Pointer)(progressInfoPtr)
+	entry, ok := callbacksAsyncOperationProgressHandler.get(instancePtr)
+	if !ok {
+		return ole.S_OK
+	}
+	if entry.dispatcher == nil {
+		entry.callback(instance, asyncInfo, progressInfo)
+		return ole.S_OK
+	}
+
+	// the delegate and the objects it received must outlive the call if the callback runs later
+	objects := []*ole.IUnknown{
+		&instance.IUnknown,
+		(*ole.IUnknown)(asyncInfoPtr),
+	}
+	if 1 < len(entry.objectTypeArgs) && entry.objectTypeArgs[1] {
+		objects = append(objects, (*ole.IUnknown)(progressInfo))
+	}
+	entry.dispatcher.Dispatch(func() {
+		entry.callback(instance, asyncInfo, progressInfo)
+	}, objects...)
+	return ole.S_OK
+}
+
+func (instance *AsyncOperationProgressHandler) AddRef() uint64 {
+	return instance.addRef()
+}
+
+func (instance *AsyncOperationProgressHandler) Release() uint64 {
+	rem := instance.removeRef()
+	if rem == 0 {
+		// We're done.
+		instancePtr := unsafe.Pointer(instance)
+		callbacksAsyncOperationProgressHandler.delete(instancePtr)
+
+		// stop release channels used to avoid
+		// https://github.com/golang/go/issues/55015
+		releaseChannelsAsyncOperationProgressHandler.release(instancePtr)
+
+		kernel32.Free(instancePtr)
+	}
+	return rem
+}
+
+type asyncOperationProgressHandlerCallbacks struct {
+	mu        *sync.Mutex
+	callbacks map[unsafe.Pointer]asyncOperationProgressHandlerEntry
+}
+
+func (m *asyncOperationProgressHandlerCallbacks) add(p unsafe.Pointer, v asyncOperationProgressHandlerEntry) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.callbacks[p] = v
+}
+
+func (m *asyncOperationProgressHandlerCallbacks) get(p unsafe.Pointer) (asyncOperationProgressHandlerEntry, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	v, ok := m.callbacks[p]
+	return v, ok
+}
+
+func (m *asyncOperationProgressHandlerCallbacks) delete(p unsafe.Pointer) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	delete(m.callbacks, p)
+}
+
+// typedEventHandlerReleaseChannels keeps a map with channels
+// used to keep a goroutine alive during the lifecycle of this object.
+// This is required to avoid causing a deadlock error.
+// See this: https://github.com/golang/go/issues/55015
+type asyncOperationProgressHandlerReleaseChannels struct {
+	mu    *sync.Mutex
+	chans map[unsafe.Pointer]chan struct{}
+}
+
+func (m *asyncOperationProgressHandlerReleaseChannels) acquire(p unsafe.Pointer) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	c := make(chan struct{})
+	m.chans[p] = c
+
+	go func() {
+		// we need a timer to trick the go runtime into
+		// thinking there's still something going on here
+		// but we are only really interested in <-c
+		t := time.NewTimer(time.Minute)
+		for {
+			select {
+			case <-t.C:
+				t.Reset(time.Minute)
+			case <-c:
+				t.Stop()
+				return
+			}
+		}
+	}()
+}
+
+func (m *asyncOperationProgressHandlerReleaseChannels) release(p unsafe.Pointer) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if c, ok := m.chans[p]; ok {
+		close(c)
+		delete(m.chans, p)
+	}
+}
@@ -0,0 +1,65 @@
+// Code generated by winrt-go-gen. DO NOT EDIT.
+
+//go:build windows
+
+//nolint:all
+package foundation
+
+import "fmt"
+
+type AsyncStatus int32
+
+const SignatureAsyncStatus string = "enum(Windows.Foundation.AsyncStatus;i4)"
+
+const (
+	AsyncStatusCanceled  AsyncStatus = 2
+	AsyncStatusCompleted AsyncStatus = 1
+	AsyncStatusError     AsyncStatus = 3
+	AsyncStatusStarted   AsyncStatus = 0
+)
+
+var valuesAsyncStatus = []AsyncStatus{
+	AsyncStatusCanceled,
+	AsyncStatusCompleted,
+	AsyncStatusError,
+	AsyncStatusStarted,
+}
+
+var namesAsyncStatus = []string{
+	"Canceled",
+	"Completed",
+	"Error",
+	"Started",
+}
+
+// ValuesAsyncStatus returns all the values defined by AsyncStatus.
+func ValuesAsyncStatus() []AsyncStatus {
+	return append([]AsyncStatus(nil), valuesAsyncStatus...)
+}
+
+// String returns the name of the value.
+func (v AsyncStatus) String() string {
+	for i, value := range valuesAsyncStatus {
+		if value == v {
+			return namesAsyncStatus[i]
+		}
+	}
+	return fmt.Sprintf("AsyncStatus(%d)", int32(v))
+}
+
+// ParseAsyncStatus returns the value with the given name.
+func ParseAsyncStatus(s string) (AsyncStatus, error) {
+	if v, ok := lookupAsyncStatus(s); ok {
+		return v, nil
+	}
+	return 0, fmt.Errorf("invalid AsyncStatus %q", s)
+}
+
+func lookupAsyncStatus(name string) (AsyncStatus, bool) {
+	for i, n := range namesAsyncStatus {
+		if n == name {
+			return valuesAsyncStatus[i], true
+		}
+	}
+	return 0, false
+}
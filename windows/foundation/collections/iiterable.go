@@ -0,0 +1,92 @@
+// Code generated by winrt-go-gen. DO NOT EDIT.
+
+//go:build windows
+
+//nolint:all
+package collections
+
+import (
+	"syscall"
+	"unsafe"
+
+	"github.com/go-ole/go-ole"
+	"github.com/waylyrics/winrt-go"
+)
+
+const GUIDIIterable string = "faa585ea-6214-4217-afda-7f46de5869b3"
+const SignatureIIterable string = "{faa585ea-6214-4217-afda-7f46de5869b3}"
+
+var IIDIIterable = ole.GUID{Data1: 0xfaa585ea, Data2: 0x6214, Data3: 0x4217, Data4: [8]byte{0xaf, 0xda, 0x7f, 0x46, 0xde, 0x58, 0x69, 0xb3}}
+
+// IIterable was introduced in Windows.Foundation.FoundationContract v1.0.
+type IIterable struct {
+	ole.IInspectable
+}
+
+type IIterableVtbl struct {
+	ole.IInspectableVtbl
+
+	First uintptr
+}
+
+func (v *IIterable) VTable() *IIterableVtbl {
+	return (*IIterableVtbl)(unsafe.Pointer(v.RawVTable))
+}
+
+// First was introduced in Windows.Foundation.FoundationContract v1.0.
+func (v *IIterable) First() (*IIterator, error) {
+	var out *IIterator
+	hr, _, _ := syscall.Syscall(
+		v.VTable().First,
+		2,                             // nargs
+		uintptr(unsafe.Pointer(v)),    // this
+		uintptr(unsafe.Pointer(&out)), // out IIterator
+		0,
+	)
+
+	if hr != 0 {
+		return nil, ole.NewError(hr)
+	}
+
+	return out, nil
+}
+
+// IIterableOf is a typed wrapper of IIterable, instantiated with the type arguments T.
+type IIterableOf[T any] struct {
+	*IIterable
+	signatures []string
+}
+
+// NewIIterableOf wraps the given IIterable. The signatures of the type arguments are
+// required to compute the IID of the instantiated interface. It panics if a type argument
+// cannot be passed to the vtable calls, see winrt.CheckTypeArg.
+func NewIIterableOf[T any](v *IIterable, signatureT string) *IIterableOf[T] {
+	winrt.CheckTypeArg[T]()
+	return &IIterableOf[T]{
+		IIterable:  v,
+		signatures: []string{signatureT},
+	}
+}
+
+// Signature returns the signature of the instantiated interface.
+func (w *IIterableOf[T]) Signature() string {
+	return winrt.ParameterizedInstanceSignature(GUIDIIterable, w.signatures...)
+}
+
+// Signatures returns the signatures of the type arguments.
+func (w *IIterableOf[T]) Signatures() []string {
+	return w.signatures
+}
+
+// IID returns the IID of the instantiated interface.
+func (w *IIterableOf[T]) IID() *ole.GUID {
+	return ole.NewGUID(winrt.ParameterizedInstanceGUID(GUIDIIterable, w.signatures...))
+}
+
+func (w *IIterableOf[T]) First() (*IIteratorOf[T], error) {
+	out, err := w.IIterable.First()
+	if err != nil {
+		return nil, err
+	}
+	return NewIIteratorOf[T](out, w.signatures[0]), nil
+}
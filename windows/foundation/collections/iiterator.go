@@ -0,0 +1,186 @@
+// Code generated by winrt-go-gen. DO NOT EDIT.
+
+//go:build windows
+
+//nolint:all
+package collections
+
+import (
+	"syscall"
+	"unsafe"
+
+	"github.com/go-ole/go-ole"
+	"github.com/waylyrics/winrt-go"
+)
+
+const GUIDIIterator string = "6a79e863-4300-459a-9966-cbb660963ee1"
+const SignatureIIterator string = "{6a79e863-4300-459a-9966-cbb660963ee1}"
+
+var IIDIIterator = ole.GUID{Data1: 0x6a79e863, Data2: 0x4300, Data3: 0x459a, Data4: [8]byte{0x99, 0x66, 0xcb, 0xb6, 0x60, 0x96, 0x3e, 0xe1}}
+
+// IIterator was introduced in Windows.Foundation.FoundationContract v1.0.
+type IIterator struct {
+	ole.IInspectable
+}
+
+type IIteratorVtbl struct {
+	ole.IInspectableVtbl
+
+	GetCurrent    uintptr
+	GetHasCurrent uintptr
+	MoveNext      uintptr
+	GetMany       uintptr
+}
+
+func (v *IIterator) VTable() *IIteratorVtbl {
+	return (*IIteratorVtbl)(unsafe.Pointer(v.RawVTable))
+}
+
+// GetCurrent was introduced in Windows.Foundation.FoundationContract v1.0.
+func (v *IIterator) GetCurrent() (unsafe.Pointer, error) {
+	var out unsafe.Pointer
+	hr, _, _ := syscall.Syscall(
+		v.VTable().GetCurrent,
+		2,                             // nargs
+		uintptr(unsafe.Pointer(v)),    // this
+		uintptr(unsafe.Pointer(&out)), // out unsafe.Pointer
+		0,
+	)
+
+	if hr != 0 {
+		return nil, ole.NewError(hr)
+	}
+
+	return out, nil
+}
+
+// GetHasCurrent was introduced in Windows.Foundation.FoundationContract v1.0.
+func (v *IIterator) GetHasCurrent() (bool, error) {
+	var out bool
+	hr, _, _ := syscall.Syscall(
+		v.VTable().GetHasCurrent,
+		2,                             // nargs
+		uintptr(unsafe.Pointer(v)),    // this
+		uintptr(unsafe.Pointer(&out)), // out bool
+		0,
+	)
+
+	if hr != 0 {
+		return false, ole.NewError(hr)
+	}
+
+	return out, nil
+}
+
+// MoveNext was introduced in Windows.Foundation.FoundationContract v1.0.
+func (v *IIterator) MoveNext() (bool, error) {
+	var out bool
+	hr, _, _ := syscall.Syscall(
+		v.VTable().MoveNext,
+		2,                             // nargs
+		uintptr(unsafe.Pointer(v)),    // this
+		uintptr(unsafe.Pointer(&out)), // out bool
+		0,
+	)
+
+	if hr != 0 {
+		return false, ole.NewError(hr)
+	}
+
+	return out, nil
+}
+
+// GetMany was introduced in Windows.Foundation.FoundationContract v1.0.
+func (v *IIterator) GetMany(items []unsafe.Pointer) (uint32, error) {
+	var out uint32
+	hr, _, _ := syscall.Syscall6(
+		v.VTable().GetMany,
+		4,                             // nargs
+		uintptr(unsafe.Pointer(v)),    // this
+		uintptr(len(items)),           // in uint32
+		winrt.ArrayABI(items),         // out []unsafe.Pointer
+		uintptr(unsafe.Pointer(&out)), // out uint32
+		0,
+		0,
+	)
+
+	if hr != 0 {
+		return 0, ole.NewError(hr)
+	}
+
+	return out, nil
+}
+
+// IIteratorOf is a typed wrapper of IIterator, instantiated with the type arguments T.
+type IIteratorOf[T any] struct {
+	*IIterator
+	signatures []string
+}
+
+// NewIIteratorOf wraps the given IIterator. The signatures of the type arguments are
+// required to compute the IID of the instantiated interface. It panics if a type argument
+// cannot be passed to the vtable calls, see winrt.CheckTypeArg.
+func NewIIteratorOf[T any](v *IIterator, signatureT string) *IIteratorOf[T] {
+	winrt.CheckTypeArg[T]()
+	return &IIteratorOf[T]{
+		IIterator:  v,
+		signatures: []string{signatureT},
+	}
+}
+
+// Signature returns the signature of the instantiated interface.
+func (w *IIteratorOf[T]) Signature() string {
+	return winrt.ParameterizedInstanceSignature(GUIDIIterator, w.signatures...)
+}
+
+// Signatures returns the signatures of the type arguments.
+func (w *IIteratorOf[T]) Signatures() []string {
+	return w.signatures
+}
+
+// IID returns the IID of the instantiated interface.
+func (w *IIteratorOf[T]) IID() *ole.GUID {
+	return ole.NewGUID(winrt.ParameterizedInstanceGUID(GUIDIIterator, w.signatures...))
+}
+
+func (w *IIteratorOf[T]) GetCurrent() (T, error) {
+	v := w.IIterator
+	var out T
+	hr, _, _ := syscall.Syscall(
+		v.VTable().GetCurrent,
+		2,                             // nargs
+		uintptr(unsafe.Pointer(v)),    // this
+		uintptr(unsafe.Pointer(&out)), // out T
+		0,
+	)
+
+	if hr != 0 {
+		return *new(T), ole.NewError(hr)
+	}
+
+	winrt.TypeArgResult(&out)
+	return out, nil
+}
+
+func (w *IIteratorOf[T]) GetMany(items []T) (uint32, error) {
+	v := w.IIterator
+	winrt.ClearTypeArgArray(items)
+	var out uint32
+	hr, _, _ := syscall.Syscall6(
+		v.VTable().GetMany,
+		4,                             // nargs
+		uintptr(unsafe.Pointer(v)),    // this
+		uintptr(len(items)),           // in uint32
+		winrt.ArrayABI(items),         // out []T
+		uintptr(unsafe.Pointer(&out)), // out uint32
+		0,
+		0,
+	)
+
+	if hr != 0 {
+		return 0, ole.NewError(hr)
+	}
+
+	winrt.TypeArgResults(items)
+	return out, nil
+}
@@ -10,11 +10,15 @@ import (
 	"unsafe"
 
 	"github.com/go-ole/go-ole"
+	"github.com/waylyrics/winrt-go"
 )
 
 const GUIDIVector string = "913337e9-11a1-4345-a3a2-4e7f956e222d"
 const SignatureIVector string = "{913337e9-11a1-4345-a3a2-4e7f956e222d}"
 
+var IIDIVector = ole.GUID{Data1: 0x913337e9, Data2: 0x11a1, Data3: 0x4345, Data4: [8]byte{0xa3, 0xa2, 0x4e, 0x7f, 0x95, 0x6e, 0x22, 0x2d}}
+
+// IVector was introduced in Windows.Foundation.FoundationContract v1.0.
 type IVector struct {
 	ole.IInspectable
 }
@@ -40,10 +44,12 @@ func (v *IVector) VTable() *IVectorVtbl {
 	return (*IVectorVtbl)(unsafe.Pointer(v.RawVTable))
 }
 
+// GetAt was introduced in Windows.Foundation.FoundationContract v1.0.
 func (v *IVector) GetAt(index uint32) (unsafe.Pointer, error) {
 	var out unsafe.Pointer
-	hr, _, _ := syscall.SyscallN(
+	hr, _, _ := syscall.Syscall(
 		v.VTable().GetAt,
+		3,                             // nargs
 		uintptr(unsafe.Pointer(v)),    // this
 		uintptr(index),                // in uint32
 		uintptr(unsafe.Pointer(&out)), // out unsafe.Pointer
@@ -56,12 +62,15 @@ func (v *IVector) GetAt(index uint32) (unsafe.Pointer, error) {
 	return out, nil
 }
 
+// GetSize was introduced in Windows.Foundation.FoundationContract v1.0.
 func (v *IVector) GetSize() (uint32, error) {
 	var out uint32
-	hr, _, _ := syscall.SyscallN(
+	hr, _, _ := syscall.Syscall(
 		v.VTable().GetSize,
+		2,                             // nargs
 		uintptr(unsafe.Pointer(v)),    // this
 		uintptr(unsafe.Pointer(&out)), // out uint32
+		0,
 	)
 
 	if hr != 0 {
@@ -71,12 +80,15 @@ func (v *IVector) GetSize() (uint32, error) {
 	return out, nil
 }
 
+// GetView was introduced in Windows.Foundation.FoundationContract v1.0.
 func (v *IVector) GetView() (*IVectorView, error) {
 	var out *IVectorView
-	hr, _, _ := syscall.SyscallN(
+	hr, _, _ := syscall.Syscall(
 		v.VTable().GetView,
+		2,                             // nargs
 		uintptr(unsafe.Pointer(v)),    // this
 		uintptr(unsafe.Pointer(&out)), // out IVectorView
+		0,
 	)
 
 	if hr != 0 {
@@ -86,15 +98,19 @@ func (v *IVector) GetView() (*IVectorView, error) {
 	return out, nil
 }
 
+// IndexOf was introduced in Windows.Foundation.FoundationContract v1.0.
 func (v *IVector) IndexOf(value unsafe.Pointer) (uint32, bool, error) {
 	var index uint32
 	var out bool
-	hr, _, _ := syscall.SyscallN(
+	hr, _, _ := syscall.Syscall6(
 		v.VTable().IndexOf,
+		4,                               // nargs
 		uintptr(unsafe.Pointer(v)),      // this
-		uintptr(unsafe.Pointer(&value)), // in unsafe.Pointer
+		winrt.ABIValue(&value),          // in unsafe.Pointer
 		uintptr(unsafe.Pointer(&index)), // out uint32
 		uintptr(unsafe.Pointer(&out)),   // out bool
+		0,
+		0,
 	)
 
 	if hr != 0 {
@@ -104,12 +120,14 @@ func (v *IVector) IndexOf(value unsafe.Pointer) (uint32, bool, error) {
 	return index, out, nil
 }
 
+// SetAt was introduced in Windows.Foundation.FoundationContract v1.0.
 func (v *IVector) SetAt(index uint32, value unsafe.Pointer) error {
-	hr, _, _ := syscall.SyscallN(
+	hr, _, _ := syscall.Syscall(
 		v.VTable().SetAt,
-		uintptr(unsafe.Pointer(v)),      // this
-		uintptr(index),                  // in uint32
-		uintptr(unsafe.Pointer(&value)), // in unsafe.Pointer
+		3,                          // nargs
+		uintptr(unsafe.Pointer(v)), // this
+		uintptr(index),             // in uint32
+		winrt.ABIValue(&value),     // in unsafe.Pointer
 	)
 
 	if hr != 0 {
@@ -119,12 +137,14 @@ func (v *IVector) SetAt(index uint32, value unsafe.Pointer) error {
 	return nil
 }
 
+// InsertAt was introduced in Windows.Foundation.FoundationContract v1.0.
 func (v *IVector) InsertAt(index uint32, value unsafe.Pointer) error {
-	hr, _, _ := syscall.SyscallN(
+	hr, _, _ := syscall.Syscall(
 		v.VTable().InsertAt,
-		uintptr(unsafe.Pointer(v)),      // this
-		uintptr(index),                  // in uint32
-		uintptr(unsafe.Pointer(&value)), // in unsafe.Pointer
+		3,                          // nargs
+		uintptr(unsafe.Pointer(v)), // this
+		uintptr(index),             // in uint32
+		winrt.ABIValue(&value),     // in unsafe.Pointer
 	)
 
 	if hr != 0 {
@@ -134,11 +154,14 @@ func (v *IVector) InsertAt(index uint32, value unsafe.Pointer) error {
 	return nil
 }
 
+// RemoveAt was introduced in Windows.Foundation.FoundationContract v1.0.
 func (v *IVector) RemoveAt(index uint32) error {
-	hr, _, _ := syscall.SyscallN(
+	hr, _, _ := syscall.Syscall(
 		v.VTable().RemoveAt,
+		2,                          // nargs
 		uintptr(unsafe.Pointer(v)), // this
 		uintptr(index),             // in uint32
+		0,
 	)
 
 	if hr != 0 {
@@ -148,11 +171,14 @@ func (v *IVector) RemoveAt(index uint32) error {
 	return nil
 }
 
+// Append was introduced in Windows.Foundation.FoundationContract v1.0.
 func (v *IVector) Append(value unsafe.Pointer) error {
-	hr, _, _ := syscall.SyscallN(
+	hr, _, _ := syscall.Syscall(
 		v.VTable().Append,
-		uintptr(unsafe.Pointer(v)),      // this
-		uintptr(unsafe.Pointer(&value)), // in unsafe.Pointer
+		2,                          // nargs
+		uintptr(unsafe.Pointer(v)), // this
+		winrt.ABIValue(&value),     // in unsafe.Pointer
+		0,
 	)
 
 	if hr != 0 {
@@ -162,10 +188,14 @@ func (v *IVector) Append(value unsafe.Pointer) error {
 	return nil
 }
 
+// RemoveAtEnd was introduced in Windows.Foundation.FoundationContract v1.0.
 func (v *IVector) RemoveAtEnd() error {
-	hr, _, _ := syscall.SyscallN(
+	hr, _, _ := syscall.Syscall(
 		v.VTable().RemoveAtEnd,
+		1,                          // nargs
 		uintptr(unsafe.Pointer(v)), // this
+		0,
+		0,
 	)
 
 	if hr != 0 {
@@ -175,10 +205,14 @@ func (v *IVector) RemoveAtEnd() error {
 	return nil
 }
 
+// Clear was introduced in Windows.Foundation.FoundationContract v1.0.
 func (v *IVector) Clear() error {
-	hr, _, _ := syscall.SyscallN(
+	hr, _, _ := syscall.Syscall(
 		v.VTable().Clear,
+		1,                          // nargs
 		uintptr(unsafe.Pointer(v)), // this
+		0,
+		0,
 	)
 
 	if hr != 0 {
@@ -188,31 +222,35 @@ func (v *IVector) Clear() error {
 	return nil
 }
 
-func (v *IVector) GetMany(startIndex uint32, itemsSize uint32) ([]unsafe.Pointer, uint32, error) {
-	var items []unsafe.Pointer = make([]unsafe.Pointer, itemsSize)
+// GetMany was introduced in Windows.Foundation.FoundationContract v1.0.
+func (v *IVector) GetMany(startIndex uint32, items []unsafe.Pointer) (uint32, error) {
 	var out uint32
-	hr, _, _ := syscall.SyscallN(
+	hr, _, _ := syscall.Syscall6(
 		v.VTable().GetMany,
-		uintptr(unsafe.Pointer(v)),         // this
-		uintptr(startIndex),                // in uint32
-		uintptr(itemsSize),                 // in uint32
-		uintptr(unsafe.Pointer(&items[0])), // out unsafe.Pointer
-		uintptr(unsafe.Pointer(&out)),      // out uint32
+		5,                             // nargs
+		uintptr(unsafe.Pointer(v)),    // this
+		uintptr(startIndex),           // in uint32
+		uintptr(len(items)),           // in uint32
+		winrt.ArrayABI(items),         // out []unsafe.Pointer
+		uintptr(unsafe.Pointer(&out)), // out uint32
+		0,
 	)
 
 	if hr != 0 {
-		return nil, 0, ole.NewError(hr)
+		return 0, ole.NewError(hr)
 	}
 
-	return items, out, nil
+	return out, nil
 }
 
-func (v *IVector) ReplaceAll(itemsSize uint32, items []unsafe.Pointer) error {
-	hr, _, _ := syscall.SyscallN(
+// ReplaceAll was introduced in Windows.Foundation.FoundationContract v1.0.
+func (v *IVector) ReplaceAll(items []unsafe.Pointer) error {
+	hr, _, _ := syscall.Syscall(
 		v.VTable().ReplaceAll,
-		uintptr(unsafe.Pointer(v)),         // this
-		uintptr(itemsSize),                 // in uint32
-		uintptr(unsafe.Pointer(&items[0])), // in unsafe.Pointer
+		3,                          // nargs
+		uintptr(unsafe.Pointer(v)), // this
+		uintptr(len(items)),        // in uint32
+		winrt.ArrayABI(items),      // in []unsafe.Pointer
 	)
 
 	if hr != 0 {
@@ -221,3 +259,195 @@ func (v *IVector) ReplaceAll(itemsSize uint32, items []unsafe.Pointer) error {
 
 	return nil
 }
+
+// First was introduced in Windows.Foundation.FoundationContract v1.0.
+func (v *IVector) First(iid *ole.GUID) (*IIterator, error) {
+	itf, err := winrt.QueryInterface(&v.IUnknown, iid)
+	if err != nil {
+		return nil, err
+	}
+	defer itf.Release()
+	parent := (*IIterable)(unsafe.Pointer(itf))
+	return parent.First()
+}
+
+// IVectorOf is a typed wrapper of IVector, instantiated with the type arguments T.
+type IVectorOf[T any] struct {
+	*IVector
+	signatures []string
+}
+
+// NewIVectorOf wraps the given IVector. The signatures of the type arguments are
+// required to compute the IID of the instantiated interface. It panics if a type argument
+// cannot be passed to the vtable calls, see winrt.CheckTypeArg.
+func NewIVectorOf[T any](v *IVector, signatureT string) *IVectorOf[T] {
+	winrt.CheckTypeArg[T]()
+	return &IVectorOf[T]{
+		IVector:    v,
+		signatures: []string{signatureT},
+	}
+}
+
+// Signature returns the signature of the instantiated interface.
+func (w *IVectorOf[T]) Signature() string {
+	return winrt.ParameterizedInstanceSignature(GUIDIVector, w.signatures...)
+}
+
+// Signatures returns the signatures of the type arguments.
+func (w *IVectorOf[T]) Signatures() []string {
+	return w.signatures
+}
+
+// IID returns the IID of the instantiated interface.
+func (w *IVectorOf[T]) IID() *ole.GUID {
+	return ole.NewGUID(winrt.ParameterizedInstanceGUID(GUIDIVector, w.signatures...))
+}
+
+func (w *IVectorOf[T]) GetAt(index uint32) (T, error) {
+	v := w.IVector
+	var out T
+	hr, _, _ := syscall.Syscall(
+		v.VTable().GetAt,
+		3,                             // nargs
+		uintptr(unsafe.Pointer(v)),    // this
+		uintptr(index),                // in uint32
+		uintptr(unsafe.Pointer(&out)), // out T
+	)
+
+	if hr != 0 {
+		return *new(T), ole.NewError(hr)
+	}
+
+	winrt.TypeArgResult(&out)
+	return out, nil
+}
+
+func (w *IVectorOf[T]) GetView() (*IVectorViewOf[T], error) {
+	out, err := w.IVector.GetView()
+	if err != nil {
+		return nil, err
+	}
+	return NewIVectorViewOf[T](out, w.signatures[0]), nil
+}
+
+func (w *IVectorOf[T]) IndexOf(value T) (uint32, bool, error) {
+	v := w.IVector
+	var index uint32
+	var out bool
+	hr, _, _ := syscall.Syscall6(
+		v.VTable().IndexOf,
+		4,                               // nargs
+		uintptr(unsafe.Pointer(v)),      // this
+		winrt.TypeArgABI(&value),        // in T
+		uintptr(unsafe.Pointer(&index)), // out uint32
+		uintptr(unsafe.Pointer(&out)),   // out bool
+		0,
+		0,
+	)
+
+	if hr != 0 {
+		return 0, false, ole.NewError(hr)
+	}
+
+	return index, out, nil
+}
+
+func (w *IVectorOf[T]) SetAt(index uint32, value T) error {
+	v := w.IVector
+	hr, _, _ := syscall.Syscall(
+		v.VTable().SetAt,
+		3,                          // nargs
+		uintptr(unsafe.Pointer(v)), // this
+		uintptr(index),             // in uint32
+		winrt.TypeArgABI(&value),   // in T
+	)
+
+	if hr != 0 {
+		return ole.NewError(hr)
+	}
+
+	return nil
+}
+
+func (w *IVectorOf[T]) InsertAt(index uint32, value T) error {
+	v := w.IVector
+	hr, _, _ := syscall.Syscall(
+		v.VTable().InsertAt,
+		3,                          // nargs
+		uintptr(unsafe.Pointer(v)), // this
+		uintptr(index),             // in uint32
+		winrt.TypeArgABI(&value),   // in T
+	)
+
+	if hr != 0 {
+		return ole.NewError(hr)
+	}
+
+	return nil
+}
+
+func (w *IVectorOf[T]) Append(value T) error {
+	v := w.IVector
+	hr, _, _ := syscall.Syscall(
+		v.VTable().Append,
+		2,                          // nargs
+		uintptr(unsafe.Pointer(v)), // this
+		winrt.TypeArgABI(&value),   // in T
+		0,
+	)
+
+	if hr != 0 {
+		return ole.NewError(hr)
+	}
+
+	return nil
+}
+
+func (w *IVectorOf[T]) GetMany(startIndex uint32, items []T) (uint32, error) {
+	v := w.IVector
+	winrt.ClearTypeArgArray(items)
+	var out uint32
+	hr, _, _ := syscall.Syscall6(
+		v.VTable().GetMany,
+		5,                             // nargs
+		uintptr(unsafe.Pointer(v)),    // this
+		uintptr(startIndex),           // in uint32
+		uintptr(len(items)),           // in uint32
+		winrt.ArrayABI(items),         // out []T
+		uintptr(unsafe.Pointer(&out)), // out uint32
+		0,
+	)
+
+	if hr != 0 {
+		return 0, ole.NewError(hr)
+	}
+
+	winrt.TypeArgResults(items)
+	return out, nil
+}
+
+func (w *IVectorOf[T]) ReplaceAll(items []T) error {
+	v := w.IVector
+	itemsABI := winrt.TypeArgArray(items)
+	hr, _, _ := syscall.Syscall(
+		v.VTable().ReplaceAll,
+		3,                          // nargs
+		uintptr(unsafe.Pointer(v)), // this
+		uintptr(len(items)),        // in uint32
+		winrt.ArrayABI(itemsABI),   // in []T
+	)
+
+	if hr != 0 {
+		return ole.NewError(hr)
+	}
+
+	return nil
+}
+
+func (w *IVectorOf[T]) First() (*IIteratorOf[T], error) {
+	out, err := w.IVector.First(ole.NewGUID(winrt.ParameterizedInstanceGUID(GUIDIIterable, w.signatures[0])))
+	if err != nil {
+		return nil, err
+	}
+	return NewIIteratorOf[T](out, w.signatures[0]), nil
+}
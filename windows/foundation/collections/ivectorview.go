@@ -10,11 +10,15 @@ import (
 	"unsafe"
 
 	"github.com/go-ole/go-ole"
+	"github.com/waylyrics/winrt-go"
 )
 
 const GUIDIVectorView string = "bbe1fa4c-b0e3-4583-baef-1f1b2e483e56"
 const SignatureIVectorView string = "{bbe1fa4c-b0e3-4583-baef-1f1b2e483e56}"
 
+var IIDIVectorView = ole.GUID{Data1: 0xbbe1fa4c, Data2: 0xb0e3, Data3: 0x4583, Data4: [8]byte{0xba, 0xef, 0x1f, 0x1b, 0x2e, 0x48, 0x3e, 0x56}}
+
+// IVectorView was introduced in Windows.Foundation.FoundationContract v1.0.
 type IVectorView struct {
 	ole.IInspectable
 }
@@ -32,10 +36,12 @@ func (v *IVectorView) VTable() *IVectorViewVtbl {
 	return (*IVectorViewVtbl)(unsafe.Pointer(v.RawVTable))
 }
 
+// GetAt was introduced in Windows.Foundation.FoundationContract v1.0.
 func (v *IVectorView) GetAt(index uint32) (unsafe.Pointer, error) {
 	var out unsafe.Pointer
-	hr, _, _ := syscall.SyscallN(
+	hr, _, _ := syscall.Syscall(
 		v.VTable().GetAt,
+		3,                             // nargs
 		uintptr(unsafe.Pointer(v)),    // this
 		uintptr(index),                // in uint32
 		uintptr(unsafe.Pointer(&out)), // out unsafe.Pointer
@@ -48,12 +54,15 @@ func (v *IVectorView) GetAt(index uint32) (unsafe.Pointer, error) {
 	return out, nil
 }
 
+// GetSize was introduced in Windows.Foundation.FoundationContract v1.0.
 func (v *IVectorView) GetSize() (uint32, error) {
 	var out uint32
-	hr, _, _ := syscall.SyscallN(
+	hr, _, _ := syscall.Syscall(
 		v.VTable().GetSize,
+		2,                             // nargs
 		uintptr(unsafe.Pointer(v)),    // this
 		uintptr(unsafe.Pointer(&out)), // out uint32
+		0,
 	)
 
 	if hr != 0 {
@@ -63,15 +72,19 @@ func (v *IVectorView) GetSize() (uint32, error) {
 	return out, nil
 }
 
+// IndexOf was introduced in Windows.Foundation.FoundationContract v1.0.
 func (v *IVectorView) IndexOf(value unsafe.Pointer) (uint32, bool, error) {
 	var index uint32
 	var out bool
-	hr, _, _ := syscall.SyscallN(
+	hr, _, _ := syscall.Syscall6(
 		v.VTable().IndexOf,
+		4,                               // nargs
 		uintptr(unsafe.Pointer(v)),      // this
-		uintptr(unsafe.Pointer(&value)), // in unsafe.Pointer
+		winrt.ABIValue(&value),          // in unsafe.Pointer
 		uintptr(unsafe.Pointer(&index)), // out uint32
 		uintptr(unsafe.Pointer(&out)),   // out bool
+		0,
+		0,
 	)
 
 	if hr != 0 {
@@ -81,21 +94,138 @@ func (v *IVectorView) IndexOf(value unsafe.Pointer) (uint32, bool, error) {
 	return index, out, nil
 }
 
-func (v *IVectorView) GetMany(startIndex uint32, itemsSize uint32) ([]unsafe.Pointer, uint32, error) {
-	var items []unsafe.Pointer = make([]unsafe.Pointer, itemsSize)
+// GetMany was introduced in Windows.Foundation.FoundationContract v1.0.
+func (v *IVectorView) GetMany(startIndex uint32, items []unsafe.Pointer) (uint32, error) {
 	var out uint32
-	hr, _, _ := syscall.SyscallN(
+	hr, _, _ := syscall.Syscall6(
 		v.VTable().GetMany,
-		uintptr(unsafe.Pointer(v)),         // this
-		uintptr(startIndex),                // in uint32
-		uintptr(itemsSize),                 // in uint32
-		uintptr(unsafe.Pointer(&items[0])), // out unsafe.Pointer
-		uintptr(unsafe.Pointer(&out)),      // out uint32
+		5,                             // nargs
+		uintptr(unsafe.Pointer(v)),    // this
+		uintptr(startIndex),           // in uint32
+		uintptr(len(items)),           // in uint32
+		winrt.ArrayABI(items),         // out []unsafe.Pointer
+		uintptr(unsafe.Pointer(&out)), // out uint32
+		0,
 	)
 
 	if hr != 0 {
-		return nil, 0, ole.NewError(hr)
+		return 0, ole.NewError(hr)
+	}
+
+	return out, nil
+}
+
+// First was introduced in Windows.Foundation.FoundationContract v1.0.
+func (v *IVectorView) First(iid *ole.GUID) (*IIterator, error) {
+	itf, err := winrt.QueryInterface(&v.IUnknown, iid)
+	if err != nil {
+		return nil, err
+	}
+	defer itf.Release()
+	parent := (*IIterable)(unsafe.Pointer(itf))
+	return parent.First()
+}
+
+// IVectorViewOf is a typed wrapper of IVectorView, instantiated with the type arguments T.
+type IVectorViewOf[T any] struct {
+	*IVectorView
+	signatures []string
+}
+
+// NewIVectorViewOf wraps the given IVectorView. The signatures of the type arguments are
+// required to compute the IID of the instantiated interface. It panics if a type argument
+// cannot be passed to the vtable calls, see winrt.CheckTypeArg.
+func NewIVectorViewOf[T any](v *IVectorView, signatureT string) *IVectorViewOf[T] {
+	winrt.CheckTypeArg[T]()
+	return &IVectorViewOf[T]{
+		IVectorView: v,
+		signatures:  []string{signatureT},
 	}
+}
 
-	return items, out, nil
+// Signature returns the signature of the instantiated interface.
+func (w *IVectorViewOf[T]) Signature() string {
+	return winrt.ParameterizedInstanceSignature(GUIDIVectorView, w.signatures...)
+}
+
+// Signatures returns the signatures of the type arguments.
+func (w *IVectorViewOf[T]) Signatures() []string {
+	return w.signatures
+}
+
+// IID returns the IID of the instantiated interface.
+func (w *IVectorViewOf[T]) IID() *ole.GUID {
+	return ole.NewGUID(winrt.ParameterizedInstanceGUID(GUIDIVectorView, w.signatures...))
+}
+
+func (w *IVectorViewOf[T]) GetAt(index uint32) (T, error) {
+	v := w.IVectorView
+	var out T
+	hr, _, _ := syscall.Syscall(
+		v.VTable().GetAt,
+		3,                             // nargs
+		uintptr(unsafe.Pointer(v)),    // this
+		uintptr(index),                // in uint32
+		uintptr(unsafe.Pointer(&out)), // out T
+	)
+
+	if hr != 0 {
+		return *new(T), ole.NewError(hr)
+	}
+
+	winrt.TypeArgResult(&out)
+	return out, nil
+}
+
+func (w *IVectorViewOf[T]) IndexOf(value T) (uint32, bool, error) {
+	v := w.IVectorView
+	var index uint32
+	var out bool
+	hr, _, _ := syscall.Syscall6(
+		v.VTable().IndexOf,
+		4,                               // nargs
+		uintptr(unsafe.Pointer(v)),      // this
+		winrt.TypeArgABI(&value),        // in T
+		uintptr(unsafe.Pointer(&index)), // out uint32
+		uintptr(unsafe.Pointer(&out)),   // out bool
+		0,
+		0,
+	)
+
+	if hr != 0 {
+		return 0, false, ole.NewError(hr)
+	}
+
+	return index, out, nil
+}
+
+func (w *IVectorViewOf[T]) GetMany(startIndex uint32, items []T) (uint32, error) {
+	v := w.IVectorView
+	winrt.ClearTypeArgArray(items)
+	var out uint32
+	hr, _, _ := syscall.Syscall6(
+		v.VTable().GetMany,
+		5,                             // nargs
+		uintptr(unsafe.Pointer(v)),    // this
+		uintptr(startIndex),           // in uint32
+		uintptr(len(items)),           // in uint32
+		winrt.ArrayABI(items),         // out []T
+		uintptr(unsafe.Pointer(&out)), // out uint32
+		0,
+	)
+
+	if hr != 0 {
+		return 0, ole.NewError(hr)
+	}
+
+	winrt.TypeArgResults(items)
+	return out, nil
+}
+
+func (w *IVectorViewOf[T]) First() (*IIteratorOf[T], error) {
+	out, err := w.IVectorView.First(ole.NewGUID(winrt.ParameterizedInstanceGUID(GUIDIIterable, w.signatures[0])))
+	if err != nil {
+		return nil, err
+	}
+	return NewIIteratorOf[T](out, w.signatures[0]), nil
 }
@@ -5,8 +5,24 @@
 //nolint:all
 package foundation
 
+import (
+	"time"
+
+	"github.com/waylyrics/winrt-go"
+)
+
 const SignatureDateTime string = "struct(Windows.Foundation.DateTime;i8)"
 
 type DateTime struct {
 	UniversalTime int64
 }
+
+// ToTime returns the DateTime as a time.Time in UTC.
+func (v DateTime) ToTime() time.Time {
+	return winrt.TimeFromTicks(v.UniversalTime)
+}
+
+// DateTimeFromTime returns the DateTime of the given time.
+func DateTimeFromTime(t time.Time) DateTime {
+	return DateTime{UniversalTime: winrt.TicksFromTime(t)}
+}
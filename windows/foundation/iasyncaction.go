@@ -0,0 +1,148 @@
+// Code generated by winrt-go-gen. DO NOT EDIT.
+
+//go:build windows
+
+//nolint:all
+package foundation
+
+import (
+	"syscall"
+	"unsafe"
+
+	"github.com/go-ole/go-ole"
+	"github.com/waylyrics/winrt-go"
+)
+
+const GUIDIAsyncAction string = "5a648006-843a-4da9-865b-9d26e5dfad7b"
+const SignatureIAsyncAction string = "{5a648006-843a-4da9-865b-9d26e5dfad7b}"
+
+var IIDIAsyncAction = ole.GUID{Data1: 0x5a648006, Data2: 0x843a, Data3: 0x4da9, Data4: [8]byte{0x86, 0x5b, 0x9d, 0x26, 0xe5, 0xdf, 0xad, 0x7b}}
+
+// IAsyncAction was introduced in Windows.Foundation.FoundationContract v1.0.
+type IAsyncAction struct {
+	ole.IInspectable
+}
+
+type IAsyncActionVtbl struct {
+	ole.IInspectableVtbl
+
+	SetCompleted uintptr
+	GetCompleted uintptr
+	GetResults   uintptr
+}
+
+func (v *IAsyncAction) VTable() *IAsyncActionVtbl {
+	return (*IAsyncActionVtbl)(unsafe.Pointer(v.RawVTable))
+}
+
+// IsSupportedIAsyncAction returns true if the given object implements IAsyncAction.
+func IsSupportedIAsyncAction(obj *ole.IUnknown) bool {
+	return winrt.IsSupported(obj, &IIDIAsyncAction)
+}
+
+// SetCompleted was introduced in Windows.Foundation.FoundationContract v1.0.
+func (v *IAsyncAction) SetCompleted(handler *AsyncActionCompletedHandler) error {
+	hr, _, _ := syscall.Syscall(
+		v.VTable().SetCompleted,
+		2,                                // nargs
+		uintptr(unsafe.Pointer(v)),       // this
+		uintptr(unsafe.Pointer(handler)), // in AsyncActionCompletedHandler
+		0,
+	)
+
+	if hr != 0 {
+		return ole.NewError(hr)
+	}
+
+	return nil
+}
+
+// GetCompleted was introduced in Windows.Foundation.FoundationContract v1.0.
+func (v *IAsyncAction) GetCompleted() (*AsyncActionCompletedHandler, error) {
+	var out *AsyncActionCompletedHandler
+	hr, _, _ := syscall.Syscall(
+		v.VTable().GetCompleted,
+		2,                             // nargs
+		uintptr(unsafe.Pointer(v)),    // this
+		uintptr(unsafe.Pointer(&out)), // out AsyncActionCompletedHandler
+		0,
+	)
+
+	if hr != 0 {
+		return nil, ole.NewError(hr)
+	}
+
+	return out, nil
+}
+
+// GetResults was introduced in Windows.Foundation.FoundationContract v1.0.
+func (v *IAsyncAction) GetResults() error {
+	hr, _, _ := syscall.Syscall(
+		v.VTable().GetResults,
+		1,                          // nargs
+		uintptr(unsafe.Pointer(v)), // this
+		0,
+		0,
+	)
+
+	if hr != 0 {
+		return ole.NewError(hr)
+	}
+
+	return nil
+}
+
+// GetId was introduced in Windows.Foundation.FoundationContract v1.0.
+func (v *IAsyncAction) GetId() (uint32, error) {
+	itf, err := winrt.QueryInterface(&v.IUnknown, &IIDIAsyncInfo)
+	if err != nil {
+		return 0, err
+	}
+	defer itf.Release()
+	parent := (*IAsyncInfo)(unsafe.Pointer(itf))
+	return parent.GetId()
+}
+
+// GetStatus was introduced in Windows.Foundation.FoundationContract v1.0.
+func (v *IAsyncAction) GetStatus() (AsyncStatus, error) {
+	itf, err := winrt.QueryInterface(&v.IUnknown, &IIDIAsyncInfo)
+	if err != nil {
+		return AsyncStatusCanceled, err
+	}
+	defer itf.Release()
+	parent := (*IAsyncInfo)(unsafe.Pointer(itf))
+	return parent.GetStatus()
+}
+
+// GetErrorCode was introduced in Windows.Foundation.FoundationContract v1.0.
+func (v *IAsyncAction) GetErrorCode() (HResult, error) {
+	itf, err := winrt.QueryInterface(&v.IUnknown, &IIDIAsyncInfo)
+	if err != nil {
+		return HResult{}, err
+	}
+	defer itf.Release()
+	parent := (*IAsyncInfo)(unsafe.Pointer(itf))
+	return parent.GetErrorCode()
+}
+
+// Cancel was introduced in Windows.Foundation.FoundationContract v1.0.
+func (v *IAsyncAction) Cancel() error {
+	itf, err := winrt.QueryInterface(&v.IUnknown, &IIDIAsyncInfo)
+	if err != nil {
+		return err
+	}
+	defer itf.Release()
+	parent := (*IAsyncInfo)(unsafe.Pointer(itf))
+	return parent.Cancel()
+}
+
+// Close was introduced in Windows.Foundation.FoundationContract v1.0.
+func (v *IAsyncAction) Close() error {
+	itf, err := winrt.QueryInterface(&v.IUnknown, &IIDIAsyncInfo)
+	if err != nil {
+		return err
+	}
+	defer itf.Release()
+	parent := (*IAsyncInfo)(unsafe.Pointer(itf))
+	return parent.Close()
+}
@@ -0,0 +1,212 @@
+// Code generated by winrt-go-gen. DO NOT EDIT.
+
+//go:build windows
+
+//nolint:all
+package foundation
+
+import (
+	"syscall"
+	"unsafe"
+
+	"github.com/go-ole/go-ole"
+	"github.com/waylyrics/winrt-go"
+)
+
+const GUIDIAsyncActionWithProgress string = "1f6db258-e803-48a1-9546-eb7353398884"
+const SignatureIAsyncActionWithProgress string = "{1f6db258-e803-48a1-9546-eb7353398884}"
+
+var IIDIAsyncActionWithProgress = ole.GUID{Data1: 0x1f6db258, Data2: 0xe803, Data3: 0x48a1, Data4: [8]byte{0x95, 0x46, 0xeb, 0x73, 0x53, 0x39, 0x88, 0x84}}
+
+// IAsyncActionWithProgress was introduced in Windows.Foundation.FoundationContract v1.0.
+type IAsyncActionWithProgress struct {
+	ole.IInspectable
+}
+
+type IAsyncActionWithProgressVtbl struct {
+	ole.IInspectableVtbl
+
+	SetProgress  uintptr
+	GetProgress  uintptr
+	SetCompleted uintptr
+	GetCompleted uintptr
+	GetResults   uintptr
+}
+
+func (v *IAsyncActionWithProgress) VTable() *IAsyncActionWithProgressVtbl {
+	return (*IAsyncActionWithProgressVtbl)(unsafe.Pointer(v.RawVTable))
+}
+
+// SetProgress was introduced in Windows.Foundation.FoundationContract v1.0.
+func (v *IAsyncActionWithProgress) SetProgress(handler *AsyncActionProgressHandler) error {
+	hr, _, _ := syscall.Syscall(
+		v.VTable().SetProgress,
+		2,                                // nargs
+		uintptr(unsafe.Pointer(v)),       // this
+		uintptr(unsafe.Pointer(handler)), // in AsyncActionProgressHandler
+		0,
+	)
+
+	if hr != 0 {
+		return ole.NewError(hr)
+	}
+
+	return nil
+}
+
+// GetProgress was introduced in Windows.Foundation.FoundationContract v1.0.
+func (v *IAsyncActionWithProgress) GetProgress() (*AsyncActionProgressHandler, error) {
+	var out *AsyncActionProgressHandler
+	hr, _, _ := syscall.Syscall(
+		v.VTable().GetProgress,
+		2,                             // nargs
+		uintptr(unsafe.Pointer(v)),    // this
+		uintptr(unsafe.Pointer(&out)), // out AsyncActionProgressHandler
+		0,
+	)
+
+	if hr != 0 {
+		return nil, ole.NewError(hr)
+	}
+
+	return out, nil
+}
+
+// SetCompleted was introduced in Windows.Foundation.FoundationContract v1.0.
+func (v *IAsyncActionWithProgress) SetCompleted(handler *AsyncActionWithProgressCompletedHandler) error {
+	hr, _, _ := syscall.Syscall(
+		v.VTable().SetCompleted,
+		2,                                // nargs
+		uintptr(unsafe.Pointer(v)),       // this
+		uintptr(unsafe.Pointer(handler)), // in AsyncActionWithProgressCompletedHandler
+		0,
+	)
+
+	if hr != 0 {
+		return ole.NewError(hr)
+	}
+
+	return nil
+}
+
+// GetCompleted was introduced in Windows.Foundation.FoundationContract v1.0.
+func (v *IAsyncActionWithProgress) GetCompleted() (*AsyncActionWithProgressCompletedHandler, error) {
+	var out *AsyncActionWithProgressCompletedHandler
+	hr, _, _ := syscall.Syscall(
+		v.VTable().GetCompleted,
+		2,                             // nargs
+		uintptr(unsafe.Pointer(v)),    // this
+		uintptr(unsafe.Pointer(&out)), // out AsyncActionWithProgressCompletedHandler
+		0,
+	)
+
+	if hr != 0 {
+		return nil, ole.NewError(hr)
+	}
+
+	return out, nil
+}
+
+// GetResults was introduced in Windows.Foundation.FoundationContract v1.0.
+func (v *IAsyncActionWithProgress) GetResults() error {
+	hr, _, _ := syscall.Syscall(
+		v.VTable().GetResults,
+		1,                          // nargs
+		uintptr(unsafe.Pointer(v)), // this
+		0,
+		0,
+	)
+
+	if hr != 0 {
+		return ole.NewError(hr)
+	}
+
+	return nil
+}
+
+// GetId was introduced in Windows.Foundation.FoundationContract v1.0.
+func (v *IAsyncActionWithProgress) GetId() (uint32, error) {
+	itf, err := winrt.QueryInterface(&v.IUnknown, &IIDIAsyncInfo)
+	if err != nil {
+		return 0, err
+	}
+	defer itf.Release()
+	parent := (*IAsyncInfo)(unsafe.Pointer(itf))
+	return parent.GetId()
+}
+
+// GetStatus was introduced in Windows.Foundation.FoundationContract v1.0.
+func (v *IAsyncActionWithProgress) GetStatus() (AsyncStatus, error) {
+	itf, err := winrt.QueryInterface(&v.IUnknown, &IIDIAsyncInfo)
+	if err != nil {
+		return AsyncStatusCanceled, err
+	}
+	defer itf.Release()
+	parent := (*IAsyncInfo)(unsafe.Pointer(itf))
+	return parent.GetStatus()
+}
+
+// GetErrorCode was introduced in Windows.Foundation.FoundationContract v1.0.
+func (v *IAsyncActionWithProgress) GetErrorCode() (HResult, error) {
+	itf, err := winrt.QueryInterface(&v.IUnknown, &IIDIAsyncInfo)
+	if err != nil {
+		return HResult{}, err
+	}
+	defer itf.Release()
+	parent := (*IAsyncInfo)(unsafe.Pointer(itf))
+	return parent.GetErrorCode()
+}
+
+// Cancel was introduced in Windows.Foundation.FoundationContract v1.0.
+func (v *IAsyncActionWithProgress) Cancel() error {
+	itf, err := winrt.QueryInterface(&v.IUnknown, &IIDIAsyncInfo)
+	if err != nil {
+		return err
+	}
+	defer itf.Release()
+	parent := (*IAsyncInfo)(unsafe.Pointer(itf))
+	return parent.Cancel()
+}
+
+// Close was introduced in Windows.Foundation.FoundationContract v1.0.
+func (v *IAsyncActionWithProgress) Close() error {
+	itf, err := winrt.QueryInterface(&v.IUnknown, &IIDIAsyncInfo)
+	if err != nil {
+		return err
+	}
+	defer itf.Release()
+	parent := (*IAsyncInfo)(unsafe.Pointer(itf))
+	return parent.Close()
+}
+
+// IAsyncActionWithProgressOf is a typed wrapper of IAsyncActionWithProgress, instantiated with the type arguments TProgress.
+type IAsyncActionWithProgressOf[TProgress any] struct {
+	*IAsyncActionWithProgress
+	signatures []string
+}
+
+// NewIAsyncActionWithProgressOf wraps the given IAsyncActionWithProgress. The signatures of the type arguments are
+// required to compute the IID of the instantiated interface. It panics if a type argument
+// cannot be passed to the vtable calls, see winrt.CheckTypeArg.
+func NewIAsyncActionWithProgressOf[TProgress any](v *IAsyncActionWithProgress, signatureTProgress string) *IAsyncActionWithProgressOf[TProgress] {
+	winrt.CheckTypeArg[TProgress]()
+	return &IAsyncActionWithProgressOf[TProgress]{
+		IAsyncActionWithProgress: v,
+		signatures:               []string{signatureTProgress},
+	}
+}
+
+// Signature returns the signature of the instantiated interface.
+func (w *IAsyncActionWithProgressOf[TProgress]) Signature() string {
+	return winrt.ParameterizedInstanceSignature(GUIDIAsyncActionWithProgress, w.signatures...)
+}
+
+// Signatures returns the signatures of the type arguments.
+func (w *IAsyncActionWithProgressOf[TProgress]) Signatures() []string {
+	return w.signatures
+}
+
+// IID returns the IID of the instantiated interface.
+func (w *IAsyncActionWithProgressOf[TProgress]) IID() *ole.GUID {
+	return ole.NewGUID(winrt.ParameterizedInstanceGUID(GUIDIAsyncActionWithProgress, w.signatures...))
+}
@@ -0,0 +1,131 @@
+// Code generated by winrt-go-gen. DO NOT EDIT.
+
+//go:build windows
+
+//nolint:all
+package foundation
+
+import (
+	"syscall"
+	"unsafe"
+
+	"github.com/go-ole/go-ole"
+	"github.com/waylyrics/winrt-go"
+)
+
+const GUIDIAsyncInfo string = "00000036-0000-0000-c000-000000000046"
+const SignatureIAsyncInfo string = "{00000036-0000-0000-c000-000000000046}"
+
+var IIDIAsyncInfo = ole.GUID{Data1: 0x00000036, Data2: 0x0000, Data3: 0x0000, Data4: [8]byte{0xc0, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x46}}
+
+// IAsyncInfo was introduced in Windows.Foundation.FoundationContract v1.0.
+type IAsyncInfo struct {
+	ole.IInspectable
+}
+
+type IAsyncInfoVtbl struct {
+	ole.IInspectableVtbl
+
+	GetId        uintptr
+	GetStatus    uintptr
+	GetErrorCode uintptr
+	Cancel       uintptr
+	Close        uintptr
+}
+
+func (v *IAsyncInfo) VTable() *IAsyncInfoVtbl {
+	return (*IAsyncInfoVtbl)(unsafe.Pointer(v.RawVTable))
+}
+
+// IsSupportedIAsyncInfo returns true if the given object implements IAsyncInfo.
+func IsSupportedIAsyncInfo(obj *ole.IUnknown) bool {
+	return winrt.IsSupported(obj, &IIDIAsyncInfo)
+}
+
+// GetId was introduced in Windows.Foundation.FoundationContract v1.0.
+func (v *IAsyncInfo) GetId() (uint32, error) {
+	var out uint32
+	hr, _, _ := syscall.Syscall(
+		v.VTable().GetId,
+		2,                             // nargs
+		uintptr(unsafe.Pointer(v)),    // this
+		uintptr(unsafe.Pointer(&out)), // out uint32
+		0,
+	)
+
+	if hr != 0 {
+		return 0, ole.NewError(hr)
+	}
+
+	return out, nil
+}
+
+// GetStatus was introduced in Windows.Foundation.FoundationContract v1.0.
+func (v *IAsyncInfo) GetStatus() (AsyncStatus, error) {
+	var out AsyncStatus
+	hr, _, _ := syscall.Syscall(
+		v.VTable().GetStatus,
+		2,                             // nargs
+		uintptr(unsafe.Pointer(v)),    // this
+		uintptr(unsafe.Pointer(&out)), // out AsyncStatus
+		0,
+	)
+
+	if hr != 0 {
+		return AsyncStatusCanceled, ole.NewError(hr)
+	}
+
+	return out, nil
+}
+
+// GetErrorCode was introduced in Windows.Foundation.FoundationContract v1.0.
+func (v *IAsyncInfo) GetErrorCode() (HResult, error) {
+	var out HResult
+	hr, _, _ := syscall.Syscall(
+		v.VTable().GetErrorCode,
+		2,                             // nargs
+		uintptr(unsafe.Pointer(v)),    // this
+		uintptr(unsafe.Pointer(&out)), // out HResult
+		0,
+	)
+
+	if hr != 0 {
+		return HResult{}, ole.NewError(hr)
+	}
+
+	return out, nil
+}
+
+// Cancel was introduced in Windows.Foundation.FoundationContract v1.0.
+func (v *IAsyncInfo) Cancel() error {
+	hr, _, _ := syscall.Syscall(
+		v.VTable().Cancel,
+		1,                          // nargs
+		uintptr(unsafe.Pointer(v)), // this
+		0,
+		0,
+	)
+
+	if hr != 0 {
+		return ole.NewError(hr)
+	}
+
+	return nil
+}
+
+// Close was introduced in Windows.Foundation.FoundationContract v1.0.
+func (v *IAsyncInfo) Close() error {
+	hr, _, _ := syscall.Syscall(
+		v.VTable().Close,
+		1,                          // nargs
+		uintptr(unsafe.Pointer(v)), // this
+		0,
+		0,
+	)
+
+	if hr != 0 {
+		return ole.NewError(hr)
+	}
+
+	return nil
+}
@@ -0,0 +1,195 @@
+// Code generated by winrt-go-gen. DO NOT EDIT.
+
+//go:build windows
+
+//nolint:all
+package foundation
+
+import (
+	"syscall"
+	"unsafe"
+
+	"github.com/go-ole/go-ole"
+	"github.com/waylyrics/winrt-go"
+)
+
+const GUIDIAsyncOperation string = "9fc2b0bb-e446-44e2-aa61-9cab8f636af2"
+const SignatureIAsyncOperation string = "{9fc2b0bb-e446-44e2-aa61-9cab8f636af2}"
+
+var IIDIAsyncOperation = ole.GUID{Data1: 0x9fc2b0bb, Data2: 0xe446, Data3: 0x44e2, Data4: [8]byte{0xaa, 0x61, 0x9c, 0xab, 0x8f, 0x63, 0x6a, 0xf2}}
+
+// IAsyncOperation was introduced in Windows.Foundation.FoundationContract v1.0.
+type IAsyncOperation struct {
+	ole.IInspectable
+}
+
+type IAsyncOperationVtbl struct {
+	ole.IInspectableVtbl
+
+	SetCompleted uintptr
+	GetCompleted uintptr
+	GetResults   uintptr
+}
+
+func (v *IAsyncOperation) VTable() *IAsyncOperationVtbl {
+	return (*IAsyncOperationVtbl)(unsafe.Pointer(v.RawVTable))
+}
+
+// SetCompleted was introduced in Windows.Foundation.FoundationContract v1.0.
+func (v *IAsyncOperation) SetCompleted(handler *AsyncOperationCompletedHandler) error {
+	hr, _, _ := syscall.Syscall(
+		v.VTable().SetCompleted,
+		2,                                // nargs
+		uintptr(unsafe.Pointer(v)),       // this
+		uintptr(unsafe.Pointer(handler)), // in AsyncOperationCompletedHandler
+		0,
+	)
+
+	if hr != 0 {
+		return ole.NewError(hr)
+	}
+
+	return nil
+}
+
+// GetCompleted was introduced in Windows.Foundation.FoundationContract v1.0.
+func (v *IAsyncOperation) GetCompleted() (*AsyncOperationCompletedHandler, error) {
+	var out *AsyncOperationCompletedHandler
+	hr, _, _ := syscall.Syscall(
+		v.VTable().GetCompleted,
+		2,                             // nargs
+		uintptr(unsafe.Pointer(v)),    // this
+		uintptr(unsafe.Pointer(&out)), // out AsyncOperationCompletedHandler
+		0,
+	)
+
+	if hr != 0 {
+		return nil, ole.NewError(hr)
+	}
+
+	return out, nil
+}
+
+// GetResults was introduced in Windows.Foundation.FoundationContract v1.0.
+func (v *IAsyncOperation) GetResults() (unsafe.Pointer, error) {
+	var out unsafe.Pointer
+	hr, _, _ := syscall.Syscall(
+		v.VTable().GetResults,
+		2,                             // nargs
+		uintptr(unsafe.Pointer(v)),    // this
+		uintptr(unsafe.Pointer(&out)), // out unsafe.Pointer
+		0,
+	)
+
+	if hr != 0 {
+		return nil, ole.NewError(hr)
+	}
+
+	return out, nil
+}
+
+// GetId was introduced in Windows.Foundation.FoundationContract v1.0.
+func (v *IAsyncOperation) GetId() (uint32, error) {
+	itf, err := winrt.QueryInterface(&v.IUnknown, &IIDIAsyncInfo)
+	if err != nil {
+		return 0, err
+	}
+	defer itf.Release()
+	parent := (*IAsyncInfo)(unsafe.Pointer(itf))
+	return parent.GetId()
+}
+
+// GetStatus was introduced in Windows.Foundation.FoundationContract v1.0.
+func (v *IAsyncOperation) GetStatus() (AsyncStatus, error) {
+	itf, err := winrt.QueryInterface(&v.IUnknown, &IIDIAsyncInfo)
+	if err != nil {
+		return AsyncStatusCanceled, err
+	}
+	defer itf.Release()
+	parent := (*IAsyncInfo)(unsafe.Pointer(itf))
+	return parent.GetStatus()
+}
+
+// GetErrorCode was introduced in Windows.Foundation.FoundationContract v1.0.
+func (v *IAsyncOperation) GetErrorCode() (HResult, error) {
+	itf, err := winrt.QueryInterface(&v.IUnknown, &IIDIAsyncInfo)
+	if err != nil {
+		return HResult{}, err
+	}
+	defer itf.Release()
+	parent := (*IAsyncInfo)(unsafe.Pointer(itf))
+	return parent.GetErrorCode()
+}
+
+// Cancel was introduced in Windows.Foundation.FoundationContract v1.0.
+func (v *IAsyncOperation) Cancel() error {
+	itf, err := winrt.QueryInterface(&v.IUnknown, &IIDIAsyncInfo)
+	if err != nil {
+		return err
+	}
+	defer itf.Release()
+	parent := (*IAsyncInfo)(unsafe.Pointer(itf))
+	return parent.Cancel()
+}
+
+// Close was introduced in Windows.Foundation.FoundationContract v1.0.
+func (v *IAsyncOperation) Close() error {
+	itf, err := winrt.QueryInterface(&v.IUnknown, &IIDIAsyncInfo)
+	if err != nil {
+		return err
+	}
+	defer itf.Release()
+	parent := (*IAsyncInfo)(unsafe.Pointer(itf))
+	return parent.Close()
+}
+
+// IAsyncOperationOf is a typed wrapper of IAsyncOperation, instantiated with the type arguments TResult.
+type IAsyncOperationOf[TResult any] struct {
+	*IAsyncOperation
+	signatures []string
+}
+
+// NewIAsyncOperationOf wraps the given IAsyncOperation. The signatures of the type arguments are
+// required to compute the IID of the instantiated interface. It panics if a type argument
+// cannot be passed to the vtable calls, see winrt.CheckTypeArg.
+func NewIAsyncOperationOf[TResult any](v *IAsyncOperation, signatureTResult string) *IAsyncOperationOf[TResult] {
+	winrt.CheckTypeArg[TResult]()
+	return &IAsyncOperationOf[TResult]{
+		IAsyncOperation: v,
+		signatures:      []string{signatureTResult},
+	}
+}
+
+// Signature returns the signature of the instantiated interface.
+func (w *IAsyncOperationOf[TResult]) Signature() string {
+	return winrt.ParameterizedInstanceSignature(GUIDIAsyncOperation, w.signatures...)
+}
+
+// Signatures returns the signatures of the type arguments.
+func (w *IAsyncOperationOf[TResult]) Signatures() []string {
+	return w.signatures
+}
+
+// IID returns the IID of the instantiated interface.
+func (w *IAsyncOperationOf[TResult]) IID() *ole.GUID {
+	return ole.NewGUID(winrt.ParameterizedInstanceGUID(GUIDIAsyncOperation, w.signatures...))
+}
+
+func (w *IAsyncOperationOf[TResult]) GetResults() (TResult, error) {
+	v := w.IAsyncOperation
+	var out TResult
+	hr, _, _ := syscall.Syscall(
+		v.VTable().GetResults,
+		2,                             // nargs
+		uintptr(unsafe.Pointer(v)),    // this
+		uintptr(unsafe.Pointer(&out)), // out TResult
+		0,
+	)
+
+	if hr != 0 {
+		return *new(TResult), ole.NewError(hr)
+	}
+
+	winrt.TypeArgResult(&out)
+	return out, nil
+}
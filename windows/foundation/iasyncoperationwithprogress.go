@@ -0,0 +1,233 @@
+// Code generated by winrt-go-gen. DO NOT EDIT.
+
+//go:build windows
+
+//nolint:all
+package foundation
+
+import (
+	"syscall"
+	"unsafe"
+
+	"github.com/go-ole/go-ole"
+	"github.com/waylyrics/winrt-go"
+)
+
+const GUIDIAsyncOperationWithProgress string = "b5d036d7-e297-498f-ba60-0289e76e23dd"
+const SignatureIAsyncOperationWithProgress string = "{b5d036d7-e297-498f-ba60-0289e76e23dd}"
+
+var IIDIAsyncOperationWithProgress = ole.GUID{Data1: 0xb5d036d7, Data2: 0xe297, Data3: 0x498f, Data4: [8]byte{0xba, 0x60, 0x02, 0x89, 0xe7, 0x6e, 0x23, 0xdd}}
+
+// IAsyncOperationWithProgress was introduced in Windows.Foundation.FoundationContract v1.0.
+type IAsyncOperationWithProgress struct {
+	ole.IInspectable
+}
+
+type IAsyncOperationWithProgressVtbl struct {
+	ole.IInspectableVtbl
+
+	SetProgress  uintptr
+	GetProgress  uintptr
+	SetCompleted uintptr
+	GetCompleted uintptr
+	GetResults   uintptr
+}
+
+func (v *IAsyncOperationWithProgress) VTable() *IAsyncOperationWithProgressVtbl {
+	return (*IAsyncOperationWithProgressVtbl)(unsafe.Pointer(v.RawVTable))
+}
+
+// SetProgress was introduced in Windows.Foundation.FoundationContract v1.0.
+func (v *IAsyncOperationWithProgress) SetProgress(handler *AsyncOperationProgressHandler) error {
+	hr, _, _ := syscall.Syscall(
+		v.VTable().SetProgress,
+		2,                                // nargs
+		uintptr(unsafe.Pointer(v)),       // this
+		uintptr(unsafe.Pointer(handler)), // in AsyncOperationProgressHandler
+		0,
+	)
+
+	if hr != 0 {
+		return ole.NewError(hr)
+	}
+
+	return nil
+}
+
+// GetProgress was introduced in Windows.Foundation.FoundationContract v1.0.
+func (v *IAsyncOperationWithProgress) GetProgress() (*AsyncOperationProgressHandler, error) {
+	var out *AsyncOperationProgressHandler
+	hr, _, _ := syscall.Syscall(
+		v.VTable().GetProgress,
+		2,                             // nargs
+		uintptr(unsafe.Pointer(v)),    // this
+		uintptr(unsafe.Pointer(&out)), // out AsyncOperationProgressHandler
+		0,
+	)
+
+	if hr != 0 {
+		return nil, ole.NewError(hr)
+	}
+
+	return out, nil
+}
+
+// SetCompleted was introduced in Windows.Foundation.FoundationContract v1.0.
+func (v *IAsyncOperationWithProgress) SetCompleted(handler *AsyncOperationWithProgressCompletedHandler) error {
+	hr, _, _ := syscall.Syscall(
+		v.VTable().SetCompleted,
+		2,                                // nargs
+		uintptr(unsafe.Pointer(v)),       // this
+		uintptr(unsafe.Pointer(handler)), // in AsyncOperationWithProgressCompletedHandler
+		0,
+	)
+
+	if hr != 0 {
+		return ole.NewError(hr)
+	}
+
+	return nil
+}
+
+// GetCompleted was introduced in Windows.Foundation.FoundationContract v1.0.
+func (v *IAsyncOperationWithProgress) GetCompleted() (*AsyncOperationWithProgressCompletedHandler, error) {
+	var out *AsyncOperationWithProgressCompletedHandler
+	hr, _, _ := syscall.Syscall(
+		v.VTable().GetCompleted,
+		2,                             // nargs
+		uintptr(unsafe.Pointer(v)),    // this
+		uintptr(unsafe.Pointer(&out)), // out AsyncOperationWithProgressCompletedHandler
+		0,
+	)
+
+	if hr != 0 {
+		return nil, ole.NewError(hr)
+	}
+
+	return out, nil
+}
+
+// GetResults was introduced in Windows.Foundation.FoundationContract v1.0.
+func (v *IAsyncOperationWithProgress) GetResults() (unsafe.Pointer, error) {
+	var out unsafe.Pointer
+	hr, _, _ := syscall.Syscall(
+		v.VTable().GetResults,
+		2,                             // nargs
+		uintptr(unsafe.Pointer(v)),    // this
+		uintptr(unsafe.Pointer(&out)), // out unsafe.Pointer
+		0,
+	)
+
+	if hr != 0 {
+		return nil, ole.NewError(hr)
+	}
+
+	return out, nil
+}
+
+// GetId was introduced in Windows.Foundation.FoundationContract v1.0.
+func (v *IAsyncOperationWithProgress) GetId() (uint32, error) {
+	itf, err := winrt.QueryInterface(&v.IUnknown, &IIDIAsyncInfo)
+	if err != nil {
+		return 0, err
+	}
+	defer itf.Release()
+	parent := (*IAsyncInfo)(unsafe.Pointer(itf))
+	return parent.GetId()
+}
+
+// GetStatus was introduced in Windows.Foundation.FoundationContract v1.0.
+func (v *IAsyncOperationWithProgress) GetStatus() (AsyncStatus, error) {
+	itf, err := winrt.QueryInterface(&v.IUnknown, &IIDIAsyncInfo)
+	if err != nil {
+		return AsyncStatusCanceled, err
+	}
+	defer itf.Release()
+	parent := (*IAsyncInfo)(unsafe.Pointer(itf))
+	return parent.GetStatus()
+}
+
+// GetErrorCode was introduced in Windows.Foundation.FoundationContract v1.0.
+func (v *IAsyncOperationWithProgress) GetErrorCode() (HResult, error) {
+	itf, err := winrt.QueryInterface(&v.IUnknown, &IIDIAsyncInfo)
+	if err != nil {
+		return HResult{}, err
+	}
+	defer itf.Release()
+	parent := (*IAsyncInfo)(unsafe.Pointer(itf))
+	return parent.GetErrorCode()
+}
+
+// Cancel was introduced in Windows.Foundation.FoundationContract v1.0.
+func (v *IAsyncOperationWithProgress) Cancel() error {
+	itf, err := winrt.QueryInterface(&v.IUnknown, &IIDIAsyncInfo)
+	if err != nil {
+		return err
+	}
+	defer itf.Release()
+	parent := (*IAsyncInfo)(unsafe.Pointer(itf))
+	return parent.Cancel()
+}
+
+// Close was introduced in Windows.Foundation.FoundationContract v1.0.
+func (v *IAsyncOperationWithProgress) Close() error {
+	itf, err := winrt.QueryInterface(&v.IUnknown, &IIDIAsyncInfo)
+	if err != nil {
+		return err
+	}
+	defer itf.Release()
+	parent := (*IAsyncInfo)(unsafe.Pointer(itf))
+	return parent.Close()
+}
+
+// IAsyncOperationWithProgressOf is a typed wrapper of IAsyncOperationWithProgress, instantiated with the type arguments TResult, TProgress.
+type IAsyncOperationWithProgressOf[TResult any, TProgress any] struct {
+	*IAsyncOperationWithProgress
+	signatures []string
+}
+
+// NewIAsyncOperationWithProgressOf wraps the given IAsyncOperationWithProgress. The signatures of the type arguments are
+// required to compute the IID of the instantiated interface. It panics if a type argument
+// cannot be passed to the vtable calls, see winrt.CheckTypeArg.
+func NewIAsyncOperationWithProgressOf[TResult any, TProgress any](v *IAsyncOperationWithProgress, signatureTResult string, signatureTProgress string) *IAsyncOperationWithProgressOf[TResult, TProgress] {
+	winrt.CheckTypeArg[TResult]()
+	winrt.CheckTypeArg[TProgress]()
+	return &IAsyncOperationWithProgressOf[TResult, TProgress]{
+		IAsyncOperationWithProgress: v,
+		signatures:                  []string{signatureTResult, signatureTProgress},
+	}
+}
+
+// Signature returns the signature of the instantiated interface.
+func (w *IAsyncOperationWithProgressOf[TResult, TProgress]) Signature() string {
+	return winrt.ParameterizedInstanceSignature(GUIDIAsyncOperationWithProgress, w.signatures...)
+}
+
+// Signatures returns the signatures of the type arguments.
+func (w *IAsyncOperationWithProgressOf[TResult, TProgress]) Signatures() []string {
+	return w.signatures
+}
+
+// IID returns the IID of the instantiated interface.
+func (w *IAsyncOperationWithProgressOf[TResult, TProgress]) IID() *ole.GUID {
+	return ole.NewGUID(winrt.ParameterizedInstanceGUID(GUIDIAsyncOperationWithProgress, w.signatures...))
+}
+
+func (w *IAsyncOperationWithProgressOf[TResult, TProgress]) GetResults() (TResult, error) {
+	v := w.IAsyncOperationWithProgress
+	var out TResult
+	hr, _, _ := syscall.Syscall(
+		v.VTable().GetResults,
+		2,                             // nargs
+		uintptr(unsafe.Pointer(v)),    // this
+		uintptr(unsafe.Pointer(&out)), // out TResult
+		0,
+	)
+
+	if hr != 0 {
+		return *new(TResult), ole.NewError(hr)
+	}
+
+	winrt.TypeArgResult(&out)
+	return out, nil
+}
@@ -0,0 +1,820 @@
+// Code generated by winrt-go-gen. DO NOT EDIT.
+
+//go:build windows
+
+//nolint:all
+package foundation
+
+import (
+	"syscall"
+	"unsafe"
+
+	"github.com/go-ole/go-ole"
+	"github.com/waylyrics/winrt-go"
+	"github.com/waylyrics/winrt-go/hstring"
+)
+
+const GUIDIPropertyValue string = "4bd682dd-7554-40e9-9a9b-82654ede7e62"
+const SignatureIPropertyValue string = "{4bd682dd-7554-40e9-9a9b-82654ede7e62}"
+
+var IIDIPropertyValue = ole.GUID{Data1: 0x4bd682dd, Data2: 0x7554, Data3: 0x40e9, Data4: [8]byte{0x9a, 0x9b, 0x82, 0x65, 0x4e, 0xde, 0x7e, 0x62}}
+
+// IPropertyValue was introduced in Windows.Foundation.FoundationContract v1.0.
+type IPropertyValue struct {
+	ole.IInspectable
+}
+
+type IPropertyValueVtbl struct {
+	ole.IInspectableVtbl
+
+	GetType             uintptr
+	GetIsNumericScalar  uintptr
+	GetUInt8            uintptr
+	GetInt16            uintptr
+	GetUInt16           uintptr
+	GetInt32            uintptr
+	GetUInt32           uintptr
+	GetInt64            uintptr
+	GetUInt64           uintptr
+	GetSingle           uintptr
+	GetDouble           uintptr
+	GetChar16           uintptr
+	GetBoolean          uintptr
+	GetString           uintptr
+	GetGuid             uintptr
+	GetDateTime         uintptr
+	GetTimeSpan         uintptr
+	GetPoint            uintptr
+	GetSize             uintptr
+	GetRect             uintptr
+	GetUInt8Array       uintptr
+	GetInt16Array       uintptr
+	GetUInt16Array      uintptr
+	GetInt32Array       uintptr
+	GetUInt32Array      uintptr
+	GetInt64Array       uintptr
+	GetUInt64Array      uintptr
+	GetSingleArray      uintptr
+	GetDoubleArray      uintptr
+	GetChar16Array      uintptr
+	GetBooleanArray     uintptr
+	GetStringArray      uintptr
+	GetInspectableArray uintptr
+	GetGuidArray        uintptr
+	GetDateTimeArray    uintptr
+	GetTimeSpanArray    uintptr
+	GetPointArray       uintptr
+	GetSizeArray        uintptr
+	GetRectArray        uintptr
+}
+
+func (v *IPropertyValue) VTable() *IPropertyValueVtbl {
+	return (*IPropertyValueVtbl)(unsafe.Pointer(v.RawVTable))
+}
+
+// IsSupportedIPropertyValue returns true if the given object implements IPropertyValue.
+func IsSupportedIPropertyValue(obj *ole.IUnknown) bool {
+	return winrt.IsSupported(obj, &IIDIPropertyValue)
+}
+
+// GetType was introduced in Windows.Foundation.FoundationContract v1.0.
+func (v *IPropertyValue) GetType() (PropertyType, error) {
+	var out PropertyType
+	hr, _, _ := syscall.Syscall(
+		v.VTable().GetType,
+		2,                             // nargs
+		uintptr(unsafe.Pointer(v)),    // this
+		uintptr(unsafe.Pointer(&out)), // out PropertyType
+		0,
+	)
+
+	if hr != 0 {
+		return PropertyTypeEmpty, ole.NewError(hr)
+	}
+
+	return out, nil
+}
+
+// GetIsNumericScalar was introduced in Windows.Foundation.FoundationContract v1.0.
+func (v *IPropertyValue) GetIsNumericScalar() (bool, error) {
+	var out bool
+	hr, _, _ := syscall.Syscall(
+		v.VTable().GetIsNumericScalar,
+		2,                             // nargs
+		uintptr(unsafe.Pointer(v)),    // this
+		uintptr(unsafe.Pointer(&out)), // out bool
+		0,
+	)
+
+	if hr != 0 {
+		return false, ole.NewError(hr)
+	}
+
+	return out, nil
+}
+
+// GetUInt8 was introduced in Windows.Foundation.FoundationContract v1.0.
+func (v *IPropertyValue) GetUInt8() (uint8, error) {
+	var out uint8
+	hr, _, _ := syscall.Syscall(
+		v.VTable().GetUInt8,
+		2,                             // nargs
+		uintptr(unsafe.Pointer(v)),    // this
+		uintptr(unsafe.Pointer(&out)), // out uint8
+		0,
+	)
+
+	if hr != 0 {
+		return 0, ole.NewError(hr)
+	}
+
+	return out, nil
+}
+
+// GetInt16 was introduced in Windows.Foundation.FoundationContract v1.0.
+func (v *IPropertyValue) GetInt16() (int16, error) {
+	var out int16
+	hr, _, _ := syscall.Syscall(
+		v.VTable().GetInt16,
+		2,                             // nargs
+		uintptr(unsafe.Pointer(v)),    // this
+		uintptr(unsafe.Pointer(&out)), // out int16
+		0,
+	)
+
+	if hr != 0 {
+		return 0, ole.NewError(hr)
+	}
+
+	return out, nil
+}
+
+// GetUInt16 was introduced in Windows.Foundation.FoundationContract v1.0.
+func (v *IPropertyValue) GetUInt16() (uint16, error) {
+	var out uint16
+	hr, _, _ := syscall.Syscall(
+		v.VTable().GetUInt16,
+		2,                             // nargs
+		uintptr(unsafe.Pointer(v)),    // this
+		uintptr(unsafe.Pointer(&out)), // out uint16
+		0,
+	)
+
+	if hr != 0 {
+		return 0, ole.NewError(hr)
+	}
+
+	return out, nil
+}
+
+// GetInt32 was introduced in Windows.Foundation.FoundationContract v1.0.
+func (v *IPropertyValue) GetInt32() (int32, error) {
+	var out int32
+	hr, _, _ := syscall.Syscall(
+		v.VTable().GetInt32,
+		2,                             // nargs
+		uintptr(unsafe.Pointer(v)),    // this
+		uintptr(unsafe.Pointer(&out)), // out int32
+		0,
+	)
+
+	if hr != 0 {
+		return 0, ole.NewError(hr)
+	}
+
+	return out, nil
+}
+
+// GetUInt32 was introduced in Windows.Foundation.FoundationContract v1.0.
+func (v *IPropertyValue) GetUInt32() (uint32, error) {
+	var out uint32
+	hr, _, _ := syscall.Syscall(
+		v.VTable().GetUInt32,
+		2,                             // nargs
+		uintptr(unsafe.Pointer(v)),    // this
+		uintptr(unsafe.Pointer(&out)), // out uint32
+		0,
+	)
+
+	if hr != 0 {
+		return 0, ole.NewError(hr)
+	}
+
+	return out, nil
+}
+
+// GetInt64 was introduced in Windows.Foundation.FoundationContract v1.0.
+func (v *IPropertyValue) GetInt64() (int64, error) {
+	var out int64
+	hr, _, _ := syscall.Syscall(
+		v.VTable().GetInt64,
+		2,                             // nargs
+		uintptr(unsafe.Pointer(v)),    // this
+		uintptr(unsafe.Pointer(&out)), // out int64
+		0,
+	)
+
+	if hr != 0 {
+		return 0, ole.NewError(hr)
+	}
+
+	return out, nil
+}
+
+// GetUInt64 was introduced in Windows.Foundation.FoundationContract v1.0.
+func (v *IPropertyValue) GetUInt64() (uint64, error) {
+	var out uint64
+	hr, _, _ := syscall.Syscall(
+		v.VTable().GetUInt64,
+		2,                             // nargs
+		uintptr(unsafe.Pointer(v)),    // this
+		uintptr(unsafe.Pointer(&out)), // out uint64
+		0,
+	)
+
+	if hr != 0 {
+		return 0, ole.NewError(hr)
+	}
+
+	return out, nil
+}
+
+// GetSingle was introduced in Windows.Foundation.FoundationContract v1.0.
+func (v *IPropertyValue) GetSingle() (float32, error) {
+	var out float32
+	hr, _, _ := syscall.Syscall(
+		v.VTable().GetSingle,
+		2,                             // nargs
+		uintptr(unsafe.Pointer(v)),    // this
+		uintptr(unsafe.Pointer(&out)), // out float32
+		0,
+	)
+
+	if hr != 0 {
+		return 0.0, ole.NewError(hr)
+	}
+
+	return out, nil
+}
+
+// GetDouble was introduced in Windows.Foundation.FoundationContract v1.0.
+func (v *IPropertyValue) GetDouble() (float64, error) {
+	var out float64
+	hr, _, _ := syscall.Syscall(
+		v.VTable().GetDouble,
+		2,                             // nargs
+		uintptr(unsafe.Pointer(v)),    // this
+		uintptr(unsafe.Pointer(&out)), // out float64
+		0,
+	)
+
+	if hr != 0 {
+		return 0.0, ole.NewError(hr)
+	}
+
+	return out, nil
+}
+
+// GetChar16 was introduced in Windows.Foundation.FoundationContract v1.0.
+func (v *IPropertyValue) GetChar16() (uint16, error) {
+	var out uint16
+	hr, _, _ := syscall.Syscall(
+		v.VTable().GetChar16,
+		2,                             // nargs
+		uintptr(unsafe.Pointer(v)),    // this
+		uintptr(unsafe.Pointer(&out)), // out uint16
+		0,
+	)
+
+	if hr != 0 {
+		return 0, ole.NewError(hr)
+	}
+
+	return out, nil
+}
+
+// GetBoolean was introduced in Windows.Foundation.FoundationContract v1.0.
+func (v *IPropertyValue) GetBoolean() (bool, error) {
+	var out bool
+	hr, _, _ := syscall.Syscall(
+		v.VTable().GetBoolean,
+		2,                             // nargs
+		uintptr(unsafe.Pointer(v)),    // this
+		uintptr(unsafe.Pointer(&out)), // out bool
+		0,
+	)
+
+	if hr != 0 {
+		return false, ole.NewError(hr)
+	}
+
+	return out, nil
+}
+
+// GetString was introduced in Windows.Foundation.FoundationContract v1.0.
+func (v *IPropertyValue) GetString() (string, error) {
+	var outHStr hstring.HString
+	hr, _, _ := syscall.Syscall(
+		v.VTable().GetString,
+		2,                                 // nargs
+		uintptr(unsafe.Pointer(v)),        // this
+		uintptr(unsafe.Pointer(&outHStr)), // out string
+		0,
+	)
+
+	if hr != 0 {
+		return "", ole.NewError(hr)
+	}
+
+	out := outHStr.String()
+	_ = outHStr.Delete()
+	return out, nil
+}
+
+// GetGuid was introduced in Windows.Foundation.FoundationContract v1.0.
+func (v *IPropertyValue) GetGuid() (syscall.GUID, error) {
+	var out syscall.GUID
+	hr, _, _ := syscall.Syscall(
+		v.VTable().GetGuid,
+		2,                             // nargs
+		uintptr(unsafe.Pointer(v)),    // this
+		uintptr(unsafe.Pointer(&out)), // out syscall.GUID
+		0,
+	)
+
+	if hr != 0 {
+		return syscall.GUID{}, ole.NewError(hr)
+	}
+
+	return out, nil
+}
+
+// GetDateTime was introduced in Windows.Foundation.FoundationContract v1.0.
+func (v *IPropertyValue) GetDateTime() (DateTime, error) {
+	var out DateTime
+	hr, _, _ := syscall.Syscall(
+		v.VTable().GetDateTime,
+		2,                             // nargs
+		uintptr(unsafe.Pointer(v)),    // this
+		uintptr(unsafe.Pointer(&out)), // out DateTime
+		0,
+	)
+
+	if hr != 0 {
+		return DateTime{}, ole.NewError(hr)
+	}
+
+	return out, nil
+}
+
+// GetTimeSpan was introduced in Windows.Foundation.FoundationContract v1.0.
+func (v *IPropertyValue) GetTimeSpan() (TimeSpan, error) {
+	var out TimeSpan
+	hr, _, _ := syscall.Syscall(
+		v.VTable().GetTimeSpan,
+		2,                             // nargs
+		uintptr(unsafe.Pointer(v)),    // this
+		uintptr(unsafe.Pointer(&out)), // out TimeSpan
+		0,
+	)
+
+	if hr != 0 {
+		return TimeSpan{}, ole.NewError(hr)
+	}
+
+	return out, nil
+}
+
+// GetPoint was introduced in Windows.Foundation.FoundationContract v1.0.
+func (v *IPropertyValue) GetPoint() (Point, error) {
+	var out Point
+	hr, _, _ := syscall.Syscall(
+		v.VTable().GetPoint,
+		2,                             // nargs
+		uintptr(unsafe.Pointer(v)),    // this
+		uintptr(unsafe.Pointer(&out)), // out Point
+		0,
+	)
+
+	if hr != 0 {
+		return Point{}, ole.NewError(hr)
+	}
+
+	return out, nil
+}
+
+// GetSize was introduced in Windows.Foundation.FoundationContract v1.0.
+func (v *IPropertyValue) GetSize() (Size, error) {
+	var out Size
+	hr, _, _ := syscall.Syscall(
+		v.VTable().GetSize,
+		2,                             // nargs
+		uintptr(unsafe.Pointer(v)),    // this
+		uintptr(unsafe.Pointer(&out)), // out Size
+		0,
+	)
+
+	if hr != 0 {
+		return Size{}, ole.NewError(hr)
+	}
+
+	return out, nil
+}
+
+// GetRect was introduced in Windows.Foundation.FoundationContract v1.0.
+func (v *IPropertyValue) GetRect() (Rect, error) {
+	var out Rect
+	hr, _, _ := syscall.Syscall(
+		v.VTable().GetRect,
+		2,                             // nargs
+		uintptr(unsafe.Pointer(v)),    // this
+		uintptr(unsafe.Pointer(&out)), // out Rect
+		0,
+	)
+
+	if hr != 0 {
+		return Rect{}, ole.NewError(hr)
+	}
+
+	return out, nil
+}
+
+// GetUInt8Array was introduced in Windows.Foundation.FoundationContract v1.0.
+func (v *IPropertyValue) GetUInt8Array() ([]uint8, error) {
+	var valueSize uint32
+	var valuePtr unsafe.Pointer
+	hr, _, _ := syscall.Syscall(
+		v.VTable().GetUInt8Array,
+		3,                                   // nargs
+		uintptr(unsafe.Pointer(v)),          // this
+		uintptr(unsafe.Pointer(&valueSize)), // out uint32
+		uintptr(unsafe.Pointer(&valuePtr)),  // out []uint8
+	)
+
+	if hr != 0 {
+		return nil, ole.NewError(hr)
+	}
+
+	value := winrt.ReceiveArray[uint8](valuePtr, valueSize)
+	return value, nil
+}
+
+// GetInt16Array was introduced in Windows.Foundation.FoundationContract v1.0.
+func (v *IPropertyValue) GetInt16Array() ([]int16, error) {
+	var valueSize uint32
+	var valuePtr unsafe.Pointer
+	hr, _, _ := syscall.Syscall(
+		v.VTable().GetInt16Array,
+		3,                                   // nargs
+		uintptr(unsafe.Pointer(v)),          // this
+		uintptr(unsafe.Pointer(&valueSize)), // out uint32
+		uintptr(unsafe.Pointer(&valuePtr)),  // out []int16
+	)
+
+	if hr != 0 {
+		return nil, ole.NewError(hr)
+	}
+
+	value := winrt.ReceiveArray[int16](valuePtr, valueSize)
+	return value, nil
+}
+
+// GetUInt16Array was introduced in Windows.Foundation.FoundationContract v1.0.
+func (v *IPropertyValue) GetUInt16Array() ([]uint16, error) {
+	var valueSize uint32
+	var valuePtr unsafe.Pointer
+	hr, _, _ := syscall.Syscall(
+		v.VTable().GetUInt16Array,
+		3,                                   // nargs
+		uintptr(unsafe.Pointer(v)),          // this
+		uintptr(unsafe.Pointer(&valueSize)), // out uint32
+		uintptr(unsafe.Pointer(&valuePtr)),  // out []uint16
+	)
+
+	if hr != 0 {
+		return nil, ole.NewError(hr)
+	}
+
+	value := winrt.ReceiveArray[uint16](valuePtr, valueSize)
+	return value, nil
+}
+
+// GetInt32Array was introduced in Windows.Foundation.FoundationContract v1.0.
+func (v *IPropertyValue) GetInt32Array() ([]int32, error) {
+	var valueSize uint32
+	var valuePtr unsafe.Pointer
+	hr, _, _ := syscall.Syscall(
+		v.VTable().GetInt32Array,
+		3,                                   // nargs
+		uintptr(unsafe.Pointer(v)),          // this
+		uintptr(unsafe.Pointer(&valueSize)), // out uint32
+		uintptr(unsafe.Pointer(&valuePtr)),  // out []int32
+	)
+
+	if hr != 0 {
+		return nil, ole.NewError(hr)
+	}
+
+	value := winrt.ReceiveArray[int32](valuePtr, valueSize)
+	return value, nil
+}
+
+// GetUInt32Array was introduced in Windows.Foundation.FoundationContract v1.0.
+func (v *IPropertyValue) GetUInt32Array() ([]uint32, error) {
+	var valueSize uint32
+	var valuePtr unsafe.Pointer
+	hr, _, _ := syscall.Syscall(
+		v.VTable().GetUInt32Array,
+		3,                                   // nargs
+		uintptr(unsafe.Pointer(v)),          // this
+		uintptr(unsafe.Pointer(&valueSize)), // out uint32
+		uintptr(unsafe.Pointer(&valuePtr)),  // out []uint32
+	)
+
+	if hr != 0 {
+		return nil, ole.NewError(hr)
+	}
+
+	value := winrt.ReceiveArray[uint32](valuePtr, valueSize)
+	return value, nil
+}
+
+// GetInt64Array was introduced in Windows.Foundation.FoundationContract v1.0.
+func (v *IPropertyValue) GetInt64Array() ([]int64, error) {
+	var valueSize uint32
+	var valuePtr unsafe.Pointer
+	hr, _, _ := syscall.Syscall(
+		v.VTable().GetInt64Array,
+		3,                                   // nargs
+		uintptr(unsafe.Pointer(v)),          // this
+		uintptr(unsafe.Pointer(&valueSize)), // out uint32
+		uintptr(unsafe.Pointer(&valuePtr)),  // out []int64
+	)
+
+	if hr != 0 {
+		return nil, ole.NewError(hr)
+	}
+
+	value := winrt.ReceiveArray[int64](valuePtr, valueSize)
+	return value, nil
+}
+
+// GetUInt64Array was introduced in Windows.Foundation.FoundationContract v1.0.
+func (v *IPropertyValue) GetUInt64Array() ([]uint64, error) {
+	var valueSize uint32
+	var valuePtr unsafe.Pointer
+	hr, _, _ := syscall.Syscall(
+		v.VTable().GetUInt64Array,
+		3,                                   // nargs
+		uintptr(unsafe.Pointer(v)),          // this
+		uintptr(unsafe.Pointer(&valueSize)), // out uint32
+		uintptr(unsafe.Pointer(&valuePtr)),  // out []uint64
+	)
+
+	if hr != 0 {
+		return nil, ole.NewError(hr)
+	}
+
+	value := winrt.ReceiveArray[uint64](valuePtr, valueSize)
+	return value, nil
+}
+
+// GetSingleArray was introduced in Windows.Foundation.FoundationContract v1.0.
+func (v *IPropertyValue) GetSingleArray() ([]float32, error) {
+	var valueSize uint32
+	var valuePtr unsafe.Pointer
+	hr, _, _ := syscall.Syscall(
+		v.VTable().GetSingleArray,
+		3,                                   // nargs
+		uintptr(unsafe.Pointer(v)),          // this
+		uintptr(unsafe.Pointer(&valueSize)), // out uint32
+		uintptr(unsafe.Pointer(&valuePtr)),  // out []float32
+	)
+
+	if hr != 0 {
+		return nil, ole.NewError(hr)
+	}
+
+	value := winrt.ReceiveArray[float32](valuePtr, valueSize)
+	return value, nil
+}
+
+// GetDoubleArray was introduced in Windows.Foundation.FoundationContract v1.0.
+func (v *IPropertyValue) GetDoubleArray() ([]float64, error) {
+	var valueSize uint32
+	var valuePtr unsafe.Pointer
+	hr, _, _ := syscall.Syscall(
+		v.VTable().GetDoubleArray,
+		3,                                   // nargs
+		uintptr(unsafe.Pointer(v)),          // this
+		uintptr(unsafe.Pointer(&valueSize)), // out uint32
+		uintptr(unsafe.Pointer(&valuePtr)),  // out []float64
+	)
+
+	if hr != 0 {
+		return nil, ole.NewError(hr)
+	}
+
+	value := winrt.ReceiveArray[float64](valuePtr, valueSize)
+	return value, nil
+}
+
+// GetChar16Array was introduced in Windows.Foundation.FoundationContract v1.0.
+func (v *IPropertyValue) GetChar16Array() ([]uint16, error) {
+	var valueSize uint32
+	var valuePtr unsafe.Pointer
+	hr, _, _ := syscall.Syscall(
+		v.VTable().GetChar16Array,
+		3,                                   // nargs
+		uintptr(unsafe.Pointer(v)),          // this
+		uintptr(unsafe.Pointer(&valueSize)), // out uint32
+		uintptr(unsafe.Pointer(&valuePtr)),  // out []uint16
+	)
+
+	if hr != 0 {
+		return nil, ole.NewError(hr)
+	}
+
+	value := winrt.ReceiveArray[uint16](valuePtr, valueSize)
+	return value, nil
+}
+
+// GetBooleanArray was introduced in Windows.Foundation.FoundationContract v1.0.
+func (v *IPropertyValue) GetBooleanArray() ([]bool, error) {
+	var valueSize uint32
+	var valuePtr unsafe.Pointer
+	hr, _, _ := syscall.Syscall(
+		v.VTable().GetBooleanArray,
+		3,                                   // nargs
+		uintptr(unsafe.Pointer(v)),          // this
+		uintptr(unsafe.Pointer(&valueSize)), // out uint32
+		uintptr(unsafe.Pointer(&valuePtr)),  // out []bool
+	)
+
+	if hr != 0 {
+		return nil, ole.NewError(hr)
+	}
+
+	value := winrt.ReceiveArray[bool](valuePtr, valueSize)
+	return value, nil
+}
+
+// GetStringArray was introduced in Windows.Foundation.FoundationContract v1.0.
+func (v *IPropertyValue) GetStringArray() ([]string, error) {
+	var valueSize uint32
+	var valuePtr unsafe.Pointer
+	hr, _, _ := syscall.Syscall(
+		v.VTable().GetStringArray,
+		3,                                   // nargs
+		uintptr(unsafe.Pointer(v)),          // this
+		uintptr(unsafe.Pointer(&valueSize)), // out uint32
+		uintptr(unsafe.Pointer(&valuePtr)),  // out []string
+	)
+
+	if hr != 0 {
+		return nil, ole.NewError(hr)
+	}
+
+	value := winrt.ReceiveStringArray(valuePtr, valueSize)
+	return value, nil
+}
+
+// GetInspectableArray was introduced in Windows.Foundation.FoundationContract v1.0.
+func (v *IPropertyValue) GetInspectableArray() ([]unsafe.Pointer, error) {
+	var valueSize uint32
+	var valuePtr unsafe.Pointer
+	hr, _, _ := syscall.Syscall(
+		v.VTable().GetInspectableArray,
+		3,                                   // nargs
+		uintptr(unsafe.Pointer(v)),          // this
+		uintptr(unsafe.Pointer(&valueSize)), // out uint32
+		uintptr(unsafe.Pointer(&valuePtr)),  // out []unsafe.Pointer
+	)
+
+	if hr != 0 {
+		return nil, ole.NewError(hr)
+	}
+
+	value := winrt.ReceiveArray[unsafe.Pointer](valuePtr, valueSize)
+	return value, nil
+}
+
+// GetGuidArray was introduced in Windows.Foundation.FoundationContract v1.0.
+func (v *IPropertyValue) GetGuidArray() ([]syscall.GUID, error) {
+	var valueSize uint32
+	var valuePtr unsafe.Pointer
+	hr, _, _ := syscall.Syscall(
+		v.VTable().GetGuidArray,
+		3,                                   // nargs
+		uintptr(unsafe.Pointer(v)),          // this
+		uintptr(unsafe.Pointer(&valueSize)), // out uint32
+		uintptr(unsafe.Pointer(&valuePtr)),  // out []syscall.GUID
+	)
+
+	if hr != 0 {
+		return nil, ole.NewError(hr)
+	}
+
+	value := winrt.ReceiveArray[syscall.GUID](valuePtr, valueSize)
+	return value, nil
+}
+
+// GetDateTimeArray was introduced in Windows.Foundation.FoundationContract v1.0.
+func (v *IPropertyValue) GetDateTimeArray() ([]DateTime, error) {
+	var valueSize uint32
+	var valuePtr unsafe.Pointer
+	hr, _, _ := syscall.Syscall(
+		v.VTable().GetDateTimeArray,
+		3,                                   // nargs
+		uintptr(unsafe.Pointer(v)),          // this
+		uintptr(unsafe.Pointer(&valueSize)), // out uint32
+		uintptr(unsafe.Pointer(&valuePtr)),  // out []DateTime
+	)
+
+	if hr != 0 {
+		return nil, ole.NewError(hr)
+	}
+
+	value := winrt.ReceiveArray[DateTime](valuePtr, valueSize)
+	return value, nil
+}
+
+// GetTimeSpanArray was introduced in Windows.Foundation.FoundationContract v1.0.
+func (v *IPropertyValue) GetTimeSpanArray() ([]TimeSpan, error) {
+	var valueSize uint32
+	var valuePtr unsafe.Pointer
+	hr, _, _ := syscall.Syscall(
+		v.VTable().GetTimeSpanArray,
+		3,                                   // nargs
+		uintptr(unsafe.Pointer(v)),          // this
+		uintptr(unsafe.Pointer(&valueSize)), // out uint32
+		uintptr(unsafe.Pointer(&valuePtr)),  // out []TimeSpan
+	)
+
+	if hr != 0 {
+		return nil, ole.NewError(hr)
+	}
+
+	value := winrt.ReceiveArray[TimeSpan](valuePtr, valueSize)
+	return value, nil
+}
+
+// GetPointArray was introduced in Windows.Foundation.FoundationContract v1.0.
+func (v *IPropertyValue) GetPointArray() ([]Point, error) {
+	var valueSize uint32
+	var valuePtr unsafe.Pointer
+	hr, _, _ := syscall.Syscall(
+		v.VTable().GetPointArray,
+		3,                                   // nargs
+		uintptr(unsafe.Pointer(v)),          // this
+		uintptr(unsafe.Pointer(&valueSize)), // out uint32
+		uintptr(unsafe.Pointer(&valuePtr)),  // out []Point
+	)
+
+	if hr != 0 {
+		return nil, ole.NewError(hr)
+	}
+
+	value := winrt.ReceiveArray[Point](valuePtr, valueSize)
+	return value, nil
+}
+
+// GetSizeArray was introduced in Windows.Foundation.FoundationContract v1.0.
+func (v *IPropertyValue) GetSizeArray() ([]Size, error) {
+	var valueSize uint32
+	var valuePtr unsafe.Pointer
+	hr, _, _ := syscall.Syscall(
+		v.VTable().GetSizeArray,
+		3,                                   // nargs
+		uintptr(unsafe.Pointer(v)),          // this
+		uintptr(unsafe.Pointer(&valueSize)), // out uint32
+		uintptr(unsafe.Pointer(&valuePtr)),  // out []Size
+	)
+
+	if hr != 0 {
+		return nil, ole.NewError(hr)
+	}
+
+	value := winrt.ReceiveArray[Size](valuePtr, valueSize)
+	return value, nil
+}
+
+// GetRectArray was introduced in Windows.Foundation.FoundationContract v1.0.
+func (v *IPropertyValue) GetRectArray() ([]Rect, error) {
+	var valueSize uint32
+	var valuePtr unsafe.Pointer
+	hr, _, _ := syscall.Syscall(
+		v.VTable().GetRectArray,
+		3,                                   // nargs
+		uintptr(unsafe.Pointer(v)),          // this
+		uintptr(unsafe.Pointer(&valueSize)), // out uint32
+		uintptr(unsafe.Pointer(&valuePtr)),  // out []Rect
+	)
+
+	if hr != 0 {
+		return nil, ole.NewError(hr)
+	}
+
+	value := winrt.ReceiveArray[Rect](valuePtr, valueSize)
+	return value, nil
+}
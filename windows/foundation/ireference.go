@@ -0,0 +1,532 @@
+// Code generated by winrt-go-gen. DO NOT EDIT.
+
+//go:build windows
+
+//nolint:all
+package foundation
+
+import (
+	"syscall"
+	"unsafe"
+
+	"github.com/go-ole/go-ole"
+	"github.com/waylyrics/winrt-go"
+)
+
+const GUIDIReference string = "61c17706-2d65-11e0-9ae8-d48564015472"
+const SignatureIReference string = "{61c17706-2d65-11e0-9ae8-d48564015472}"
+
+var IIDIReference = ole.GUID{Data1: 0x61c17706, Data2: 0x2d65, Data3: 0x11e0, Data4: [8]byte{0x9a, 0xe8, 0xd4, 0x85, 0x64, 0x01, 0x54, 0x72}}
+
+// IReference was introduced in Windows.Foundation.FoundationContract v1.0.
+type IReference struct {
+	ole.IInspectable
+}
+
+type IReferenceVtbl struct {
+	ole.IInspectableVtbl
+
+	GetValue uintptr
+}
+
+func (v *IReference) VTable() *IReferenceVtbl {
+	return (*IReferenceVtbl)(unsafe.Pointer(v.RawVTable))
+}
+
+// GetValue was introduced in Windows.Foundation.FoundationContract v1.0.
+func (v *IReference) GetValue() (unsafe.Pointer, error) {
+	var out unsafe.Pointer
+	hr, _, _ := syscall.Syscall(
+		v.VTable().GetValue,
+		2,                             // nargs
+		uintptr(unsafe.Pointer(v)),    // this
+		uintptr(unsafe.Pointer(&out)), // out unsafe.Pointer
+		0,
+	)
+
+	if hr != 0 {
+		return nil, ole.NewError(hr)
+	}
+
+	return out, nil
+}
+
+// GetType was introduced in Windows.Foundation.FoundationContract v1.0.
+func (v *IReference) GetType() (PropertyType, error) {
+	itf, err := winrt.QueryInterface(&v.IUnknown, &IIDIPropertyValue)
+	if err != nil {
+		return PropertyTypeEmpty, err
+	}
+	defer itf.Release()
+	parent := (*IPropertyValue)(unsafe.Pointer(itf))
+	return parent.GetType()
+}
+
+// GetIsNumericScalar was introduced in Windows.Foundation.FoundationContract v1.0.
+func (v *IReference) GetIsNumericScalar() (bool, error) {
+	itf, err := winrt.QueryInterface(&v.IUnknown, &IIDIPropertyValue)
+	if err != nil {
+		return false, err
+	}
+	defer itf.Release()
+	parent := (*IPropertyValue)(unsafe.Pointer(itf))
+	return parent.GetIsNumericScalar()
+}
+
+// GetUInt8 was introduced in Windows.Foundation.FoundationContract v1.0.
+func (v *IReference) GetUInt8() (uint8, error) {
+	itf, err := winrt.QueryInterface(&v.IUnknown, &IIDIPropertyValue)
+	if err != nil {
+		return 0, err
+	}
+	defer itf.Release()
+	parent := (*IPropertyValue)(unsafe.Pointer(itf))
+	return parent.GetUInt8()
+}
+
+// GetInt16 was introduced in Windows.Foundation.FoundationContract v1.0.
+func (v *IReference) GetInt16() (int16, error) {
+	itf, err := winrt.QueryInterface(&v.IUnknown, &IIDIPropertyValue)
+	if err != nil {
+		return 0, err
+	}
+	defer itf.Release()
+	parent := (*IPropertyValue)(unsafe.Pointer(itf))
+	return parent.GetInt16()
+}
+
+// GetUInt16 was introduced in Windows.Foundation.FoundationContract v1.0.
+func (v *IReference) GetUInt16() (uint16, error) {
+	itf, err := winrt.QueryInterface(&v.IUnknown, &IIDIPropertyValue)
+	if err != nil {
+		return 0, err
+	}
+	defer itf.Release()
+	parent := (*IPropertyValue)(unsafe.Pointer(itf))
+	return parent.GetUInt16()
+}
+
+// GetInt32 was introduced in Windows.Foundation.FoundationContract v1.0.
+func (v *IReference) GetInt32() (int32, error) {
+	itf, err := winrt.QueryInterface(&v.IUnknown, &IIDIPropertyValue)
+	if err != nil {
+		return 0, err
+	}
+	defer itf.Release()
+	parent := (*IPropertyValue)(unsafe.Pointer(itf))
+	return parent.GetInt32()
+}
+
+// GetUInt32 was introduced in Windows.Foundation.FoundationContract v1.0.
+func (v *IReference) GetUInt32() (uint32, error) {
+	itf, err := winrt.QueryInterface(&v.IUnknown, &IIDIPropertyValue)
+	if err != nil {
+		return 0, err
+	}
+	defer itf.Release()
+	parent := (*IPropertyValue)(unsafe.Pointer(itf))
+	return parent.GetUInt32()
+}
+
+// GetInt64 was introduced in Windows.Foundation.FoundationContract v1.0.
+func (v *IReference) GetInt64() (int64, error) {
+	itf, err := winrt.QueryInterface(&v.IUnknown, &IIDIPropertyValue)
+	if err != nil {
+		return 0, err
+	}
+	defer itf.Release()
+	parent := (*IPropertyValue)(unsafe.Pointer(itf))
+	return parent.GetInt64()
+}
+
+// GetUInt64 was introduced in Windows.Foundation.FoundationContract v1.0.
+func (v *IReference) GetUInt64() (uint64, error) {
+	itf, err := winrt.QueryInterface(&v.IUnknown, &IIDIPropertyValue)
+	if err != nil {
+		return 0, err
+	}
+	defer itf.Release()
+	parent := (*IPropertyValue)(unsafe.Pointer(itf))
+	return parent.GetUInt64()
+}
+
+// GetSingle was introduced in Windows.Foundation.FoundationContract v1.0.
+func (v *IReference) GetSingle() (float32, error) {
+	itf, err := winrt.QueryInterface(&v.IUnknown, &IIDIPropertyValue)
+	if err != nil {
+		return 0.0, err
+	}
+	defer itf.Release()
+	parent := (*IPropertyValue)(unsafe.Pointer(itf))
+	return parent.GetSingle()
+}
+
+// GetDouble was introduced in Windows.Foundation.FoundationContract v1.0.
+func (v *IReference) GetDouble() (float64, error) {
+	itf, err := winrt.QueryInterface(&v.IUnknown, &IIDIPropertyValue)
+	if err != nil {
+		return 0.0, err
+	}
+	defer itf.Release()
+	parent := (*IPropertyValue)(unsafe.Pointer(itf))
+	return parent.GetDouble()
+}
+
+// GetChar16 was introduced in Windows.Foundation.FoundationContract v1.0.
+func (v *IReference) GetChar16() (uint16, error) {
+	itf, err := winrt.QueryInterface(&v.IUnknown, &IIDIPropertyValue)
+	if err != nil {
+		return 0, err
+	}
+	defer itf.Release()
+	parent := (*IPropertyValue)(unsafe.Pointer(itf))
+	return parent.GetChar16()
+}
+
+// GetBoolean was introduced in Windows.Foundation.FoundationContract v1.0.
+func (v *IReference) GetBoolean() (bool, error) {
+	itf, err := winrt.QueryInterface(&v.IUnknown, &IIDIPropertyValue)
+	if err != nil {
+		return false, err
+	}
+	defer itf.Release()
+	parent := (*IPropertyValue)(unsafe.Pointer(itf))
+	return parent.GetBoolean()
+}
+
+// GetString was introduced in Windows.Foundation.FoundationContract v1.0.
+func (v *IReference) GetString() (string, error) {
+	itf, err := winrt.QueryInterface(&v.IUnknown, &IIDIPropertyValue)
+	if err != nil {
+		return "", err
+	}
+	defer itf.Release()
+	parent := (*IPropertyValue)(unsafe.Pointer(itf))
+	return parent.GetString()
+}
+
+// GetGuid was introduced in Windows.Foundation.FoundationContract v1.0.
+func (v *IReference) GetGuid() (syscall.GUID, error) {
+	itf, err := winrt.QueryInterface(&v.IUnknown, &IIDIPropertyValue)
+	if err != nil {
+		return syscall.GUID{}, err
+	}
+	defer itf.Release()
+	parent := (*IPropertyValue)(unsafe.Pointer(itf))
+	return parent.GetGuid()
+}
+
+// GetDateTime was introduced in Windows.Foundation.FoundationContract v1.0.
+func (v *IReference) GetDateTime() (DateTime, error) {
+	itf, err := winrt.QueryInterface(&v.IUnknown, &IIDIPropertyValue)
+	if err != nil {
+		return DateTime{}, err
+	}
+	defer itf.Release()
+	parent := (*IPropertyValue)(unsafe.Pointer(itf))
+	return parent.GetDateTime()
+}
+
+// GetTimeSpan was introduced in Windows.Foundation.FoundationContract v1.0.
+func (v *IReference) GetTimeSpan() (TimeSpan, error) {
+	itf, err := winrt.QueryInterface(&v.IUnknown, &IIDIPropertyValue)
+	if err != nil {
+		return TimeSpan{}, err
+	}
+	defer itf.Release()
+	parent := (*IPropertyValue)(unsafe.Pointer(itf))
+	return parent.GetTimeSpan()
+}
+
+// GetPoint was introduced in Windows.Foundation.FoundationContract v1.0.
+func (v *IReference) GetPoint() (Point, error) {
+	itf, err := winrt.QueryInterface(&v.IUnknown, &IIDIPropertyValue)
+	if err != nil {
+		return Point{}, err
+	}
+	defer itf.Release()
+	parent := (*IPropertyValue)(unsafe.Pointer(itf))
+	return parent.GetPoint()
+}
+
+// GetSize was introduced in Windows.Foundation.FoundationContract v1.0.
+func (v *IReference) GetSize() (Size, error) {
+	itf, err := winrt.QueryInterface(&v.IUnknown, &IIDIPropertyValue)
+	if err != nil {
+		return Size{}, err
+	}
+	defer itf.Release()
+	parent := (*IPropertyValue)(unsafe.Pointer(itf))
+	return parent.GetSize()
+}
+
+// GetRect was introduced in Windows.Foundation.FoundationContract v1.0.
+func (v *IReference) GetRect() (Rect, error) {
+	itf, err := winrt.QueryInterface(&v.IUnknown, &IIDIPropertyValue)
+	if err != nil {
+		return Rect{}, err
+	}
+	defer itf.Release()
+	parent := (*IPropertyValue)(unsafe.Pointer(itf))
+	return parent.GetRect()
+}
+
+// GetUInt8Array was introduced in Windows.Foundation.FoundationContract v1.0.
+func (v *IReference) GetUInt8Array() ([]uint8, error) {
+	itf, err := winrt.QueryInterface(&v.IUnknown, &IIDIPropertyValue)
+	if err != nil {
+		return nil, err
+	}
+	defer itf.Release()
+	parent := (*IPropertyValue)(unsafe.Pointer(itf))
+	return parent.GetUInt8Array()
+}
+
+// GetInt16Array was introduced in Windows.Foundation.FoundationContract v1.0.
+func (v *IReference) GetInt16Array() ([]int16, error) {
+	itf, err := winrt.QueryInterface(&v.IUnknown, &IIDIPropertyValue)
+	if err != nil {
+		return nil, err
+	}
+	defer itf.Release()
+	parent := (*IPropertyValue)(unsafe.Pointer(itf))
+	return parent.GetInt16Array()
+}
+
+// GetUInt16Array was introduced in Windows.Foundation.FoundationContract v1.0.
+func (v *IReference) GetUInt16Array() ([]uint16, error) {
+	itf, err := winrt.QueryInterface(&v.IUnknown, &IIDIPropertyValue)
+	if err != nil {
+		return nil, err
+	}
+	defer itf.Release()
+	parent := (*IPropertyValue)(unsafe.Pointer(itf))
+	return parent.GetUInt16Array()
+}
+
+// GetInt32Array was introduced in Windows.Foundation.FoundationContract v1.0.
+func (v *IReference) GetInt32Array() ([]int32, error) {
+	itf, err := winrt.QueryInterface(&v.IUnknown, &IIDIPropertyValue)
+	if err != nil {
+		return nil, err
+	}
+	defer itf.Release()
+	parent := (*IPropertyValue)(unsafe.Pointer(itf))
+	return parent.GetInt32Array()
+}
+
+// GetUInt32Array was introduced in Windows.Foundation.FoundationContract v1.0.
+func (v *IReference) GetUInt32Array() ([]uint32, error) {
+	itf, err := winrt.QueryInterface(&v.IUnknown, &IIDIPropertyValue)
+	if err != nil {
+		return nil, err
+	}
+	defer itf.Release()
+	parent := (*IPropertyValue)(unsafe.Pointer(itf))
+	return parent.GetUInt32Array()
+}
+
+// GetInt64Array was introduced in Windows.Foundation.FoundationContract v1.0.
+func (v *IReference) GetInt64Array() ([]int64, error) {
+	itf, err := winrt.QueryInterface(&v.IUnknown, &IIDIPropertyValue)
+	if err != nil {
+		return nil, err
+	}
+	defer itf.Release()
+	parent := (*IPropertyValue)(unsafe.Pointer(itf))
+	return parent.GetInt64Array()
+}
+
+// GetUInt64Array was introduced in Windows.Foundation.FoundationContract v1.0.
+func (v *IReference) GetUInt64Array() ([]uint64, error) {
+	itf, err := winrt.QueryInterface(&v.IUnknown, &IIDIPropertyValue)
+	if err != nil {
+		return nil, err
+	}
+	defer itf.Release()
+	parent := (*IPropertyValue)(unsafe.Pointer(itf))
+	return parent.GetUInt64Array()
+}
+
+// GetSingleArray was introduced in Windows.Foundation.FoundationContract v1.0.
+func (v *IReference) GetSingleArray() ([]float32, error) {
+	itf, err := winrt.QueryInterface(&v.IUnknown, &IIDIPropertyValue)
+	if err != nil {
+		return nil, err
+	}
+	defer itf.Release()
+	parent := (*IPropertyValue)(unsafe.Pointer(itf))
+	return parent.GetSingleArray()
+}
+
+// GetDoubleArray was introduced in Windows.Foundation.FoundationContract v1.0.
+func (v *IReference) GetDoubleArray() ([]float64, error) {
+	itf, err := winrt.QueryInterface(&v.IUnknown, &IIDIPropertyValue)
+	if err != nil {
+		return nil, err
+	}
+	defer itf.Release()
+	parent := (*IPropertyValue)(unsafe.Pointer(itf))
+	return parent.GetDoubleArray()
+}
+
+// GetChar16Array was introduced in Windows.Foundation.FoundationContract v1.0.
+func (v *IReference) GetChar16Array() ([]uint16, error) {
+	itf, err := winrt.QueryInterface(&v.IUnknown, &IIDIPropertyValue)
+	if err != nil {
+		return nil, err
+	}
+	defer itf.Release()
+	parent := (*IPropertyValue)(unsafe.Pointer(itf))
+	return parent.GetChar16Array()
+}
+
+// GetBooleanArray was introduced in Windows.Foundation.FoundationContract v1.0.
+func (v *IReference) GetBooleanArray() ([]bool, error) {
+	itf, err := winrt.QueryInterface(&v.IUnknown, &IIDIPropertyValue)
+	if err != nil {
+		return nil, err
+	}
+	defer itf.Release()
+	parent := (*IPropertyValue)(unsafe.Pointer(itf))
+	return parent.GetBooleanArray()
+}
+
+// GetStringArray was introduced in Windows.Foundation.FoundationContract v1.0.
+func (v *IReference) GetStringArray() ([]string, error) {
+	itf, err := winrt.QueryInterface(&v.IUnknown, &IIDIPropertyValue)
+	if err != nil {
+		return nil, err
+	}
+	defer itf.Release()
+	parent := (*IPropertyValue)(unsafe.Pointer(itf))
+	return parent.GetStringArray()
+}
+
+// GetInspectableArray was introduced in Windows.Foundation.FoundationContract v1.0.
+func (v *IReference) GetInspectableArray() ([]unsafe.Pointer, error) {
+	itf, err := winrt.QueryInterface(&v.IUnknown, &IIDIPropertyValue)
+	if err != nil {
+		return nil, err
+	}
+	defer itf.Release()
+	parent := (*IPropertyValue)(unsafe.Pointer(itf))
+	return parent.GetInspectableArray()
+}
+
+// GetGuidArray was introduced in Windows.Foundation.FoundationContract v1.0.
+func (v *IReference) GetGuidArray() ([]syscall.GUID, error) {
+	itf, err := winrt.QueryInterface(&v.IUnknown, &IIDIPropertyValue)
+	if err != nil {
+		return nil, err
+	}
+	defer itf.Release()
+	parent := (*IPropertyValue)(unsafe.Pointer(itf))
+	return parent.GetGuidArray()
+}
+
+// GetDateTimeArray was introduced in Windows.Foundation.FoundationContract v1.0.
+func (v *IReference) GetDateTimeArray() ([]DateTime, error) {
+	itf, err := winrt.QueryInterface(&v.IUnknown, &IIDIPropertyValue)
+	if err != nil {
+		return nil, err
+	}
+	defer itf.Release()
+	parent := (*IPropertyValue)(unsafe.Pointer(itf))
+	return parent.GetDateTimeArray()
+}
+
+// GetTimeSpanArray was introduced in Windows.Foundation.FoundationContract v1.0.
+func (v *IReference) GetTimeSpanArray() ([]TimeSpan, error) {
+	itf, err := winrt.QueryInterface(&v.IUnknown, &IIDIPropertyValue)
+	if err != nil {
+		return nil, err
+	}
+	defer itf.Release()
+	parent := (*IPropertyValue)(unsafe.Pointer(itf))
+	return parent.GetTimeSpanArray()
+}
+
+// GetPointArray was introduced in Windows.Foundation.FoundationContract v1.0.
+func (v *IReference) GetPointArray() ([]Point, error) {
+	itf, err := winrt.QueryInterface(&v.IUnknown, &IIDIPropertyValue)
+	if err != nil {
+		return nil, err
+	}
+	defer itf.Release()
+	parent := (*IPropertyValue)(unsafe.Pointer(itf))
+	return parent.GetPointArray()
+}
+
+// GetSizeArray was introduced in Windows.Foundation.FoundationContract v1.0.
+func (v *IReference) GetSizeArray() ([]Size, error) {
+	itf, err := winrt.QueryInterface(&v.IUnknown, &IIDIPropertyValue)
+	if err != nil {
+		return nil, err
+	}
+	defer itf.Release()
+	parent := (*IPropertyValue)(unsafe.Pointer(itf))
+	return parent.GetSizeArray()
+}
+
+// GetRectArray was introduced in Windows.Foundation.FoundationContract v1.0.
+func (v *IReference) GetRectArray() ([]Rect, error) {
+	itf, err := winrt.QueryInterface(&v.IUnknown, &IIDIPropertyValue)
+	if err != nil {
+		return nil, err
+	}
+	defer itf.Release()
+	parent := (*IPropertyValue)(unsafe.Pointer(itf))
+	return parent.GetRectArray()
+}
+
+// IReferenceOf is a typed wrapper of IReference, instantiated with the type arguments T.
+type IReferenceOf[T any] struct {
+	*IReference
+	signatures []string
+}
+
+// NewIReferenceOf wraps the given IReference. The signatures of the type arguments are
+// required to compute the IID of the instantiated interface. It panics if a type argument
+// cannot be passed to the vtable calls, see winrt.CheckTypeArg.
+func NewIReferenceOf[T any](v *IReference, signatureT string) *IReferenceOf[T] {
+	winrt.CheckTypeArg[T]()
+	return &IReferenceOf[T]{
+		IReference: v,
+		signatures: []string{signatureT},
+	}
+}
+
+// Signature returns the signature of the instantiated interface.
+func (w *IReferenceOf[T]) Signature() string {
+	return winrt.ParameterizedInstanceSignature(GUIDIReference, w.signatures...)
+}
+
+// Signatures returns the signatures of the type arguments.
+func (w *IReferenceOf[T]) Signatures() []string {
+	return w.signatures
+}
+
+// IID returns the IID of the instantiated interface.
+func (w *IReferenceOf[T]) IID() *ole.GUID {
+	return ole.NewGUID(winrt.ParameterizedInstanceGUID(GUIDIReference, w.signatures...))
+}
+
+func (w *IReferenceOf[T]) GetValue() (T, error) {
+	v := w.IReference
+	var out T
+	hr, _, _ := syscall.Syscall(
+		v.VTable().GetValue,
+		2,                             // nargs
+		uintptr(unsafe.Pointer(v)),    // this
+		uintptr(unsafe.Pointer(&out)), // out T
+		0,
+	)
+
+	if hr != 0 {
+		return *new(T), ole.NewError(hr)
+	}
+
+	winrt.TypeArgResult(&out)
+	return out, nil
+}
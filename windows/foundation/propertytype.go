@@ -0,0 +1,176 @@
+// Code generated by winrt-go-gen. DO NOT EDIT.
+
+//go:build windows
+
+//nolint:all
+package foundation
+
+import "fmt"
+
+type PropertyType int32
+
+const SignaturePropertyType string = "enum(Windows.Foundation.PropertyType;i4)"
+
+const (
+	PropertyTypeEmpty            PropertyType = 0
+	PropertyTypeUInt8            PropertyType = 1
+	PropertyTypeInt16            PropertyType = 2
+	PropertyTypeUInt16           PropertyType = 3
+	PropertyTypeInt32            PropertyType = 4
+	PropertyTypeUInt32           PropertyType = 5
+	PropertyTypeInt64            PropertyType = 6
+	PropertyTypeUInt64           PropertyType = 7
+	PropertyTypeSingle           PropertyType = 8
+	PropertyTypeDouble           PropertyType = 9
+	PropertyTypeChar16           PropertyType = 10
+	PropertyTypeBoolean          PropertyType = 11
+	PropertyTypeString           PropertyType = 12
+	PropertyTypeInspectable      PropertyType = 13
+	PropertyTypeDateTime         PropertyType = 14
+	PropertyTypeTimeSpan         PropertyType = 15
+	PropertyTypeGuid             PropertyType = 16
+	PropertyTypePoint            PropertyType = 17
+	PropertyTypeSize             PropertyType = 18
+	PropertyTypeRect             PropertyType = 19
+	PropertyTypeOtherType        PropertyType = 20
+	PropertyTypeUInt8Array       PropertyType = 1025
+	PropertyTypeInt16Array       PropertyType = 1026
+	PropertyTypeUInt16Array      PropertyType = 1027
+	PropertyTypeInt32Array       PropertyType = 1028
+	PropertyTypeUInt32Array      PropertyType = 1029
+	PropertyTypeInt64Array       PropertyType = 1030
+	PropertyTypeUInt64Array      PropertyType = 1031
+	PropertyTypeSingleArray      PropertyType = 1032
+	PropertyTypeDoubleArray      PropertyType = 1033
+	PropertyTypeChar16Array      PropertyType = 1034
+	PropertyTypeBooleanArray     PropertyType = 1035
+	PropertyTypeStringArray      PropertyType = 1036
+	PropertyTypeInspectableArray PropertyType = 1037
+	PropertyTypeDateTimeArray    PropertyType = 1038
+	PropertyTypeTimeSpanArray    PropertyType = 1039
+	PropertyTypeGuidArray        PropertyType = 1040
+	PropertyTypePointArray       PropertyType = 1041
+	PropertyTypeSizeArray        PropertyType = 1042
+	PropertyTypeRectArray        PropertyType = 1043
+	PropertyTypeOtherTypeArray   PropertyType = 1044
+)
+
+var valuesPropertyType = []PropertyType{
+	PropertyTypeEmpty,
+	PropertyTypeUInt8,
+	PropertyTypeInt16,
+	PropertyTypeUInt16,
+	PropertyTypeInt32,
+	PropertyTypeUInt32,
+	PropertyTypeInt64,
+	PropertyTypeUInt64,
+	PropertyTypeSingle,
+	PropertyTypeDouble,
+	PropertyTypeChar16,
+	PropertyTypeBoolean,
+	PropertyTypeString,
+	PropertyTypeInspectable,
+	PropertyTypeDateTime,
+	PropertyTypeTimeSpan,
+	PropertyTypeGuid,
+	PropertyTypePoint,
+	PropertyTypeSize,
+	PropertyTypeRect,
+	PropertyTypeOtherType,
+	PropertyTypeUInt8Array,
+	PropertyTypeInt16Array,
+	PropertyTypeUInt16Array,
+	PropertyTypeInt32Array,
+	PropertyTypeUInt32Array,
+	PropertyTypeInt64Array,
+	PropertyTypeUInt64Array,
+	PropertyTypeSingleArray,
+	PropertyTypeDoubleArray,
+	PropertyTypeChar16Array,
+	PropertyTypeBooleanArray,
+	PropertyTypeStringArray,
+	PropertyTypeInspectableArray,
+	PropertyTypeDateTimeArray,
+	PropertyTypeTimeSpanArray,
+	PropertyTypeGuidArray,
+	PropertyTypePointArray,
+	PropertyTypeSizeArray,
+	PropertyTypeRectArray,
+	PropertyTypeOtherTypeArray,
+}
+
+var namesPropertyType = []string{
+	"Empty",
+	"UInt8",
+	"Int16",
+	"UInt16",
+	"Int32",
+	"UInt32",
+	"Int64",
+	"UInt64",
+	"Single",
+	"Double",
+	"Char16",
+	"Boolean",
+	"String",
+	"Inspectable",
+	"DateTime",
+	"TimeSpan",
+	"Guid",
+	"Point",
+	"Size",
+	"Rect",
+	"OtherType",
+	"UInt8Array",
+	"Int16Array",
+	"UInt16Array",
+	"Int32Array",
+	"UInt32Array",
+	"Int64Array",
+	"UInt64Array",
+	"SingleArray",
+	"DoubleArray",
+	"Char16Array",
+	"BooleanArray",
+	"StringArray",
+	"InspectableArray",
+	"DateTimeArray",
+	"TimeSpanArray",
+	"GuidArray",
+	"PointArray",
+	"SizeArray",
+	"RectArray",
+	"OtherTypeArray",
+}
+
+// ValuesPropertyType returns all the values defined by PropertyType.
+func ValuesPropertyType() []PropertyType {
+	return append([]PropertyType(nil), valuesPropertyType...)
+}
+
+// String returns the name of the value.
+func (v PropertyType) String() string {
+	for i, value := range valuesPropertyType {
+		if value == v {
+			return namesPropertyType[i]
+		}
+	}
+	return fmt.Sprintf("PropertyType(%d)", int32(v))
+}
+
+// ParsePropertyType returns the value with the given name.
+func ParsePropertyType(s string) (PropertyType, error) {
+	if v, ok := lookupPropertyType(s); ok {
+		return v, nil
+	}
+	return 0, fmt.Errorf("invalid PropertyType %q", s)
+}
+
+func lookupPropertyType(name string) (PropertyType, bool) {
+	for i, n := range namesPropertyType {
+		if n == name {
+			return valuesPropertyType[i], true
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,1077 @@
+// Code generated by winrt-go-gen. DO NOT EDIT.
+
+//go:build windows
+
+//nolint:all
+package foundation
+
+import (
+	"syscall"
+	"unsafe"
+
+	"github.com/go-ole/go-ole"
+	"github.com/waylyrics/winrt-go"
+	"github.com/waylyrics/winrt-go/hstring"
+)
+
+const GUIDiPropertyValueStatics string = "629bdbc8-d932-4ff4-96b9-8d96c5c1e858"
+const SignatureiPropertyValueStatics string = "{629bdbc8-d932-4ff4-96b9-8d96c5c1e858}"
+
+var IIDiPropertyValueStatics = ole.GUID{Data1: 0x629bdbc8, Data2: 0xd932, Data3: 0x4ff4, Data4: [8]byte{0x96, 0xb9, 0x8d, 0x96, 0xc5, 0xc1, 0xe8, 0x58}}
+
+// iPropertyValueStatics was introduced in Windows.Foundation.FoundationContract v1.0.
+type iPropertyValueStatics struct {
+	ole.IInspectable
+}
+
+type iPropertyValueStaticsVtbl struct {
+	ole.IInspectableVtbl
+
+	PropertyValueCreateEmpty            uintptr
+	PropertyValueCreateUInt8            uintptr
+	PropertyValueCreateInt16            uintptr
+	PropertyValueCreateUInt16           uintptr
+	PropertyValueCreateInt32            uintptr
+	PropertyValueCreateUInt32           uintptr
+	PropertyValueCreateInt64            uintptr
+	PropertyValueCreateUInt64           uintptr
+	PropertyValueCreateSingle           uintptr
+	PropertyValueCreateDouble           uintptr
+	PropertyValueCreateChar16           uintptr
+	PropertyValueCreateBoolean          uintptr
+	PropertyValueCreateString           uintptr
+	PropertyValueCreateInspectable      uintptr
+	PropertyValueCreateGuid             uintptr
+	PropertyValueCreateDateTime         uintptr
+	PropertyValueCreateTimeSpan         uintptr
+	PropertyValueCreatePoint            uintptr
+	PropertyValueCreateSize             uintptr
+	PropertyValueCreateRect             uintptr
+	PropertyValueCreateUInt8Array       uintptr
+	PropertyValueCreateInt16Array       uintptr
+	PropertyValueCreateUInt16Array      uintptr
+	PropertyValueCreateInt32Array       uintptr
+	PropertyValueCreateUInt32Array      uintptr
+	PropertyValueCreateInt64Array       uintptr
+	PropertyValueCreateUInt64Array      uintptr
+	PropertyValueCreateSingleArray      uintptr
+	PropertyValueCreateDoubleArray      uintptr
+	PropertyValueCreateChar16Array      uintptr
+	PropertyValueCreateBooleanArray     uintptr
+	PropertyValueCreateStringArray      uintptr
+	PropertyValueCreateInspectableArray uintptr
+	PropertyValueCreateGuidArray        uintptr
+	PropertyValueCreateDateTimeArray    uintptr
+	PropertyValueCreateTimeSpanArray    uintptr
+	PropertyValueCreatePointArray       uintptr
+	PropertyValueCreateSizeArray        uintptr
+	PropertyValueCreateRectArray        uintptr
+}
+
+func (v *iPropertyValueStatics) VTable() *iPropertyValueStaticsVtbl {
+	return (*iPropertyValueStaticsVtbl)(unsafe.Pointer(v.RawVTable))
+}
+
+// PropertyValueCreateEmpty was introduced in Windows.Foundation.FoundationContract v1.0.
+func PropertyValueCreateEmpty() (unsafe.Pointer, error) {
+	factory, err := winrt.GetActivationFactory("Windows.Foundation.PropertyValue", &IIDiPropertyValueStatics)
+	if err != nil {
+		return nil, err
+	}
+	v := (*iPropertyValueStatics)(unsafe.Pointer(factory))
+
+	var out unsafe.Pointer
+	hr, _, _ := syscall.Syscall(
+		v.VTable().PropertyValueCreateEmpty,
+		2,                             // nargs
+		uintptr(unsafe.Pointer(v)),    // this
+		uintptr(unsafe.Pointer(&out)), // out unsafe.Pointer
+		0,
+	)
+
+	if hr != 0 {
+		return nil, ole.NewError(hr)
+	}
+
+	return out, nil
+}
+
+// PropertyValueCreateUInt8 was introduced in Windows.Foundation.FoundationContract v1.0.
+func PropertyValueCreateUInt8(value uint8) (unsafe.Pointer, error) {
+	factory, err := winrt.GetActivationFactory("Windows.Foundation.PropertyValue", &IIDiPropertyValueStatics)
+	if err != nil {
+		return nil, err
+	}
+	v := (*iPropertyValueStatics)(unsafe.Pointer(factory))
+
+	var out unsafe.Pointer
+	hr, _, _ := syscall.Syscall(
+		v.VTable().PropertyValueCreateUInt8,
+		3,                             // nargs
+		uintptr(unsafe.Pointer(v)),    // this
+		uintptr(value),                // in uint8
+		uintptr(unsafe.Pointer(&out)), // out unsafe.Pointer
+	)
+
+	if hr != 0 {
+		return nil, ole.NewError(hr)
+	}
+
+	return out, nil
+}
+
+// PropertyValueCreateInt16 was introduced in Windows.Foundation.FoundationContract v1.0.
+func PropertyValueCreateInt16(value int16) (unsafe.Pointer, error) {
+	factory, err := winrt.GetActivationFactory("Windows.Foundation.PropertyValue", &IIDiPropertyValueStatics)
+	if err != nil {
+		return nil, err
+	}
+	v := (*iPropertyValueStatics)(unsafe.Pointer(factory))
+
+	var out unsafe.Pointer
+	hr, _, _ := syscall.Syscall(
+		v.VTable().PropertyValueCreateInt16,
+		3,                             // nargs
+		uintptr(unsafe.Pointer(v)),    // this
+		uintptr(value),                // in int16
+		uintptr(unsafe.Pointer(&out)), // out unsafe.Pointer
+	)
+
+	if hr != 0 {
+		return nil, ole.NewError(hr)
+	}
+
+	return out, nil
+}
+
+// PropertyValueCreateUInt16 was introduced in Windows.Foundation.FoundationContract v1.0.
+func PropertyValueCreateUInt16(value uint16) (unsafe.Pointer, error) {
+	factory, err := winrt.GetActivationFactory("Windows.Foundation.PropertyValue", &IIDiPropertyValueStatics)
+	if err != nil {
+		return nil, err
+	}
+	v := (*iPropertyValueStatics)(unsafe.Pointer(factory))
+
+	var out unsafe.Pointer
+	hr, _, _ := syscall.Syscall(
+		v.VTable().PropertyValueCreateUInt16,
+		3,                             // nargs
+		uintptr(unsafe.Pointer(v)),    // this
+		uintptr(value),                // in uint16
+		uintptr(unsafe.Pointer(&out)), // out unsafe.Pointer
+	)
+
+	if hr != 0 {
+		return nil, ole.NewError(hr)
+	}
+
+	return out, nil
+}
+
+// PropertyValueCreateInt32 was introduced in Windows.Foundation.FoundationContract v1.0.
+func PropertyValueCreateInt32(value int32) (unsafe.Pointer, error) {
+	factory, err := winrt.GetActivationFactory("Windows.Foundation.PropertyValue", &IIDiPropertyValueStatics)
+	if err != nil {
+		return nil, err
+	}
+	v := (*iPropertyValueStatics)(unsafe.Pointer(factory))
+
+	var out unsafe.Pointer
+	hr, _, _ := syscall.Syscall(
+		v.VTable().PropertyValueCreateInt32,
+		3,                             // nargs
+		uintptr(unsafe.Pointer(v)),    // this
+		uintptr(value),                // in int32
+		uintptr(unsafe.Pointer(&out)), // out unsafe.Pointer
+	)
+
+	if hr != 0 {
+		return nil, ole.NewError(hr)
+	}
+
+	return out, nil
+}
+
+// PropertyValueCreateUInt32 was introduced in Windows.Foundation.FoundationContract v1.0.
+func PropertyValueCreateUInt32(value uint32) (unsafe.Pointer, error) {
+	factory, err := winrt.GetActivationFactory("Windows.Foundation.PropertyValue", &IIDiPropertyValueStatics)
+	if err != nil {
+		return nil, err
+	}
+	v := (*iPropertyValueStatics)(unsafe.Pointer(factory))
+
+	var out unsafe.Pointer
+	hr, _, _ := syscall.Syscall(
+		v.VTable().PropertyValueCreateUInt32,
+		3,                             // nargs
+		uintptr(unsafe.Pointer(v)),    // this
+		uintptr(value),                // in uint32
+		uintptr(unsafe.Pointer(&out)), // out unsafe.Pointer
+	)
+
+	if hr != 0 {
+		return nil, ole.NewError(hr)
+	}
+
+	return out, nil
+}
+
+// PropertyValueCreateInt64 was introduced in Windows.Foundation.FoundationContract v1.0.
+func PropertyValueCreateInt64(value int64) (unsafe.Pointer, error) {
+	factory, err := winrt.GetActivationFactory("Windows.Foundation.PropertyValue", &IIDiPropertyValueStatics)
+	if err != nil {
+		return nil, err
+	}
+	v := (*iPropertyValueStatics)(unsafe.Pointer(factory))
+
+	var out unsafe.Pointer
+	hr, _, _ := syscall.Syscall(
+		v.VTable().PropertyValueCreateInt64,
+		3,                             // nargs
+		uintptr(unsafe.Pointer(v)),    // this
+		uintptr(value),                // in int64
+		uintptr(unsafe.Pointer(&out)), // out unsafe.Pointer
+	)
+
+	if hr != 0 {
+		return nil, ole.NewError(hr)
+	}
+
+	return out, nil
+}
+
+// PropertyValueCreateUInt64 was introduced in Windows.Foundation.FoundationContract v1.0.
+func PropertyValueCreateUInt64(value uint64) (unsafe.Pointer, error) {
+	factory, err := winrt.GetActivationFactory("Windows.Foundation.PropertyValue", &IIDiPropertyValueStatics)
+	if err != nil {
+		return nil, err
+	}
+	v := (*iPropertyValueStatics)(unsafe.Pointer(factory))
+
+	var out unsafe.Pointer
+	hr, _, _ := syscall.Syscall(
+		v.VTable().PropertyValueCreateUInt64,
+		3,                             // nargs
+		uintptr(unsafe.Pointer(v)),    // this
+		uintptr(value),                // in uint64
+		uintptr(unsafe.Pointer(&out)), // out unsafe.Pointer
+	)
+
+	if hr != 0 {
+		return nil, ole.NewError(hr)
+	}
+
+	return out, nil
+}
+
+// PropertyValueCreateSingle was introduced in Windows.Foundation.FoundationContract v1.0.
+func PropertyValueCreateSingle(value float32) (unsafe.Pointer, error) {
+	factory, err := winrt.GetActivationFactory("Windows.Foundation.PropertyValue", &IIDiPropertyValueStatics)
+	if err != nil {
+		return nil, err
+	}
+	v := (*iPropertyValueStatics)(unsafe.Pointer(factory))
+
+	var out unsafe.Pointer
+	hr, _, _ := syscall.Syscall(
+		v.VTable().PropertyValueCreateSingle,
+		3,                             // nargs
+		uintptr(unsafe.Pointer(v)),    // this
+		winrt.ABIValue(&value),        // in float32
+		uintptr(unsafe.Pointer(&out)), // out unsafe.Pointer
+	)
+
+	if hr != 0 {
+		return nil, ole.NewError(hr)
+	}
+
+	return out, nil
+}
+
+// PropertyValueCreateDouble was introduced in Windows.Foundation.FoundationContract v1.0.
+func PropertyValueCreateDouble(value float64) (unsafe.Pointer, error) {
+	factory, err := winrt.GetActivationFactory("Windows.Foundation.PropertyValue", &IIDiPropertyValueStatics)
+	if err != nil {
+		return nil, err
+	}
+	v := (*iPropertyValueStatics)(unsafe.Pointer(factory))
+
+	var out unsafe.Pointer
+	hr, _, _ := syscall.Syscall(
+		v.VTable().PropertyValueCreateDouble,
+		3,                             // nargs
+		uintptr(unsafe.Pointer(v)),    // this
+		winrt.ABIValue(&value),        // in float64
+		uintptr(unsafe.Pointer(&out)), // out unsafe.Pointer
+	)
+
+	if hr != 0 {
+		return nil, ole.NewError(hr)
+	}
+
+	return out, nil
+}
+
+// PropertyValueCreateChar16 was introduced in Windows.Foundation.FoundationContract v1.0.
+func PropertyValueCreateChar16(value uint16) (unsafe.Pointer, error) {
+	factory, err := winrt.GetActivationFactory("Windows.Foundation.PropertyValue", &IIDiPropertyValueStatics)
+	if err != nil {
+		return nil, err
+	}
+	v := (*iPropertyValueStatics)(unsafe.Pointer(factory))
+
+	var out unsafe.Pointer
+	hr, _, _ := syscall.Syscall(
+		v.VTable().PropertyValueCreateChar16,
+		3,                             // nargs
+		uintptr(unsafe.Pointer(v)),    // this
+		uintptr(value),                // in uint16
+		uintptr(unsafe.Pointer(&out)), // out unsafe.Pointer
+	)
+
+	if hr != 0 {
+		return nil, ole.NewError(hr)
+	}
+
+	return out, nil
+}
+
+// PropertyValueCreateBoolean was introduced in Windows.Foundation.FoundationContract v1.0.
+func PropertyValueCreateBoolean(value bool) (unsafe.Pointer, error) {
+	factory, err := winrt.GetActivationFactory("Windows.Foundation.PropertyValue", &IIDiPropertyValueStatics)
+	if err != nil {
+		return nil, err
+	}
+	v := (*iPropertyValueStatics)(unsafe.Pointer(factory))
+
+	var out unsafe.Pointer
+	hr, _, _ := syscall.Syscall(
+		v.VTable().PropertyValueCreateBoolean,
+		3,                          // nargs
+		uintptr(unsafe.Pointer(v)), // this
+		uintptr(*(*byte)(unsafe.Pointer(&value))), // in bool
+		uintptr(unsafe.Pointer(&out)),             // out unsafe.Pointer
+	)
+
+	if hr != 0 {
+		return nil, ole.NewError(hr)
+	}
+
+	return out, nil
+}
+
+// PropertyValueCreateString was introduced in Windows.Foundation.FoundationContract v1.0.
+func PropertyValueCreateString(value string) (unsafe.Pointer, error) {
+	factory, err := winrt.GetActivationFactory("Windows.Foundation.PropertyValue", &IIDiPropertyValueStatics)
+	if err != nil {
+		return nil, err
+	}
+	v := (*iPropertyValueStatics)(unsafe.Pointer(factory))
+
+	var out unsafe.Pointer
+	valueHStr, err := hstring.NewReference(value)
+	if err != nil {
+		return nil, err
+	}
+	hr, _, _ := syscall.Syscall(
+		v.VTable().PropertyValueCreateString,
+		3,                             // nargs
+		uintptr(unsafe.Pointer(v)),    // this
+		uintptr(valueHStr.HString()),  // in string
+		uintptr(unsafe.Pointer(&out)), // out unsafe.Pointer
+	)
+
+	valueHStr.Release()
+
+	if hr != 0 {
+		return nil, ole.NewError(hr)
+	}
+
+	return out, nil
+}
+
+// PropertyValueCreateInspectable was introduced in Windows.Foundation.FoundationContract v1.0.
+func PropertyValueCreateInspectable(value unsafe.Pointer) (unsafe.Pointer, error) {
+	factory, err := winrt.GetActivationFactory("Windows.Foundation.PropertyValue", &IIDiPropertyValueStatics)
+	if err != nil {
+		return nil, err
+	}
+	v := (*iPropertyValueStatics)(unsafe.Pointer(factory))
+
+	var out unsafe.Pointer
+	hr, _, _ := syscall.Syscall(
+		v.VTable().PropertyValueCreateInspectable,
+		3,                             // nargs
+		uintptr(unsafe.Pointer(v)),    // this
+		winrt.ABIValue(&value),        // in unsafe.Pointer
+		uintptr(unsafe.Pointer(&out)), // out unsafe.Pointer
+	)
+
+	if hr != 0 {
+		return nil, ole.NewError(hr)
+	}
+
+	return out, nil
+}
+
+// PropertyValueCreateGuid was introduced in Windows.Foundation.FoundationContract v1.0.
+func PropertyValueCreateGuid(value syscall.GUID) (unsafe.Pointer, error) {
+	factory, err := winrt.GetActivationFactory("Windows.Foundation.PropertyValue", &IIDiPropertyValueStatics)
+	if err != nil {
+		return nil, err
+	}
+	v := (*iPropertyValueStatics)(unsafe.Pointer(factory))
+
+	var out unsafe.Pointer
+	hr, _, _ := syscall.Syscall(
+		v.VTable().PropertyValueCreateGuid,
+		3,                             // nargs
+		uintptr(unsafe.Pointer(v)),    // this
+		winrt.ABIValue(&value),        // in syscall.GUID
+		uintptr(unsafe.Pointer(&out)), // out unsafe.Pointer
+	)
+
+	if hr != 0 {
+		return nil, ole.NewError(hr)
+	}
+
+	return out, nil
+}
+
+// PropertyValueCreateDateTime was introduced in Windows.Foundation.FoundationContract v1.0.
+func PropertyValueCreateDateTime(value DateTime) (unsafe.Pointer, error) {
+	factory, err := winrt.GetActivationFactory("Windows.Foundation.PropertyValue", &IIDiPropertyValueStatics)
+	if err != nil {
+		return nil, err
+	}
+	v := (*iPropertyValueStatics)(unsafe.Pointer(factory))
+
+	var out unsafe.Pointer
+	hr, _, _ := syscall.Syscall(
+		v.VTable().PropertyValueCreateDateTime,
+		3,                             // nargs
+		uintptr(unsafe.Pointer(v)),    // this
+		winrt.ABIValue(&value),        // in DateTime
+		uintptr(unsafe.Pointer(&out)), // out unsafe.Pointer
+	)
+
+	if hr != 0 {
+		return nil, ole.NewError(hr)
+	}
+
+	return out, nil
+}
+
+// PropertyValueCreateTimeSpan was introduced in Windows.Foundation.FoundationContract v1.0.
+func PropertyValueCreateTimeSpan(value TimeSpan) (unsafe.Pointer, error) {
+	factory, err := winrt.GetActivationFactory("Windows.Foundation.PropertyValue", &IIDiPropertyValueStatics)
+	if err != nil {
+		return nil, err
+	}
+	v := (*iPropertyValueStatics)(unsafe.Pointer(factory))
+
+	var out unsafe.Pointer
+	hr, _, _ := syscall.Syscall(
+		v.VTable().PropertyValueCreateTimeSpan,
+		3,                             // nargs
+		uintptr(unsafe.Pointer(v)),    // this
+		winrt.ABIValue(&value),        // in TimeSpan
+		uintptr(unsafe.Pointer(&out)), // out unsafe.Pointer
+	)
+
+	if hr != 0 {
+		return nil, ole.NewError(hr)
+	}
+
+	return out, nil
+}
+
+// PropertyValueCreatePoint was introduced in Windows.Foundation.FoundationContract v1.0.
+func PropertyValueCreatePoint(value Point) (unsafe.Pointer, error) {
+	factory, err := winrt.GetActivationFactory("Windows.Foundation.PropertyValue", &IIDiPropertyValueStatics)
+	if err != nil {
+		return nil, err
+	}
+	v := (*iPropertyValueStatics)(unsafe.Pointer(factory))
+
+	var out unsafe.Pointer
+	hr, _, _ := syscall.Syscall(
+		v.VTable().PropertyValueCreatePoint,
+		3,                             // nargs
+		uintptr(unsafe.Pointer(v)),    // this
+		winrt.ABIValue(&value),        // in Point
+		uintptr(unsafe.Pointer(&out)), // out unsafe.Pointer
+	)
+
+	if hr != 0 {
+		return nil, ole.NewError(hr)
+	}
+
+	return out, nil
+}
+
+// PropertyValueCreateSize was introduced in Windows.Foundation.FoundationContract v1.0.
+func PropertyValueCreateSize(value Size) (unsafe.Pointer, error) {
+	factory, err := winrt.GetActivationFactory("Windows.Foundation.PropertyValue", &IIDiPropertyValueStatics)
+	if err != nil {
+		return nil, err
+	}
+	v := (*iPropertyValueStatics)(unsafe.Pointer(factory))
+
+	var out unsafe.Pointer
+	hr, _, _ := syscall.Syscall(
+		v.VTable().PropertyValueCreateSize,
+		3,                             // nargs
+		uintptr(unsafe.Pointer(v)),    // this
+		winrt.ABIValue(&value),        // in Size
+		uintptr(unsafe.Pointer(&out)), // out unsafe.Pointer
+	)
+
+	if hr != 0 {
+		return nil, ole.NewError(hr)
+	}
+
+	return out, nil
+}
+
+// PropertyValueCreateRect was introduced in Windows.Foundation.FoundationContract v1.0.
+func PropertyValueCreateRect(value Rect) (unsafe.Pointer, error) {
+	factory, err := winrt.GetActivationFactory("Windows.Foundation.PropertyValue", &IIDiPropertyValueStatics)
+	if err != nil {
+		return nil, err
+	}
+	v := (*iPropertyValueStatics)(unsafe.Pointer(factory))
+
+	var out unsafe.Pointer
+	hr, _, _ := syscall.Syscall(
+		v.VTable().PropertyValueCreateRect,
+		3,                             // nargs
+		uintptr(unsafe.Pointer(v)),    // this
+		winrt.ABIValue(&value),        // in Rect
+		uintptr(unsafe.Pointer(&out)), // out unsafe.Pointer
+	)
+
+	if hr != 0 {
+		return nil, ole.NewError(hr)
+	}
+
+	return out, nil
+}
+
+// PropertyValueCreateUInt8Array was introduced in Windows.Foundation.FoundationContract v1.0.
+func PropertyValueCreateUInt8Array(value []uint8) (unsafe.Pointer, error) {
+	factory, err := winrt.GetActivationFactory("Windows.Foundation.PropertyValue", &IIDiPropertyValueStatics)
+	if err != nil {
+		return nil, err
+	}
+	v := (*iPropertyValueStatics)(unsafe.Pointer(factory))
+
+	var out unsafe.Pointer
+	hr, _, _ := syscall.Syscall6(
+		v.VTable().PropertyValueCreateUInt8Array,
+		4,                             // nargs
+		uintptr(unsafe.Pointer(v)),    // this
+		uintptr(len(value)),           // in uint32
+		winrt.ArrayABI(value),         // in []uint8
+		uintptr(unsafe.Pointer(&out)), // out unsafe.Pointer
+		0,
+		0,
+	)
+
+	if hr != 0 {
+		return nil, ole.NewError(hr)
+	}
+
+	return out, nil
+}
+
+// PropertyValueCreateInt16Array was introduced in Windows.Foundation.FoundationContract v1.0.
+func PropertyValueCreateInt16Array(value []int16) (unsafe.Pointer, error) {
+	factory, err := winrt.GetActivationFactory("Windows.Foundation.PropertyValue", &IIDiPropertyValueStatics)
+	if err != nil {
+		return nil, err
+	}
+	v := (*iPropertyValueStatics)(unsafe.Pointer(factory))
+
+	var out unsafe.Pointer
+	hr, _, _ := syscall.Syscall6(
+		v.VTable().PropertyValueCreateInt16Array,
+		4,                             // nargs
+		uintptr(unsafe.Pointer(v)),    // this
+		uintptr(len(value)),           // in uint32
+		winrt.ArrayABI(value),         // in []int16
+		uintptr(unsafe.Pointer(&out)), // out unsafe.Pointer
+		0,
+		0,
+	)
+
+	if hr != 0 {
+		return nil, ole.NewError(hr)
+	}
+
+	return out, nil
+}
+
+// PropertyValueCreateUInt16Array was introduced in Windows.Foundation.FoundationContract v1.0.
+func PropertyValueCreateUInt16Array(value []uint16) (unsafe.Pointer, error) {
+	factory, err := winrt.GetActivationFactory("Windows.Foundation.PropertyValue", &IIDiPropertyValueStatics)
+	if err != nil {
+		return nil, err
+	}
+	v := (*iPropertyValueStatics)(unsafe.Pointer(factory))
+
+	var out unsafe.Pointer
+	hr, _, _ := syscall.Syscall6(
+		v.VTable().PropertyValueCreateUInt16Array,
+		4,                             // nargs
+		uintptr(unsafe.Pointer(v)),    // this
+		uintptr(len(value)),           // in uint32
+		winrt.ArrayABI(value),         // in []uint16
+		uintptr(unsafe.Pointer(&out)), // out unsafe.Pointer
+		0,
+		0,
+	)
+
+	if hr != 0 {
+		return nil, ole.NewError(hr)
+	}
+
+	return out, nil
+}
+
+// PropertyValueCreateInt32Array was introduced in Windows.Foundation.FoundationContract v1.0.
+func PropertyValueCreateInt32Array(value []int32) (unsafe.Pointer, error) {
+	factory, err := winrt.GetActivationFactory("Windows.Foundation.PropertyValue", &IIDiPropertyValueStatics)
+	if err != nil {
+		return nil, err
+	}
+	v := (*iPropertyValueStatics)(unsafe.Pointer(factory))
+
+	var out unsafe.Pointer
+	hr, _, _ := syscall.Syscall6(
+		v.VTable().PropertyValueCreateInt32Array,
+		4,                             // nargs
+		uintptr(unsafe.Pointer(v)),    // this
+		uintptr(len(value)),           // in uint32
+		winrt.ArrayABI(value),         // in []int32
+		uintptr(unsafe.Pointer(&out)), // out unsafe.Pointer
+		0,
+		0,
+	)
+
+	if hr != 0 {
+		return nil, ole.NewError(hr)
+	}
+
+	return out, nil
+}
+
+// PropertyValueCreateUInt32Array was introduced in Windows.Foundation.FoundationContract v1.0.
+func PropertyValueCreateUInt32Array(value []uint32) (unsafe.Pointer, error) {
+	factory, err := winrt.GetActivationFactory("Windows.Foundation.PropertyValue", &IIDiPropertyValueStatics)
+	if err != nil {
+		return nil, err
+	}
+	v := (*iPropertyValueStatics)(unsafe.Pointer(factory))
+
+	var out unsafe.Pointer
+	hr, _, _ := syscall.Syscall6(
+		v.VTable().PropertyValueCreateUInt32Array,
+		4,                             // nargs
+		uintptr(unsafe.Pointer(v)),    // this
+		uintptr(len(value)),           // in uint32
+		winrt.ArrayABI(value),         // in []uint32
+		uintptr(unsafe.Pointer(&out)), // out unsafe.Pointer
+		0,
+		0,
+	)
+
+	if hr != 0 {
+		return nil, ole.NewError(hr)
+	}
+
+	return out, nil
+}
+
+// PropertyValueCreateInt64Array was introduced in Windows.Foundation.FoundationContract v1.0.
+func PropertyValueCreateInt64Array(value []int64) (unsafe.Pointer, error) {
+	factory, err := winrt.GetActivationFactory("Windows.Foundation.PropertyValue", &IIDiPropertyValueStatics)
+	if err != nil {
+		return nil, err
+	}
+	v := (*iPropertyValueStatics)(unsafe.Pointer(factory))
+
+	var out unsafe.Pointer
+	hr, _, _ := syscall.Syscall6(
+		v.VTable().PropertyValueCreateInt64Array,
+		4,                             // nargs
+		uintptr(unsafe.Pointer(v)),    // this
+		uintptr(len(value)),           // in uint32
+		winrt.ArrayABI(value),         // in []int64
+		uintptr(unsafe.Pointer(&out)), // out unsafe.Pointer
+		0,
+		0,
+	)
+
+	if hr != 0 {
+		return nil, ole.NewError(hr)
+	}
+
+	return out, nil
+}
+
+// PropertyValueCreateUInt64Array was introduced in Windows.Foundation.FoundationContract v1.0.
+func PropertyValueCreateUInt64Array(value []uint64) (unsafe.Pointer, error) {
+	factory, err := winrt.GetActivationFactory("Windows.Foundation.PropertyValue", &IIDiPropertyValueStatics)
+	if err != nil {
+		return nil, err
+	}
+	v := (*iPropertyValueStatics)(unsafe.Pointer(factory))
+
+	var out unsafe.Pointer
+	hr, _, _ := syscall.Syscall6(
+		v.VTable().PropertyValueCreateUInt64Array,
+		4,                             // nargs
+		uintptr(unsafe.Pointer(v)),    // this
+		uintptr(len(value)),           // in uint32
+		winrt.ArrayABI(value),         // in []uint64
+		uintptr(unsafe.Pointer(&out)), // out unsafe.Pointer
+		0,
+		0,
+	)
+
+	if hr != 0 {
+		return nil, ole.NewError(hr)
+	}
+
+	return out, nil
+}
+
+// PropertyValueCreateSingleArray was introduced in Windows.Foundation.FoundationContract v1.0.
+func PropertyValueCreateSingleArray(value []float32) (unsafe.Pointer, error) {
+	factory, err := winrt.GetActivationFactory("Windows.Foundation.PropertyValue", &IIDiPropertyValueStatics)
+	if err != nil {
+		return nil, err
+	}
+	v := (*iPropertyValueStatics)(unsafe.Pointer(factory))
+
+	var out unsafe.Pointer
+	hr, _, _ := syscall.Syscall6(
+		v.VTable().PropertyValueCreateSingleArray,
+		4,                             // nargs
+		uintptr(unsafe.Pointer(v)),    // this
+		uintptr(len(value)),           // in uint32
+		winrt.ArrayABI(value),         // in []float32
+		uintptr(unsafe.Pointer(&out)), // out unsafe.Pointer
+		0,
+		0,
+	)
+
+	if hr != 0 {
+		return nil, ole.NewError(hr)
+	}
+
+	return out, nil
+}
+
+// PropertyValueCreateDoubleArray was introduced in Windows.Foundation.FoundationContract v1.0.
+func PropertyValueCreateDoubleArray(value []float64) (unsafe.Pointer, error) {
+	factory, err := winrt.GetActivationFactory("Windows.Foundation.PropertyValue", &IIDiPropertyValueStatics)
+	if err != nil {
+		return nil, err
+	}
+	v := (*iPropertyValueStatics)(unsafe.Pointer(factory))
+
+	var out unsafe.Pointer
+	hr, _, _ := syscall.Syscall6(
+		v.VTable().PropertyValueCreateDoubleArray,
+		4,                             // nargs
+		uintptr(unsafe.Pointer(v)),    // this
+		uintptr(len(value)),           // in uint32
+		winrt.ArrayABI(value),         // in []float64
+		uintptr(unsafe.Pointer(&out)), // out unsafe.Pointer
+		0,
+		0,
+	)
+
+	if hr != 0 {
+		return nil, ole.NewError(hr)
+	}
+
+	return out, nil
+}
+
+// PropertyValueCreateChar16Array was introduced in Windows.Foundation.FoundationContract v1.0.
+func PropertyValueCreateChar16Array(value []uint16) (unsafe.Pointer, error) {
+	factory, err := winrt.GetActivationFactory("Windows.Foundation.PropertyValue", &IIDiPropertyValueStatics)
+	if err != nil {
+		return nil, err
+	}
+	v := (*iPropertyValueStatics)(unsafe.Pointer(factory))
+
+	var out unsafe.Pointer
+	hr, _, _ := syscall.Syscall6(
+		v.VTable().PropertyValueCreateChar16Array,
+		4,                             // nargs
+		uintptr(unsafe.Pointer(v)),    // this
+		uintptr(len(value)),           // in uint32
+		winrt.ArrayABI(value),         // in []uint16
+		uintptr(unsafe.Pointer(&out)), // out unsafe.Pointer
+		0,
+		0,
+	)
+
+	if hr != 0 {
+		return nil, ole.NewError(hr)
+	}
+
+	return out, nil
+}
+
+// PropertyValueCreateBooleanArray was introduced in Windows.Foundation.FoundationContract v1.0.
+func PropertyValueCreateBooleanArray(value []bool) (unsafe.Pointer, error) {
+	factory, err := winrt.GetActivationFactory("Windows.Foundation.PropertyValue", &IIDiPropertyValueStatics)
+	if err != nil {
+		return nil, err
+	}
+	v := (*iPropertyValueStatics)(unsafe.Pointer(factory))
+
+	var out unsafe.Pointer
+	hr, _, _ := syscall.Syscall6(
+		v.VTable().PropertyValueCreateBooleanArray,
+		4,                             // nargs
+		uintptr(unsafe.Pointer(v)),    // this
+		uintptr(len(value)),           // in uint32
+		winrt.ArrayABI(value),         // in []bool
+		uintptr(unsafe.Pointer(&out)), // out unsafe.Pointer
+		0,
+		0,
+	)
+
+	if hr != 0 {
+		return nil, ole.NewError(hr)
+	}
+
+	return out, nil
+}
+
+// PropertyValueCreateStringArray was introduced in Windows.Foundation.FoundationContract v1.0.
+func PropertyValueCreateStringArray(value []string) (unsafe.Pointer, error) {
+	factory, err := winrt.GetActivationFactory("Windows.Foundation.PropertyValue", &IIDiPropertyValueStatics)
+	if err != nil {
+		return nil, err
+	}
+	v := (*iPropertyValueStatics)(unsafe.Pointer(factory))
+
+	var out unsafe.Pointer
+	valueHStr, err := winrt.NewHStringArray(value)
+	if err != nil {
+		return nil, err
+	}
+	defer winrt.DeleteHStringArray(valueHStr)
+	hr, _, _ := syscall.Syscall6(
+		v.VTable().PropertyValueCreateStringArray,
+		4,                             // nargs
+		uintptr(unsafe.Pointer(v)),    // this
+		uintptr(len(value)),           // in uint32
+		winrt.ArrayABI(valueHStr),     // in []string
+		uintptr(unsafe.Pointer(&out)), // out unsafe.Pointer
+		0,
+		0,
+	)
+
+	if hr != 0 {
+		return nil, ole.NewError(hr)
+	}
+
+	return out, nil
+}
+
+// PropertyValueCreateInspectableArray was introduced in Windows.Foundation.FoundationContract v1.0.
+func PropertyValueCreateInspectableArray(value []unsafe.Pointer) (unsafe.Pointer, error) {
+	factory, err := winrt.GetActivationFactory("Windows.Foundation.PropertyValue", &IIDiPropertyValueStatics)
+	if err != nil {
+		return nil, err
+	}
+	v := (*iPropertyValueStatics)(unsafe.Pointer(factory))
+
+	var out unsafe.Pointer
+	hr, _, _ := syscall.Syscall6(
+		v.VTable().PropertyValueCreateInspectableArray,
+		4,                             // nargs
+		uintptr(unsafe.Pointer(v)),    // this
+		uintptr(len(value)),           // in uint32
+		winrt.ArrayABI(value),         // in []unsafe.Pointer
+		uintptr(unsafe.Pointer(&out)), // out unsafe.Pointer
+		0,
+		0,
+	)
+
+	if hr != 0 {
+		return nil, ole.NewError(hr)
+	}
+
+	return out, nil
+}
+
+// PropertyValueCreateGuidArray was introduced in Windows.Foundation.FoundationContract v1.0.
+func PropertyValueCreateGuidArray(value []syscall.GUID) (unsafe.Pointer, error) {
+	factory, err := winrt.GetActivationFactory("Windows.Foundation.PropertyValue", &IIDiPropertyValueStatics)
+	if err != nil {
+		return nil, err
+	}
+	v := (*iPropertyValueStatics)(unsafe.Pointer(factory))
+
+	var out unsafe.Pointer
+	hr, _, _ := syscall.Syscall6(
+		v.VTable().PropertyValueCreateGuidArray,
+		4,                             // nargs
+		uintptr(unsafe.Pointer(v)),    // this
+		uintptr(len(value)),           // in uint32
+		winrt.ArrayABI(value),         // in []syscall.GUID
+		uintptr(unsafe.Pointer(&out)), // out unsafe.Pointer
+		0,
+		0,
+	)
+
+	if hr != 0 {
+		return nil, ole.NewError(hr)
+	}
+
+	return out, nil
+}
+
+// PropertyValueCreateDateTimeArray was introduced in Windows.Foundation.FoundationContract v1.0.
+func PropertyValueCreateDateTimeArray(value []DateTime) (unsafe.Pointer, error) {
+	factory, err := winrt.GetActivationFactory("Windows.Foundation.PropertyValue", &IIDiPropertyValueStatics)
+	if err != nil {
+		return nil, err
+	}
+	v := (*iPropertyValueStatics)(unsafe.Pointer(factory))
+
+	var out unsafe.Pointer
+	hr, _, _ := syscall.Syscall6(
+		v.VTable().PropertyValueCreateDateTimeArray,
+		4,                             // nargs
+		uintptr(unsafe.Pointer(v)),    // this
+		uintptr(len(value)),           // in uint32
+		winrt.ArrayABI(value),         // in []DateTime
+		uintptr(unsafe.Pointer(&out)), // out unsafe.Pointer
+		0,
+		0,
+	)
+
+	if hr != 0 {
+		return nil, ole.NewError(hr)
+	}
+
+	return out, nil
+}
+
+// PropertyValueCreateTimeSpanArray was introduced in Windows.Foundation.FoundationContract v1.0.
+func PropertyValueCreateTimeSpanArray(value []TimeSpan) (unsafe.Pointer, error) {
+	factory, err := winrt.GetActivationFactory("Windows.Foundation.PropertyValue", &IIDiPropertyValueStatics)
+	if err != nil {
+		return nil, err
+	}
+	v := (*iPropertyValueStatics)(unsafe.Pointer(factory))
+
+	var out unsafe.Pointer
+	hr, _, _ := syscall.Syscall6(
+		v.VTable().PropertyValueCreateTimeSpanArray,
+		4,                             // nargs
+		uintptr(unsafe.Pointer(v)),    // this
+		uintptr(len(value)),           // in uint32
+		winrt.ArrayABI(value),         // in []TimeSpan
+		uintptr(unsafe.Pointer(&out)), // out unsafe.Pointer
+		0,
+		0,
+	)
+
+	if hr != 0 {
+		return nil, ole.NewError(hr)
+	}
+
+	return out, nil
+}
+
+// PropertyValueCreatePointArray was introduced in Windows.Foundation.FoundationContract v1.0.
+func PropertyValueCreatePointArray(value []Point) (unsafe.Pointer, error) {
+	factory, err := winrt.GetActivationFactory("Windows.Foundation.PropertyValue", &IIDiPropertyValueStatics)
+	if err != nil {
+		return nil, err
+	}
+	v := (*iPropertyValueStatics)(unsafe.Pointer(factory))
+
+	var out unsafe.Pointer
+	hr, _, _ := syscall.Syscall6(
+		v.VTable().PropertyValueCreatePointArray,
+		4,                             // nargs
+		uintptr(unsafe.Pointer(v)),    // this
+		uintptr(len(value)),           // in uint32
+		winrt.ArrayABI(value),         // in []Point
+		uintptr(unsafe.Pointer(&out)), // out unsafe.Pointer
+		0,
+		0,
+	)
+
+	if hr != 0 {
+		return nil, ole.NewError(hr)
+	}
+
+	return out, nil
+}
+
+// PropertyValueCreateSizeArray was introduced in Windows.Foundation.FoundationContract v1.0.
+func PropertyValueCreateSizeArray(value []Size) (unsafe.Pointer, error) {
+	factory, err := winrt.GetActivationFactory("Windows.Foundation.PropertyValue", &IIDiPropertyValueStatics)
+	if err != nil {
+		return nil, err
+	}
+	v := (*iPropertyValueStatics)(unsafe.Pointer(factory))
+
+	var out unsafe.Pointer
+	hr, _, _ := syscall.Syscall6(
+		v.VTable().PropertyValueCreateSizeArray,
+		4,                             // nargs
+		uintptr(unsafe.Pointer(v)),    // this
+		uintptr(len(value)),           // in uint32
+		winrt.ArrayABI(value),         // in []Size
+		uintptr(unsafe.Pointer(&out)), // out unsafe.Pointer
+		0,
+		0,
+	)
+
+	if hr != 0 {
+		return nil, ole.NewError(hr)
+	}
+
+	return out, nil
+}
+
+// PropertyValueCreateRectArray was introduced in Windows.Foundation.FoundationContract v1.0.
+func PropertyValueCreateRectArray(value []Rect) (unsafe.Pointer, error) {
+	factory, err := winrt.GetActivationFactory("Windows.Foundation.PropertyValue", &IIDiPropertyValueStatics)
+	if err != nil {
+		return nil, err
+	}
+	v := (*iPropertyValueStatics)(unsafe.Pointer(factory))
+
+	var out unsafe.Pointer
+	hr, _, _ := syscall.Syscall6(
+		v.VTable().PropertyValueCreateRectArray,
+		4,                             // nargs
+		uintptr(unsafe.Pointer(v)),    // this
+		uintptr(len(value)),           // in uint32
+		winrt.ArrayABI(value),         // in []Rect
+		uintptr(unsafe.Pointer(&out)), // out unsafe.Pointer
+		0,
+		0,
+	)
+
+	if hr != 0 {
+		return nil, ole.NewError(hr)
+	}
+
+	return out, nil
+}
@@ -5,8 +5,24 @@
 //nolint:all
 package foundation
 
+import (
+	"time"
+
+	"github.com/waylyrics/winrt-go"
+)
+
 const SignatureTimeSpan string = "struct(Windows.Foundation.TimeSpan;i8)"
 
 type TimeSpan struct {
 	Duration int64
 }
+
+// ToDuration returns the TimeSpan as a time.Duration.
+func (v TimeSpan) ToDuration() time.Duration {
+	return winrt.DurationFromTicks(v.Duration)
+}
+
+// TimeSpanFromDuration returns the TimeSpan of the given duration.
+func TimeSpanFromDuration(d time.Duration) TimeSpan {
+	return TimeSpan{Duration: winrt.TicksFromDuration(d)}
+}
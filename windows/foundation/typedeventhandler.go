@@ -11,6 +11,7 @@ import (
 	"unsafe"
 
 	"github.com/go-ole/go-ole"
+	"github.com/waylyrics/winrt-go"
 	"github.com/waylyrics/winrt-go/internal/delegate"
 	"github.com/waylyrics/winrt-go/internal/kernel32"
 )
@@ -18,6 +19,8 @@ import (
 const GUIDTypedEventHandler string = "9de1c534-6ae1-11e0-84e1-18a905bcc53f"
 const SignatureTypedEventHandler string = "delegate({9de1c534-6ae1-11e0-84e1-18a905bcc53f})"
 
+var IIDTypedEventHandler = ole.GUID{Data1: 0x9de1c534, Data2: 0x6ae1, Data3: 0x11e0, Data4: [8]byte{0x84, 0xe1, 0x18, 0xa9, 0x05, 0xbc, 0xc5, 0x3f}}
+
 type TypedEventHandler struct {
 	ole.IUnknown
 	sync.Mutex
@@ -32,9 +35,16 @@ type TypedEventHandlerVtbl struct {
 
 type TypedEventHandlerCallback func(instance *TypedEventHandler, sender unsafe.Pointer, args unsafe.Pointer)
 
+// typedEventHandlerEntry is the callback of a TypedEventHandler, and the dispatcher that runs it.
+type typedEventHandlerEntry struct {
+	callback       TypedEventHandlerCallback
+	dispatcher     *winrt.Dispatcher // nil for the callbacks that run inline
+	objectTypeArgs []bool
+}
+
 var callbacksTypedEventHandler = &typedEventHandlerCallbacks{
 	mu:        &sync.Mutex{},
-	callbacks: make(map[unsafe.Pointer]TypedEventHandlerCallback),
+	callbacks: make(map[unsafe.Pointer]typedEventHandlerEntry),
 }
 
 var releaseChannelsTypedEventHandler = &typedEventHandlerReleaseChannels{
@@ -43,6 +53,22 @@ var releaseChannelsTypedEventHandler = &typedEventHandlerReleaseChannels{
 }
 
 func NewTypedEventHandler(iid *ole.GUID, callback TypedEventHandlerCallback) *TypedEventHandler {
+	return newTypedEventHandler(iid, typedEventHandlerEntry{callback: callback})
+}
+
+// NewTypedEventHandlerWithDispatcher is like NewTypedEventHandler, but the callback is run by the given dispatcher.
+// A nil dispatcher runs the callback inline.
+// The type arguments of the delegate are passed as unsafe pointers: objectTypeArgs tells which of them
+// are objects, which are kept alive until the callback returns.
+func NewTypedEventHandlerWithDispatcher(iid *ole.GUID, dispatcher *winrt.Dispatcher, objectTypeArgs []bool, callback TypedEventHandlerCallback) *TypedEventHandler {
+	return newTypedEventHandler(iid, typedEventHandlerEntry{
+		callback:       callback,
+		dispatcher:     dispatcher,
+		objectTypeArgs: objectTypeArgs,
+	})
+}
+
+func newTypedEventHandler(iid *ole.GUID, entry typedEventHandlerEntry) *TypedEventHandler {
 	size := unsafe.Sizeof(*(*TypedEventHandler)(nil))
 	instPtr := kernel32.Malloc(size)
 	inst := (*TypedEventHandler)(instPtr)
@@ -62,7 +88,7 @@ func NewTypedEventHandler(iid *ole.GUID, callback TypedEventHandlerCallback) *Ty
 	inst.Mutex = sync.Mutex{}
 	inst.refs = 0
 
-	callbacksTypedEventHandler.add(unsafe.Pointer(inst), callback)
+	callbacksTypedEventHandler.add(unsafe.Pointer(inst), entry)
 
 	// See the docs in the releaseChannelsTypedEventHandler struct
 	releaseChannelsTypedEventHandler.acquire(unsafe.Pointer(inst))
@@ -102,9 +128,28 @@ func (instance *TypedEventHandler) Invoke(instancePtr, rawArgs0, rawArgs1, rawAr
 	// See the quote above.
 	sender := (unsafe.Pointer)(senderPtr)
 	args := (unsafe.Pointer)(argsPtr)
-	if callback, ok := callbacksTypedEventHandler.get(instancePtr); ok {
-		callback(instance, sender, args)
+	entry, ok := callbacksTypedEventHandler.get(instancePtr)
+	if !ok {
+		return ole.S_OK
+	}
+	if entry.dispatcher == nil {
+		entry.callback(instance, sender, args)
+		return ole.S_OK
+	}
+
+	// the delegate and the objects it received must outlive the call if the callback runs later
+	objects := []*ole.IUnknown{
+		&instance.IUnknown,
+	}
+	if 0 < len(entry.objectTypeArgs) && entry.objectTypeArgs[0] {
+		objects = append(objects, (*ole.IUnknown)(sender))
+	}
+	if 1 < len(entry.objectTypeArgs) && entry.objectTypeArgs[1] {
+		objects = append(objects, (*ole.IUnknown)(args))
 	}
+	entry.dispatcher.Dispatch(func() {
+		entry.callback(instance, sender, args)
+	}, objects...)
 	return ole.S_OK
 }
 
@@ -130,17 +175,17 @@ func (instance *TypedEventHandler) Release() uint64 {
 
 type typedEventHandlerCallbacks struct {
 	mu        *sync.Mutex
-	callbacks map[unsafe.Pointer]TypedEventHandlerCallback
+	callbacks map[unsafe.Pointer]typedEventHandlerEntry
 }
 
-func (m *typedEventHandlerCallbacks) add(p unsafe.Pointer, v TypedEventHandlerCallback) {
+func (m *typedEventHandlerCallbacks) add(p unsafe.Pointer, v typedEventHandlerEntry) {
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
 	m.callbacks[p] = v
 }
 
-func (m *typedEventHandlerCallbacks) get(p unsafe.Pointer) (TypedEventHandlerCallback, bool) {
+func (m *typedEventHandlerCallbacks) get(p unsafe.Pointer) (typedEventHandlerEntry, bool) {
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
@@ -0,0 +1,80 @@
+// Code generated by winrt-go-gen. DO NOT EDIT.
+
+//go:build windows
+
+//nolint:all
+package media
+
+import (
+	"syscall"
+	"unsafe"
+
+	"github.com/go-ole/go-ole"
+	"github.com/waylyrics/winrt-go"
+)
+
+const SignatureAutoRepeatModeChangeRequestedEventArgs string = "rc(Windows.Media.AutoRepeatModeChangeRequestedEventArgs;{ea137efa-d852-438e-882b-c990109a78f4})"
+
+type AutoRepeatModeChangeRequestedEventArgs struct {
+	winrt.Object
+}
+
+// HasIAutoRepeatModeChangeRequestedEventArgs returns true if the AutoRepeatModeChangeRequestedEventArgs implements iAutoRepeatModeChangeRequestedEventArgs.
+// Interfaces added in newer versions of Windows may not be implemented by older ones.
+func (impl *AutoRepeatModeChangeRequestedEventArgs) HasIAutoRepeatModeChangeRequestedEventArgs() bool {
+	return impl.HasInterface(&IIDiAutoRepeatModeChangeRequestedEventArgs)
+}
+
+// GetRequestedAutoRepeatMode was introduced in Windows.Foundation.UniversalApiContract v1.0.
+func (impl *AutoRepeatModeChangeRequestedEventArgs) GetRequestedAutoRepeatMode() (MediaPlaybackAutoRepeatMode, error) {
+	itf, err := impl.Interface(&IIDiAutoRepeatModeChangeRequestedEventArgs)
+	if err != nil {
+		return MediaPlaybackAutoRepeatModeNone, err
+	}
+	defer impl.ReleaseInterface(itf)
+	v := (*iAutoRepeatModeChangeRequestedEventArgs)(unsafe.Pointer(itf))
+	return v.GetRequestedAutoRepeatMode()
+}
+
+const GUIDiAutoRepeatModeChangeRequestedEventArgs string = "ea137efa-d852-438e-882b-c990109a78f4"
+const SignatureiAutoRepeatModeChangeRequestedEventArgs string = "{ea137efa-d852-438e-882b-c990109a78f4}"
+
+var IIDiAutoRepeatModeChangeRequestedEventArgs = ole.GUID{Data1: 0xea137efa, Data2: 0xd852, Data3: 0x438e, Data4: [8]byte{0x88, 0x2b, 0xc9, 0x90, 0x10, 0x9a, 0x78, 0xf4}}
+
+// iAutoRepeatModeChangeRequestedEventArgs was introduced in Windows.Foundation.UniversalApiContract v1.0.
+type iAutoRepeatModeChangeRequestedEventArgs struct {
+	ole.IInspectable
+}
+
+type iAutoRepeatModeChangeRequestedEventArgsVtbl struct {
+	ole.IInspectableVtbl
+
+	GetRequestedAutoRepeatMode uintptr
+}
+
+func (v *iAutoRepeatModeChangeRequestedEventArgs) VTable() *iAutoRepeatModeChangeRequestedEventArgsVtbl {
+	return (*iAutoRepeatModeChangeRequestedEventArgsVtbl)(unsafe.Pointer(v.RawVTable))
+}
+
+// IsSupportedIAutoRepeatModeChangeRequestedEventArgs returns true if the given object implements iAutoRepeatModeChangeRequestedEventArgs.
+func IsSupportedIAutoRepeatModeChangeRequestedEventArgs(obj *ole.IUnknown) bool {
+	return winrt.IsSupported(obj, &IIDiAutoRepeatModeChangeRequestedEventArgs)
+}
+
+// GetRequestedAutoRepeatMode was introduced in Windows.Foundation.UniversalApiContract v1.0.
+func (v *iAutoRepeatModeChangeRequestedEventArgs) GetRequestedAutoRepeatMode() (MediaPlaybackAutoRepeatMode, error) {
+	var out MediaPlaybackAutoRepeatMode
+	hr, _, _ := syscall.Syscall(
+		v.VTable().GetRequestedAutoRepeatMode,
+		2,                             // nargs
+		uintptr(unsafe.Pointer(v)),    // this
+		uintptr(unsafe.Pointer(&out)), // out MediaPlaybackAutoRepeatMode
+		0,
+	)
+
+	if hr != 0 {
+		return MediaPlaybackAutoRepeatModeNone, ole.NewError(hr)
+	}
+
+	return out, nil
+}
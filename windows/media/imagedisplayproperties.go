@@ -10,38 +10,62 @@ import (
 	"unsafe"
 
 	"github.com/go-ole/go-ole"
+	"github.com/waylyrics/winrt-go"
+	"github.com/waylyrics/winrt-go/hstring"
 )
 
 const SignatureImageDisplayProperties string = "rc(Windows.Media.ImageDisplayProperties;{cd0bc7ef-54e7-411f-9933-f0e98b0a96d2})"
 
 type ImageDisplayProperties struct {
-	ole.IUnknown
+	winrt.Object
 }
 
+// HasIImageDisplayProperties returns true if the ImageDisplayProperties implements iImageDisplayProperties.
+// Interfaces added in newer versions of Windows may not be implemented by older ones.
+func (impl *ImageDisplayProperties) HasIImageDisplayProperties() bool {
+	return impl.HasInterface(&IIDiImageDisplayProperties)
+}
+
+// GetTitle was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (impl *ImageDisplayProperties) GetTitle() (string, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiImageDisplayProperties))
-	defer itf.Release()
+	itf, err := impl.Interface(&IIDiImageDisplayProperties)
+	if err != nil {
+		return "", err
+	}
+	defer impl.ReleaseInterface(itf)
 	v := (*iImageDisplayProperties)(unsafe.Pointer(itf))
 	return v.GetTitle()
 }
 
+// SetTitle was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (impl *ImageDisplayProperties) SetTitle(value string) error {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiImageDisplayProperties))
-	defer itf.Release()
+	itf, err := impl.Interface(&IIDiImageDisplayProperties)
+	if err != nil {
+		return err
+	}
+	defer impl.ReleaseInterface(itf)
 	v := (*iImageDisplayProperties)(unsafe.Pointer(itf))
 	return v.SetTitle(value)
 }
 
+// GetSubtitle was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (impl *ImageDisplayProperties) GetSubtitle() (string, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiImageDisplayProperties))
-	defer itf.Release()
+	itf, err := impl.Interface(&IIDiImageDisplayProperties)
+	if err != nil {
+		return "", err
+	}
+	defer impl.ReleaseInterface(itf)
 	v := (*iImageDisplayProperties)(unsafe.Pointer(itf))
 	return v.GetSubtitle()
 }
 
+// SetSubtitle was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (impl *ImageDisplayProperties) SetSubtitle(value string) error {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiImageDisplayProperties))
-	defer itf.Release()
+	itf, err := impl.Interface(&IIDiImageDisplayProperties)
+	if err != nil {
+		return err
+	}
+	defer impl.ReleaseInterface(itf)
 	v := (*iImageDisplayProperties)(unsafe.Pointer(itf))
 	return v.SetSubtitle(value)
 }
@@ -49,6 +73,9 @@ func (impl *ImageDisplayProperties) SetSubtitle(value string) error {
 const GUIDiImageDisplayProperties string = "cd0bc7ef-54e7-411f-9933-f0e98b0a96d2"
 const SignatureiImageDisplayProperties string = "{cd0bc7ef-54e7-411f-9933-f0e98b0a96d2}"
 
+var IIDiImageDisplayProperties = ole.GUID{Data1: 0xcd0bc7ef, Data2: 0x54e7, Data3: 0x411f, Data4: [8]byte{0x99, 0x33, 0xf0, 0xe9, 0x8b, 0x0a, 0x96, 0xd2}}
+
+// iImageDisplayProperties was introduced in Windows.Foundation.UniversalApiContract v1.0.
 type iImageDisplayProperties struct {
 	ole.IInspectable
 }
@@ -66,12 +93,20 @@ func (v *iImageDisplayProperties) VTable() *iImageDisplayPropertiesVtbl {
 	return (*iImageDisplayPropertiesVtbl)(unsafe.Pointer(v.RawVTable))
 }
 
+// IsSupportedIImageDisplayProperties returns true if the given object implements iImageDisplayProperties.
+func IsSupportedIImageDisplayProperties(obj *ole.IUnknown) bool {
+	return winrt.IsSupported(obj, &IIDiImageDisplayProperties)
+}
+
+// GetTitle was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (v *iImageDisplayProperties) GetTitle() (string, error) {
-	var outHStr ole.HString
-	hr, _, _ := syscall.SyscallN(
+	var outHStr hstring.HString
+	hr, _, _ := syscall.Syscall(
 		v.VTable().GetTitle,
+		2,                                 // nargs
 		uintptr(unsafe.Pointer(v)),        // this
 		uintptr(unsafe.Pointer(&outHStr)), // out string
+		0,
 	)
 
 	if hr != 0 {
@@ -79,21 +114,26 @@ func (v *iImageDisplayProperties) GetTitle() (string, error) {
 	}
 
 	out := outHStr.String()
-	ole.DeleteHString(outHStr)
+	_ = outHStr.Delete()
 	return out, nil
 }
 
+// SetTitle was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (v *iImageDisplayProperties) SetTitle(value string) error {
-	valueHStr, err := ole.NewHString(value)
+	valueHStr, err := hstring.NewReference(value)
 	if err != nil {
 		return err
 	}
-	hr, _, _ := syscall.SyscallN(
+	hr, _, _ := syscall.Syscall(
 		v.VTable().SetTitle,
-		uintptr(unsafe.Pointer(v)), // this
-		uintptr(valueHStr),         // in string
+		2,                            // nargs
+		uintptr(unsafe.Pointer(v)),   // this
+		uintptr(valueHStr.HString()), // in string
+		0,
 	)
 
+	valueHStr.Release()
+
 	if hr != 0 {
 		return ole.NewError(hr)
 	}
@@ -101,12 +141,15 @@ func (v *iImageDisplayProperties) SetTitle(value string) error {
 	return nil
 }
 
+// GetSubtitle was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (v *iImageDisplayProperties) GetSubtitle() (string, error) {
-	var outHStr ole.HString
-	hr, _, _ := syscall.SyscallN(
+	var outHStr hstring.HString
+	hr, _, _ := syscall.Syscall(
 		v.VTable().GetSubtitle,
+		2,                                 // nargs
 		uintptr(unsafe.Pointer(v)),        // this
 		uintptr(unsafe.Pointer(&outHStr)), // out string
+		0,
 	)
 
 	if hr != 0 {
@@ -114,21 +157,26 @@ func (v *iImageDisplayProperties) GetSubtitle() (string, error) {
 	}
 
 	out := outHStr.String()
-	ole.DeleteHString(outHStr)
+	_ = outHStr.Delete()
 	return out, nil
 }
 
+// SetSubtitle was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (v *iImageDisplayProperties) SetSubtitle(value string) error {
-	valueHStr, err := ole.NewHString(value)
+	valueHStr, err := hstring.NewReference(value)
 	if err != nil {
 		return err
 	}
-	hr, _, _ := syscall.SyscallN(
+	hr, _, _ := syscall.Syscall(
 		v.VTable().SetSubtitle,
-		uintptr(unsafe.Pointer(v)), // this
-		uintptr(valueHStr),         // in string
+		2,                            // nargs
+		uintptr(unsafe.Pointer(v)),   // this
+		uintptr(valueHStr.HString()), // in string
+		0,
 	)
 
+	valueHStr.Release()
+
 	if hr != 0 {
 		return ole.NewError(hr)
 	}
@@ -5,6 +5,8 @@
 //nolint:all
 package media
 
+import "fmt"
+
 type MediaPlaybackAutoRepeatMode int32
 
 const SignatureMediaPlaybackAutoRepeatMode string = "enum(Windows.Media.MediaPlaybackAutoRepeatMode;i4)"
@@ -14,3 +16,47 @@ const (
 	MediaPlaybackAutoRepeatModeTrack MediaPlaybackAutoRepeatMode = 1
 	MediaPlaybackAutoRepeatModeList  MediaPlaybackAutoRepeatMode = 2
 )
+
+var valuesMediaPlaybackAutoRepeatMode = []MediaPlaybackAutoRepeatMode{
+	MediaPlaybackAutoRepeatModeNone,
+	MediaPlaybackAutoRepeatModeTrack,
+	MediaPlaybackAutoRepeatModeList,
+}
+
+var namesMediaPlaybackAutoRepeatMode = []string{
+	"None",
+	"Track",
+	"List",
+}
+
+// ValuesMediaPlaybackAutoRepeatMode returns all the values defined by MediaPlaybackAutoRepeatMode.
+func ValuesMediaPlaybackAutoRepeatMode() []MediaPlaybackAutoRepeatMode {
+	return append([]MediaPlaybackAutoRepeatMode(nil), valuesMediaPlaybackAutoRepeatMode...)
+}
+
+// String returns the name of the value.
+func (v MediaPlaybackAutoRepeatMode) String() string {
+	for i, value := range valuesMediaPlaybackAutoRepeatMode {
+		if value == v {
+			return namesMediaPlaybackAutoRepeatMode[i]
+		}
+	}
+	return fmt.Sprintf("MediaPlaybackAutoRepeatMode(%d)", int32(v))
+}
+
+// ParseMediaPlaybackAutoRepeatMode returns the value with the given name.
+func ParseMediaPlaybackAutoRepeatMode(s string) (MediaPlaybackAutoRepeatMode, error) {
+	if v, ok := lookupMediaPlaybackAutoRepeatMode(s); ok {
+		return v, nil
+	}
+	return 0, fmt.Errorf("invalid MediaPlaybackAutoRepeatMode %q", s)
+}
+
+func lookupMediaPlaybackAutoRepeatMode(name string) (MediaPlaybackAutoRepeatMode, bool) {
+	for i, n := range namesMediaPlaybackAutoRepeatMode {
+		if n == name {
+			return valuesMediaPlaybackAutoRepeatMode[i], true
+		}
+	}
+	return 0, false
+}
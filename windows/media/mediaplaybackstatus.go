@@ -5,6 +5,8 @@
 //nolint:all
 package media
 
+import "fmt"
+
 type MediaPlaybackStatus int32
 
 const SignatureMediaPlaybackStatus string = "enum(Windows.Media.MediaPlaybackStatus;i4)"
@@ -16,3 +18,51 @@ const (
 	MediaPlaybackStatusPlaying  MediaPlaybackStatus = 3
 	MediaPlaybackStatusPaused   MediaPlaybackStatus = 4
 )
+
+var valuesMediaPlaybackStatus = []MediaPlaybackStatus{
+	MediaPlaybackStatusClosed,
+	MediaPlaybackStatusChanging,
+	MediaPlaybackStatusStopped,
+	MediaPlaybackStatusPlaying,
+	MediaPlaybackStatusPaused,
+}
+
+var namesMediaPlaybackStatus = []string{
+	"Closed",
+	"Changing",
+	"Stopped",
+	"Playing",
+	"Paused",
+}
+
+// ValuesMediaPlaybackStatus returns all the values defined by MediaPlaybackStatus.
+func ValuesMediaPlaybackStatus() []MediaPlaybackStatus {
+	return append([]MediaPlaybackStatus(nil), valuesMediaPlaybackStatus...)
+}
+
+// String returns the name of the value.
+func (v MediaPlaybackStatus) String() string {
+	for i, value := range valuesMediaPlaybackStatus {
+		if value == v {
+			return namesMediaPlaybackStatus[i]
+		}
+	}
+	return fmt.Sprintf("MediaPlaybackStatus(%d)", int32(v))
+}
+
+// ParseMediaPlaybackStatus returns the value with the given name.
+func ParseMediaPlaybackStatus(s string) (MediaPlaybackStatus, error) {
+	if v, ok := lookupMediaPlaybackStatus(s); ok {
+		return v, nil
+	}
+	return 0, fmt.Errorf("invalid MediaPlaybackStatus %q", s)
+}
+
+func lookupMediaPlaybackStatus(name string) (MediaPlaybackStatus, bool) {
+	for i, n := range namesMediaPlaybackStatus {
+		if n == name {
+			return valuesMediaPlaybackStatus[i], true
+		}
+	}
+	return 0, false
+}
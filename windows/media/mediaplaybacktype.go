@@ -5,6 +5,8 @@
 //nolint:all
 package media
 
+import "fmt"
+
 type MediaPlaybackType int32
 
 const SignatureMediaPlaybackType string = "enum(Windows.Media.MediaPlaybackType;i4)"
@@ -15,3 +17,49 @@ const (
 	MediaPlaybackTypeVideo   MediaPlaybackType = 2
 	MediaPlaybackTypeImage   MediaPlaybackType = 3
 )
+
+var valuesMediaPlaybackType = []MediaPlaybackType{
+	MediaPlaybackTypeUnknown,
+	MediaPlaybackTypeMusic,
+	MediaPlaybackTypeVideo,
+	MediaPlaybackTypeImage,
+}
+
+var namesMediaPlaybackType = []string{
+	"Unknown",
+	"Music",
+	"Video",
+	"Image",
+}
+
+// ValuesMediaPlaybackType returns all the values defined by MediaPlaybackType.
+func ValuesMediaPlaybackType() []MediaPlaybackType {
+	return append([]MediaPlaybackType(nil), valuesMediaPlaybackType...)
+}
+
+// String returns the name of the value.
+func (v MediaPlaybackType) String() string {
+	for i, value := range valuesMediaPlaybackType {
+		if value == v {
+			return namesMediaPlaybackType[i]
+		}
+	}
+	return fmt.Sprintf("MediaPlaybackType(%d)", int32(v))
+}
+
+// ParseMediaPlaybackType returns the value with the given name.
+func ParseMediaPlaybackType(s string) (MediaPlaybackType, error) {
+	if v, ok := lookupMediaPlaybackType(s); ok {
+		return v, nil
+	}
+	return 0, fmt.Errorf("invalid MediaPlaybackType %q", s)
+}
+
+func lookupMediaPlaybackType(name string) (MediaPlaybackType, bool) {
+	for i, n := range namesMediaPlaybackType {
+		if n == name {
+			return valuesMediaPlaybackType[i], true
+		}
+	}
+	return 0, false
+}
@@ -10,102 +10,174 @@ import (
 	"unsafe"
 
 	"github.com/go-ole/go-ole"
+	"github.com/waylyrics/winrt-go"
+	"github.com/waylyrics/winrt-go/hstring"
 	"github.com/waylyrics/winrt-go/windows/foundation/collections"
 )
 
 const SignatureMusicDisplayProperties string = "rc(Windows.Media.MusicDisplayProperties;{6bbf0c59-d0a0-4d26-92a0-f978e1d18e7b})"
 
 type MusicDisplayProperties struct {
-	ole.IUnknown
+	winrt.Object
 }
 
+// HasIMusicDisplayProperties returns true if the MusicDisplayProperties implements iMusicDisplayProperties.
+// Interfaces added in newer versions of Windows may not be implemented by older ones.
+func (impl *MusicDisplayProperties) HasIMusicDisplayProperties() bool {
+	return impl.HasInterface(&IIDiMusicDisplayProperties)
+}
+
+// GetTitle was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (impl *MusicDisplayProperties) GetTitle() (string, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiMusicDisplayProperties))
-	defer itf.Release()
+	itf, err := impl.Interface(&IIDiMusicDisplayProperties)
+	if err != nil {
+		return "", err
+	}
+	defer impl.ReleaseInterface(itf)
 	v := (*iMusicDisplayProperties)(unsafe.Pointer(itf))
 	return v.GetTitle()
 }
 
+// SetTitle was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (impl *MusicDisplayProperties) SetTitle(value string) error {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiMusicDisplayProperties))
-	defer itf.Release()
+	itf, err := impl.Interface(&IIDiMusicDisplayProperties)
+	if err != nil {
+		return err
+	}
+	defer impl.ReleaseInterface(itf)
 	v := (*iMusicDisplayProperties)(unsafe.Pointer(itf))
 	return v.SetTitle(value)
 }
 
+// GetAlbumArtist was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (impl *MusicDisplayProperties) GetAlbumArtist() (string, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiMusicDisplayProperties))
-	defer itf.Release()
+	itf, err := impl.Interface(&IIDiMusicDisplayProperties)
+	if err != nil {
+		return "", err
+	}
+	defer impl.ReleaseInterface(itf)
 	v := (*iMusicDisplayProperties)(unsafe.Pointer(itf))
 	return v.GetAlbumArtist()
 }
 
+// SetAlbumArtist was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (impl *MusicDisplayProperties) SetAlbumArtist(value string) error {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiMusicDisplayProperties))
-	defer itf.Release()
+	itf, err := impl.Interface(&IIDiMusicDisplayProperties)
+	if err != nil {
+		return err
+	}
+	defer impl.ReleaseInterface(itf)
 	v := (*iMusicDisplayProperties)(unsafe.Pointer(itf))
 	return v.SetAlbumArtist(value)
 }
 
+// GetArtist was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (impl *MusicDisplayProperties) GetArtist() (string, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiMusicDisplayProperties))
-	defer itf.Release()
+	itf, err := impl.Interface(&IIDiMusicDisplayProperties)
+	if err != nil {
+		return "", err
+	}
+	defer impl.ReleaseInterface(itf)
 	v := (*iMusicDisplayProperties)(unsafe.Pointer(itf))
 	return v.GetArtist()
 }
 
+// SetArtist was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (impl *MusicDisplayProperties) SetArtist(value string) error {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiMusicDisplayProperties))
-	defer itf.Release()
+	itf, err := impl.Interface(&IIDiMusicDisplayProperties)
+	if err != nil {
+		return err
+	}
+	defer impl.ReleaseInterface(itf)
 	v := (*iMusicDisplayProperties)(unsafe.Pointer(itf))
 	return v.SetArtist(value)
 }
 
+// HasIMusicDisplayProperties2 returns true if the MusicDisplayProperties implements iMusicDisplayProperties2.
+// Interfaces added in newer versions of Windows may not be implemented by older ones.
+func (impl *MusicDisplayProperties) HasIMusicDisplayProperties2() bool {
+	return impl.HasInterface(&IIDiMusicDisplayProperties2)
+}
+
+// GetAlbumTitle was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (impl *MusicDisplayProperties) GetAlbumTitle() (string, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiMusicDisplayProperties2))
-	defer itf.Release()
+	itf, err := impl.Interface(&IIDiMusicDisplayProperties2)
+	if err != nil {
+		return "", err
+	}
+	defer impl.ReleaseInterface(itf)
 	v := (*iMusicDisplayProperties2)(unsafe.Pointer(itf))
 	return v.GetAlbumTitle()
 }
 
+// SetAlbumTitle was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (impl *MusicDisplayProperties) SetAlbumTitle(value string) error {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiMusicDisplayProperties2))
-	defer itf.Release()
+	itf, err := impl.Interface(&IIDiMusicDisplayProperties2)
+	if err != nil {
+		return err
+	}
+	defer impl.ReleaseInterface(itf)
 	v := (*iMusicDisplayProperties2)(unsafe.Pointer(itf))
 	return v.SetAlbumTitle(value)
 }
 
+// GetTrackNumber was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (impl *MusicDisplayProperties) GetTrackNumber() (uint32, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiMusicDisplayProperties2))
-	defer itf.Release()
+	itf, err := impl.Interface(&IIDiMusicDisplayProperties2)
+	if err != nil {
+		return 0, err
+	}
+	defer impl.ReleaseInterface(itf)
 	v := (*iMusicDisplayProperties2)(unsafe.Pointer(itf))
 	return v.GetTrackNumber()
 }
 
+// SetTrackNumber was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (impl *MusicDisplayProperties) SetTrackNumber(value uint32) error {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiMusicDisplayProperties2))
-	defer itf.Release()
+	itf, err := impl.Interface(&IIDiMusicDisplayProperties2)
+	if err != nil {
+		return err
+	}
+	defer impl.ReleaseInterface(itf)
 	v := (*iMusicDisplayProperties2)(unsafe.Pointer(itf))
 	return v.SetTrackNumber(value)
 }
 
+// GetGenres was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (impl *MusicDisplayProperties) GetGenres() (*collections.IVector, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiMusicDisplayProperties2))
-	defer itf.Release()
+	itf, err := impl.Interface(&IIDiMusicDisplayProperties2)
+	if err != nil {
+		return nil, err
+	}
+	defer impl.ReleaseInterface(itf)
 	v := (*iMusicDisplayProperties2)(unsafe.Pointer(itf))
 	return v.GetGenres()
 }
 
+// HasIMusicDisplayProperties3 returns true if the MusicDisplayProperties implements iMusicDisplayProperties3.
+// Interfaces added in newer versions of Windows may not be implemented by older ones.
+func (impl *MusicDisplayProperties) HasIMusicDisplayProperties3() bool {
+	return impl.HasInterface(&IIDiMusicDisplayProperties3)
+}
+
+// GetAlbumTrackCount was introduced in Windows.Foundation.UniversalApiContract v3.0.
 func (impl *MusicDisplayProperties) GetAlbumTrackCount() (uint32, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiMusicDisplayProperties3))
-	defer itf.Release()
+	itf, err := impl.Interface(&IIDiMusicDisplayProperties3)
+	if err != nil {
+		return 0, err
+	}
+	defer impl.ReleaseInterface(itf)
 	v := (*iMusicDisplayProperties3)(unsafe.Pointer(itf))
 	return v.GetAlbumTrackCount()
 }
 
+// SetAlbumTrackCount was introduced in Windows.Foundation.UniversalApiContract v3.0.
 func (impl *MusicDisplayProperties) SetAlbumTrackCount(value uint32) error {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiMusicDisplayProperties3))
-	defer itf.Release()
+	itf, err := impl.Interface(&IIDiMusicDisplayProperties3)
+	if err != nil {
+		return err
+	}
+	defer impl.ReleaseInterface(itf)
 	v := (*iMusicDisplayProperties3)(unsafe.Pointer(itf))
 	return v.SetAlbumTrackCount(value)
 }
@@ -113,6 +185,9 @@ func (impl *MusicDisplayProperties) SetAlbumTrackCount(value uint32) error {
 const GUIDiMusicDisplayProperties string = "6bbf0c59-d0a0-4d26-92a0-f978e1d18e7b"
 const SignatureiMusicDisplayProperties string = "{6bbf0c59-d0a0-4d26-92a0-f978e1d18e7b}"
 
+var IIDiMusicDisplayProperties = ole.GUID{Data1: 0x6bbf0c59, Data2: 0xd0a0, Data3: 0x4d26, Data4: [8]byte{0x92, 0xa0, 0xf9, 0x78, 0xe1, 0xd1, 0x8e, 0x7b}}
+
+// iMusicDisplayProperties was introduced in Windows.Foundation.UniversalApiContract v1.0.
 type iMusicDisplayProperties struct {
 	ole.IInspectable
 }
@@ -132,12 +207,20 @@ func (v *iMusicDisplayProperties) VTable() *iMusicDisplayPropertiesVtbl {
 	return (*iMusicDisplayPropertiesVtbl)(unsafe.Pointer(v.RawVTable))
 }
 
+// IsSupportedIMusicDisplayProperties returns true if the given object implements iMusicDisplayProperties.
+func IsSupportedIMusicDisplayProperties(obj *ole.IUnknown) bool {
+	return winrt.IsSupported(obj, &IIDiMusicDisplayProperties)
+}
+
+// GetTitle was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (v *iMusicDisplayProperties) GetTitle() (string, error) {
-	var outHStr ole.HString
-	hr, _, _ := syscall.SyscallN(
+	var outHStr hstring.HString
+	hr, _, _ := syscall.Syscall(
 		v.VTable().GetTitle,
+		2,                                 // nargs
 		uintptr(unsafe.Pointer(v)),        // this
 		uintptr(unsafe.Pointer(&outHStr)), // out string
+		0,
 	)
 
 	if hr != 0 {
@@ -145,21 +228,26 @@ func (v *iMusicDisplayProperties) GetTitle() (string, error) {
 	}
 
 	out := outHStr.String()
-	ole.DeleteHString(outHStr)
+	_ = outHStr.Delete()
 	return out, nil
 }
 
+// SetTitle was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (v *iMusicDisplayProperties) SetTitle(value string) error {
-	valueHStr, err := ole.NewHString(value)
+	valueHStr, err := hstring.NewReference(value)
 	if err != nil {
 		return err
 	}
-	hr, _, _ := syscall.SyscallN(
+	hr, _, _ := syscall.Syscall(
 		v.VTable().SetTitle,
-		uintptr(unsafe.Pointer(v)), // this
-		uintptr(valueHStr),         // in string
+		2,                            // nargs
+		uintptr(unsafe.Pointer(v)),   // this
+		uintptr(valueHStr.HString()), // in string
+		0,
 	)
 
+	valueHStr.Release()
+
 	if hr != 0 {
 		return ole.NewError(hr)
 	}
@@ -167,12 +255,15 @@ func (v *iMusicDisplayProperties) SetTitle(value string) error {
 	return nil
 }
 
+// GetAlbumArtist was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (v *iMusicDisplayProperties) GetAlbumArtist() (string, error) {
-	var outHStr ole.HString
-	hr, _, _ := syscall.SyscallN(
+	var outHStr hstring.HString
+	hr, _, _ := syscall.Syscall(
 		v.VTable().GetAlbumArtist,
+		2,                                 // nargs
 		uintptr(unsafe.Pointer(v)),        // this
 		uintptr(unsafe.Pointer(&outHStr)), // out string
+		0,
 	)
 
 	if hr != 0 {
@@ -180,21 +271,26 @@ func (v *iMusicDisplayProperties) GetAlbumArtist() (string, error) {
 	}
 
 	out := outHStr.String()
-	ole.DeleteHString(outHStr)
+	_ = outHStr.Delete()
 	return out, nil
 }
 
+// SetAlbumArtist was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (v *iMusicDisplayProperties) SetAlbumArtist(value string) error {
-	valueHStr, err := ole.NewHString(value)
+	valueHStr, err := hstring.NewReference(value)
 	if err != nil {
 		return err
 	}
-	hr, _, _ := syscall.SyscallN(
+	hr, _, _ := syscall.Syscall(
 		v.VTable().SetAlbumArtist,
-		uintptr(unsafe.Pointer(v)), // this
-		uintptr(valueHStr),         // in string
+		2,                            // nargs
+		uintptr(unsafe.Pointer(v)),   // this
+		uintptr(valueHStr.HString()), // in string
+		0,
 	)
 
+	valueHStr.Release()
+
 	if hr != 0 {
 		return ole.NewError(hr)
 	}
@@ -202,12 +298,15 @@ func (v *iMusicDisplayProperties) SetAlbumArtist(value string) error {
 	return nil
 }
 
+// GetArtist was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (v *iMusicDisplayProperties) GetArtist() (string, error) {
-	var outHStr ole.HString
-	hr, _, _ := syscall.SyscallN(
+	var outHStr hstring.HString
+	hr, _, _ := syscall.Syscall(
 		v.VTable().GetArtist,
+		2,                                 // nargs
 		uintptr(unsafe.Pointer(v)),        // this
 		uintptr(unsafe.Pointer(&outHStr)), // out string
+		0,
 	)
 
 	if hr != 0 {
@@ -215,21 +314,26 @@ func (v *iMusicDisplayProperties) GetArtist() (string, error) {
 	}
 
 	out := outHStr.String()
-	ole.DeleteHString(outHStr)
+	_ = outHStr.Delete()
 	return out, nil
 }
 
+// SetArtist was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (v *iMusicDisplayProperties) SetArtist(value string) error {
-	valueHStr, err := ole.NewHString(value)
+	valueHStr, err := hstring.NewReference(value)
 	if err != nil {
 		return err
 	}
-	hr, _, _ := syscall.SyscallN(
+	hr, _, _ := syscall.Syscall(
 		v.VTable().SetArtist,
-		uintptr(unsafe.Pointer(v)), // this
-		uintptr(valueHStr),         // in string
+		2,                            // nargs
+		uintptr(unsafe.Pointer(v)),   // this
+		uintptr(valueHStr.HString()), // in string
+		0,
 	)
 
+	valueHStr.Release()
+
 	if hr != 0 {
 		return ole.NewError(hr)
 	}
@@ -240,6 +344,9 @@ func (v *iMusicDisplayProperties) SetArtist(value string) error {
 const GUIDiMusicDisplayProperties2 string = "00368462-97d3-44b9-b00f-008afcefaf18"
 const SignatureiMusicDisplayProperties2 string = "{00368462-97d3-44b9-b00f-008afcefaf18}"
 
+var IIDiMusicDisplayProperties2 = ole.GUID{Data1: 0x00368462, Data2: 0x97d3, Data3: 0x44b9, Data4: [8]byte{0xb0, 0x0f, 0x00, 0x8a, 0xfc, 0xef, 0xaf, 0x18}}
+
+// iMusicDisplayProperties2 was introduced in Windows.Foundation.UniversalApiContract v1.0.
 type iMusicDisplayProperties2 struct {
 	ole.IInspectable
 }
@@ -258,12 +365,20 @@ func (v *iMusicDisplayProperties2) VTable() *iMusicDisplayProperties2Vtbl {
 	return (*iMusicDisplayProperties2Vtbl)(unsafe.Pointer(v.RawVTable))
 }
 
+// IsSupportedIMusicDisplayProperties2 returns true if the given object implements iMusicDisplayProperties2.
+func IsSupportedIMusicDisplayProperties2(obj *ole.IUnknown) bool {
+	return winrt.IsSupported(obj, &IIDiMusicDisplayProperties2)
+}
+
+// GetAlbumTitle was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (v *iMusicDisplayProperties2) GetAlbumTitle() (string, error) {
-	var outHStr ole.HString
-	hr, _, _ := syscall.SyscallN(
+	var outHStr hstring.HString
+	hr, _, _ := syscall.Syscall(
 		v.VTable().GetAlbumTitle,
+		2,                                 // nargs
 		uintptr(unsafe.Pointer(v)),        // this
 		uintptr(unsafe.Pointer(&outHStr)), // out string
+		0,
 	)
 
 	if hr != 0 {
@@ -271,21 +386,26 @@ func (v *iMusicDisplayProperties2) GetAlbumTitle() (string, error) {
 	}
 
 	out := outHStr.String()
-	ole.DeleteHString(outHStr)
+	_ = outHStr.Delete()
 	return out, nil
 }
 
+// SetAlbumTitle was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (v *iMusicDisplayProperties2) SetAlbumTitle(value string) error {
-	valueHStr, err := ole.NewHString(value)
+	valueHStr, err := hstring.NewReference(value)
 	if err != nil {
 		return err
 	}
-	hr, _, _ := syscall.SyscallN(
+	hr, _, _ := syscall.Syscall(
 		v.VTable().SetAlbumTitle,
-		uintptr(unsafe.Pointer(v)), // this
-		uintptr(valueHStr),         // in string
+		2,                            // nargs
+		uintptr(unsafe.Pointer(v)),   // this
+		uintptr(valueHStr.HString()), // in string
+		0,
 	)
 
+	valueHStr.Release()
+
 	if hr != 0 {
 		return ole.NewError(hr)
 	}
@@ -293,12 +413,15 @@ func (v *iMusicDisplayProperties2) SetAlbumTitle(value string) error {
 	return nil
 }
 
+// GetTrackNumber was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (v *iMusicDisplayProperties2) GetTrackNumber() (uint32, error) {
 	var out uint32
-	hr, _, _ := syscall.SyscallN(
+	hr, _, _ := syscall.Syscall(
 		v.VTable().GetTrackNumber,
+		2,                             // nargs
 		uintptr(unsafe.Pointer(v)),    // this
 		uintptr(unsafe.Pointer(&out)), // out uint32
+		0,
 	)
 
 	if hr != 0 {
@@ -308,11 +431,14 @@ func (v *iMusicDisplayProperties2) GetTrackNumber() (uint32, error) {
 	return out, nil
 }
 
+// SetTrackNumber was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (v *iMusicDisplayProperties2) SetTrackNumber(value uint32) error {
-	hr, _, _ := syscall.SyscallN(
+	hr, _, _ := syscall.Syscall(
 		v.VTable().SetTrackNumber,
+		2,                          // nargs
 		uintptr(unsafe.Pointer(v)), // this
 		uintptr(value),             // in uint32
+		0,
 	)
 
 	if hr != 0 {
@@ -322,12 +448,15 @@ func (v *iMusicDisplayProperties2) SetTrackNumber(value uint32) error {
 	return nil
 }
 
+// GetGenres was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (v *iMusicDisplayProperties2) GetGenres() (*collections.IVector, error) {
 	var out *collections.IVector
-	hr, _, _ := syscall.SyscallN(
+	hr, _, _ := syscall.Syscall(
 		v.VTable().GetGenres,
+		2,                             // nargs
 		uintptr(unsafe.Pointer(v)),    // this
 		uintptr(unsafe.Pointer(&out)), // out collections.IVector
+		0,
 	)
 
 	if hr != 0 {
@@ -340,6 +469,9 @@ func (v *iMusicDisplayProperties2) GetGenres() (*collections.IVector, error) {
 const GUIDiMusicDisplayProperties3 string = "4db51ac1-0681-4e8c-9401-b8159d9eefc7"
 const SignatureiMusicDisplayProperties3 string = "{4db51ac1-0681-4e8c-9401-b8159d9eefc7}"
 
+var IIDiMusicDisplayProperties3 = ole.GUID{Data1: 0x4db51ac1, Data2: 0x0681, Data3: 0x4e8c, Data4: [8]byte{0x94, 0x01, 0xb8, 0x15, 0x9d, 0x9e, 0xef, 0xc7}}
+
+// iMusicDisplayProperties3 was introduced in Windows.Foundation.UniversalApiContract v3.0.
 type iMusicDisplayProperties3 struct {
 	ole.IInspectable
 }
@@ -355,12 +487,20 @@ func (v *iMusicDisplayProperties3) VTable() *iMusicDisplayProperties3Vtbl {
 	return (*iMusicDisplayProperties3Vtbl)(unsafe.Pointer(v.RawVTable))
 }
 
+// IsSupportedIMusicDisplayProperties3 returns true if the given object implements iMusicDisplayProperties3.
+func IsSupportedIMusicDisplayProperties3(obj *ole.IUnknown) bool {
+	return winrt.IsSupported(obj, &IIDiMusicDisplayProperties3)
+}
+
+// GetAlbumTrackCount was introduced in Windows.Foundation.UniversalApiContract v3.0.
 func (v *iMusicDisplayProperties3) GetAlbumTrackCount() (uint32, error) {
 	var out uint32
-	hr, _, _ := syscall.SyscallN(
+	hr, _, _ := syscall.Syscall(
 		v.VTable().GetAlbumTrackCount,
+		2,                             // nargs
 		uintptr(unsafe.Pointer(v)),    // this
 		uintptr(unsafe.Pointer(&out)), // out uint32
+		0,
 	)
 
 	if hr != 0 {
@@ -370,11 +510,14 @@ func (v *iMusicDisplayProperties3) GetAlbumTrackCount() (uint32, error) {
 	return out, nil
 }
 
+// SetAlbumTrackCount was introduced in Windows.Foundation.UniversalApiContract v3.0.
 func (v *iMusicDisplayProperties3) SetAlbumTrackCount(value uint32) error {
-	hr, _, _ := syscall.SyscallN(
+	hr, _, _ := syscall.Syscall(
 		v.VTable().SetAlbumTrackCount,
+		2,                          // nargs
 		uintptr(unsafe.Pointer(v)), // this
 		uintptr(value),             // in uint32
+		0,
 	)
 
 	if hr != 0 {
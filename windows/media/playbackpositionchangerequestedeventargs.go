@@ -0,0 +1,81 @@
+// Code generated by winrt-go-gen. DO NOT EDIT.
+
+//go:build windows
+
+//nolint:all
+package media
+
+import (
+	"syscall"
+	"unsafe"
+
+	"github.com/go-ole/go-ole"
+	"github.com/waylyrics/winrt-go"
+	"github.com/waylyrics/winrt-go/windows/foundation"
+)
+
+const SignaturePlaybackPositionChangeRequestedEventArgs string = "rc(Windows.Media.PlaybackPositionChangeRequestedEventArgs;{b4493f88-eb28-4961-9c14-335e44f3e125})"
+
+type PlaybackPositionChangeRequestedEventArgs struct {
+	winrt.Object
+}
+
+// HasIPlaybackPositionChangeRequestedEventArgs returns true if the PlaybackPositionChangeRequestedEventArgs implements iPlaybackPositionChangeRequestedEventArgs.
+// Interfaces added in newer versions of Windows may not be implemented by older ones.
+func (impl *PlaybackPositionChangeRequestedEventArgs) HasIPlaybackPositionChangeRequestedEventArgs() bool {
+	return impl.HasInterface(&IIDiPlaybackPositionChangeRequestedEventArgs)
+}
+
+// GetRequestedPlaybackPosition was introduced in Windows.Foundation.UniversalApiContract v1.0.
+func (impl *PlaybackPositionChangeRequestedEventArgs) GetRequestedPlaybackPosition() (foundation.TimeSpan, error) {
+	itf, err := impl.Interface(&IIDiPlaybackPositionChangeRequestedEventArgs)
+	if err != nil {
+		return foundation.TimeSpan{}, err
+	}
+	defer impl.ReleaseInterface(itf)
+	v := (*iPlaybackPositionChangeRequestedEventArgs)(unsafe.Pointer(itf))
+	return v.GetRequestedPlaybackPosition()
+}
+
+const GUIDiPlaybackPositionChangeRequestedEventArgs string = "b4493f88-eb28-4961-9c14-335e44f3e125"
+const SignatureiPlaybackPositionChangeRequestedEventArgs string = "{b4493f88-eb28-4961-9c14-335e44f3e125}"
+
+var IIDiPlaybackPositionChangeRequestedEventArgs = ole.GUID{Data1: 0xb4493f88, Data2: 0xeb28, Data3: 0x4961, Data4: [8]byte{0x9c, 0x14, 0x33, 0x5e, 0x44, 0xf3, 0xe1, 0x25}}
+
+// iPlaybackPositionChangeRequestedEventArgs was introduced in Windows.Foundation.UniversalApiContract v1.0.
+type iPlaybackPositionChangeRequestedEventArgs struct {
+	ole.IInspectable
+}
+
+type iPlaybackPositionChangeRequestedEventArgsVtbl struct {
+	ole.IInspectableVtbl
+
+	GetRequestedPlaybackPosition uintptr
+}
+
+func (v *iPlaybackPositionChangeRequestedEventArgs) VTable() *iPlaybackPositionChangeRequestedEventArgsVtbl {
+	return (*iPlaybackPositionChangeRequestedEventArgsVtbl)(unsafe.Pointer(v.RawVTable))
+}
+
+// IsSupportedIPlaybackPositionChangeRequestedEventArgs returns true if the given object implements iPlaybackPositionChangeRequestedEventArgs.
+func IsSupportedIPlaybackPositionChangeRequestedEventArgs(obj *ole.IUnknown) bool {
+	return winrt.IsSupported(obj, &IIDiPlaybackPositionChangeRequestedEventArgs)
+}
+
+// GetRequestedPlaybackPosition was introduced in Windows.Foundation.UniversalApiContract v1.0.
+func (v *iPlaybackPositionChangeRequestedEventArgs) GetRequestedPlaybackPosition() (foundation.TimeSpan, error) {
+	var out foundation.TimeSpan
+	hr, _, _ := syscall.Syscall(
+		v.VTable().GetRequestedPlaybackPosition,
+		2,                             // nargs
+		uintptr(unsafe.Pointer(v)),    // this
+		uintptr(unsafe.Pointer(&out)), // out foundation.TimeSpan
+		0,
+	)
+
+	if hr != 0 {
+		return foundation.TimeSpan{}, ole.NewError(hr)
+	}
+
+	return out, nil
+}
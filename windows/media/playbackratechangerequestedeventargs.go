@@ -0,0 +1,80 @@
+// Code generated by winrt-go-gen. DO NOT EDIT.
+
+//go:build windows
+
+//nolint:all
+package media
+
+import (
+	"syscall"
+	"unsafe"
+
+	"github.com/go-ole/go-ole"
+	"github.com/waylyrics/winrt-go"
+)
+
+const SignaturePlaybackRateChangeRequestedEventArgs string = "rc(Windows.Media.PlaybackRateChangeRequestedEventArgs;{2ce2c41f-3cd6-4f77-9ba7-eb27c26a2140})"
+
+type PlaybackRateChangeRequestedEventArgs struct {
+	winrt.Object
+}
+
+// HasIPlaybackRateChangeRequestedEventArgs returns true if the PlaybackRateChangeRequestedEventArgs implements iPlaybackRateChangeRequestedEventArgs.
+// Interfaces added in newer versions of Windows may not be implemented by older ones.
+func (impl *PlaybackRateChangeRequestedEventArgs) HasIPlaybackRateChangeRequestedEventArgs() bool {
+	return impl.HasInterface(&IIDiPlaybackRateChangeRequestedEventArgs)
+}
+
+// GetRequestedPlaybackRate was introduced in Windows.Foundation.UniversalApiContract v1.0.
+func (impl *PlaybackRateChangeRequestedEventArgs) GetRequestedPlaybackRate() (float64, error) {
+	itf, err := impl.Interface(&IIDiPlaybackRateChangeRequestedEventArgs)
+	if err != nil {
+		return 0.0, err
+	}
+	defer impl.ReleaseInterface(itf)
+	v := (*iPlaybackRateChangeRequestedEventArgs)(unsafe.Pointer(itf))
+	return v.GetRequestedPlaybackRate()
+}
+
+const GUIDiPlaybackRateChangeRequestedEventArgs string = "2ce2c41f-3cd6-4f77-9ba7-eb27c26a2140"
+const SignatureiPlaybackRateChangeRequestedEventArgs string = "{2ce2c41f-3cd6-4f77-9ba7-eb27c26a2140}"
+
+var IIDiPlaybackRateChangeRequestedEventArgs = ole.GUID{Data1: 0x2ce2c41f, Data2: 0x3cd6, Data3: 0x4f77, Data4: [8]byte{0x9b, 0xa7, 0xeb, 0x27, 0xc2, 0x6a, 0x21, 0x40}}
+
+// iPlaybackRateChangeRequestedEventArgs was introduced in Windows.Foundation.UniversalApiContract v1.0.
+type iPlaybackRateChangeRequestedEventArgs struct {
+	ole.IInspectable
+}
+
+type iPlaybackRateChangeRequestedEventArgsVtbl struct {
+	ole.IInspectableVtbl
+
+	GetRequestedPlaybackRate uintptr
+}
+
+func (v *iPlaybackRateChangeRequestedEventArgs) VTable() *iPlaybackRateChangeRequestedEventArgsVtbl {
+	return (*iPlaybackRateChangeRequestedEventArgsVtbl)(unsafe.Pointer(v.RawVTable))
+}
+
+// IsSupportedIPlaybackRateChangeRequestedEventArgs returns true if the given object implements iPlaybackRateChangeRequestedEventArgs.
+func IsSupportedIPlaybackRateChangeRequestedEventArgs(obj *ole.IUnknown) bool {
+	return winrt.IsSupported(obj, &IIDiPlaybackRateChangeRequestedEventArgs)
+}
+
+// GetRequestedPlaybackRate was introduced in Windows.Foundation.UniversalApiContract v1.0.
+func (v *iPlaybackRateChangeRequestedEventArgs) GetRequestedPlaybackRate() (float64, error) {
+	var out float64
+	hr, _, _ := syscall.Syscall(
+		v.VTable().GetRequestedPlaybackRate,
+		2,                             // nargs
+		uintptr(unsafe.Pointer(v)),    // this
+		uintptr(unsafe.Pointer(&out)), // out float64
+		0,
+	)
+
+	if hr != 0 {
+		return 0.0, ole.NewError(hr)
+	}
+
+	return out, nil
+}
@@ -0,0 +1,80 @@
+// Code generated by winrt-go-gen. DO NOT EDIT.
+
+//go:build windows
+
+//nolint:all
+package media
+
+import (
+	"syscall"
+	"unsafe"
+
+	"github.com/go-ole/go-ole"
+	"github.com/waylyrics/winrt-go"
+)
+
+const SignatureShuffleEnabledChangeRequestedEventArgs string = "rc(Windows.Media.ShuffleEnabledChangeRequestedEventArgs;{49b593fe-4fd0-4666-a314-c0e01940d302})"
+
+type ShuffleEnabledChangeRequestedEventArgs struct {
+	winrt.Object
+}
+
+// HasIShuffleEnabledChangeRequestedEventArgs returns true if the ShuffleEnabledChangeRequestedEventArgs implements iShuffleEnabledChangeRequestedEventArgs.
+// Interfaces added in newer versions of Windows may not be implemented by older ones.
+func (impl *ShuffleEnabledChangeRequestedEventArgs) HasIShuffleEnabledChangeRequestedEventArgs() bool {
+	return impl.HasInterface(&IIDiShuffleEnabledChangeRequestedEventArgs)
+}
+
+// GetRequestedShuffleEnabled was introduced in Windows.Foundation.UniversalApiContract v1.0.
+func (impl *ShuffleEnabledChangeRequestedEventArgs) GetRequestedShuffleEnabled() (bool, error) {
+	itf, err := impl.Interface(&IIDiShuffleEnabledChangeRequestedEventArgs)
+	if err != nil {
+		return false, err
+	}
+	defer impl.ReleaseInterface(itf)
+	v := (*iShuffleEnabledChangeRequestedEventArgs)(unsafe.Pointer(itf))
+	return v.GetRequestedShuffleEnabled()
+}
+
+const GUIDiShuffleEnabledChangeRequestedEventArgs string = "49b593fe-4fd0-4666-a314-c0e01940d302"
+const SignatureiShuffleEnabledChangeRequestedEventArgs string = "{49b593fe-4fd0-4666-a314-c0e01940d302}"
+
+var IIDiShuffleEnabledChangeRequestedEventArgs = ole.GUID{Data1: 0x49b593fe, Data2: 0x4fd0, Data3: 0x4666, Data4: [8]byte{0xa3, 0x14, 0xc0, 0xe0, 0x19, 0x40, 0xd3, 0x02}}
+
+// iShuffleEnabledChangeRequestedEventArgs was introduced in Windows.Foundation.UniversalApiContract v1.0.
+type iShuffleEnabledChangeRequestedEventArgs struct {
+	ole.IInspectable
+}
+
+type iShuffleEnabledChangeRequestedEventArgsVtbl struct {
+	ole.IInspectableVtbl
+
+	GetRequestedShuffleEnabled uintptr
+}
+
+func (v *iShuffleEnabledChangeRequestedEventArgs) VTable() *iShuffleEnabledChangeRequestedEventArgsVtbl {
+	return (*iShuffleEnabledChangeRequestedEventArgsVtbl)(unsafe.Pointer(v.RawVTable))
+}
+
+// IsSupportedIShuffleEnabledChangeRequestedEventArgs returns true if the given object implements iShuffleEnabledChangeRequestedEventArgs.
+func IsSupportedIShuffleEnabledChangeRequestedEventArgs(obj *ole.IUnknown) bool {
+	return winrt.IsSupported(obj, &IIDiShuffleEnabledChangeRequestedEventArgs)
+}
+
+// GetRequestedShuffleEnabled was introduced in Windows.Foundation.UniversalApiContract v1.0.
+func (v *iShuffleEnabledChangeRequestedEventArgs) GetRequestedShuffleEnabled() (bool, error) {
+	var out bool
+	hr, _, _ := syscall.Syscall(
+		v.VTable().GetRequestedShuffleEnabled,
+		2,                             // nargs
+		uintptr(unsafe.Pointer(v)),    // this
+		uintptr(unsafe.Pointer(&out)), // out bool
+		0,
+	)
+
+	if hr != 0 {
+		return false, ole.NewError(hr)
+	}
+
+	return out, nil
+}
@@ -5,6 +5,8 @@
 //nolint:all
 package media
 
+import "fmt"
+
 type SoundLevel int32
 
 const SignatureSoundLevel string = "enum(Windows.Media.SoundLevel;i4)"
@@ -14,3 +16,47 @@ const (
 	SoundLevelLow   SoundLevel = 1
 	SoundLevelFull  SoundLevel = 2
 )
+
+var valuesSoundLevel = []SoundLevel{
+	SoundLevelMuted,
+	SoundLevelLow,
+	SoundLevelFull,
+}
+
+var namesSoundLevel = []string{
+	"Muted",
+	"Low",
+	"Full",
+}
+
+// ValuesSoundLevel returns all the values defined by SoundLevel.
+func ValuesSoundLevel() []SoundLevel {
+	return append([]SoundLevel(nil), valuesSoundLevel...)
+}
+
+// String returns the name of the value.
+func (v SoundLevel) String() string {
+	for i, value := range valuesSoundLevel {
+		if value == v {
+			return namesSoundLevel[i]
+		}
+	}
+	return fmt.Sprintf("SoundLevel(%d)", int32(v))
+}
+
+// ParseSoundLevel returns the value with the given name.
+func ParseSoundLevel(s string) (SoundLevel, error) {
+	if v, ok := lookupSoundLevel(s); ok {
+		return v, nil
+	}
+	return 0, fmt.Errorf("invalid SoundLevel %q", s)
+}
+
+func lookupSoundLevel(name string) (SoundLevel, bool) {
+	for i, n := range namesSoundLevel {
+		if n == name {
+			return valuesSoundLevel[i], true
+		}
+	}
+	return 0, false
+}
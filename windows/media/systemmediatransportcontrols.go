@@ -10,326 +10,621 @@ import (
 	"unsafe"
 
 	"github.com/go-ole/go-ole"
+	"github.com/waylyrics/winrt-go"
 	"github.com/waylyrics/winrt-go/windows/foundation"
 )
 
 const SignatureSystemMediaTransportControls string = "rc(Windows.Media.SystemMediaTransportControls;{99fa3ff4-1742-42a6-902e-087d41f965ec})"
 
 type SystemMediaTransportControls struct {
-	ole.IUnknown
+	winrt.Object
 }
 
+// HasISystemMediaTransportControls returns true if the SystemMediaTransportControls implements iSystemMediaTransportControls.
+// Interfaces added in newer versions of Windows may not be implemented by older ones.
+func (impl *SystemMediaTransportControls) HasISystemMediaTransportControls() bool {
+	return impl.HasInterface(&IIDiSystemMediaTransportControls)
+}
+
+// SubscribeButtonPressed adds a handler of the ButtonPressed event, and returns the token that removes it.
+func (impl *SystemMediaTransportControls) SubscribeButtonPressed(handler func(sender *SystemMediaTransportControls, args *SystemMediaTransportControlsButtonPressedEventArgs)) (*winrt.EventToken, error) {
+	return impl.SubscribeButtonPressedWithDispatcher(nil, handler)
+}
+
+// SubscribeButtonPressedWithDispatcher is like SubscribeButtonPressed, but the handler is run by the given dispatcher.
+// A nil dispatcher runs the handler inline.
+func (impl *SystemMediaTransportControls) SubscribeButtonPressedWithDispatcher(dispatcher *winrt.Dispatcher, handler func(sender *SystemMediaTransportControls, args *SystemMediaTransportControlsButtonPressedEventArgs)) (*winrt.EventToken, error) {
+	itf, err := impl.Interface(&IIDiSystemMediaTransportControls)
+	if err != nil {
+		return nil, err
+	}
+	defer impl.ReleaseInterface(itf)
+	v := (*iSystemMediaTransportControls)(unsafe.Pointer(itf))
+	return v.SubscribeButtonPressedWithDispatcher(dispatcher, handler)
+}
+
+// SubscribePropertyChanged adds a handler of the PropertyChanged event, and returns the token that removes it.
+func (impl *SystemMediaTransportControls) SubscribePropertyChanged(handler func(sender *SystemMediaTransportControls, args *SystemMediaTransportControlsPropertyChangedEventArgs)) (*winrt.EventToken, error) {
+	return impl.SubscribePropertyChangedWithDispatcher(nil, handler)
+}
+
+// SubscribePropertyChangedWithDispatcher is like SubscribePropertyChanged, but the handler is run by the given dispatcher.
+// A nil dispatcher runs the handler inline.
+func (impl *SystemMediaTransportControls) SubscribePropertyChangedWithDispatcher(dispatcher *winrt.Dispatcher, handler func(sender *SystemMediaTransportControls, args *SystemMediaTransportControlsPropertyChangedEventArgs)) (*winrt.EventToken, error) {
+	itf, err := impl.Interface(&IIDiSystemMediaTransportControls)
+	if err != nil {
+		return nil, err
+	}
+	defer impl.ReleaseInterface(itf)
+	v := (*iSystemMediaTransportControls)(unsafe.Pointer(itf))
+	return v.SubscribePropertyChangedWithDispatcher(dispatcher, handler)
+}
+
+// GetPlaybackStatus was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (impl *SystemMediaTransportControls) GetPlaybackStatus() (MediaPlaybackStatus, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiSystemMediaTransportControls))
-	defer itf.Release()
+	itf, err := impl.Interface(&IIDiSystemMediaTransportControls)
+	if err != nil {
+		return MediaPlaybackStatusClosed, err
+	}
+	defer impl.ReleaseInterface(itf)
 	v := (*iSystemMediaTransportControls)(unsafe.Pointer(itf))
 	return v.GetPlaybackStatus()
 }
 
+// SetPlaybackStatus was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (impl *SystemMediaTransportControls) SetPlaybackStatus(value MediaPlaybackStatus) error {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiSystemMediaTransportControls))
-	defer itf.Release()
+	itf, err := impl.Interface(&IIDiSystemMediaTransportControls)
+	if err != nil {
+		return err
+	}
+	defer impl.ReleaseInterface(itf)
 	v := (*iSystemMediaTransportControls)(unsafe.Pointer(itf))
 	return v.SetPlaybackStatus(value)
 }
 
+// GetDisplayUpdater was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (impl *SystemMediaTransportControls) GetDisplayUpdater() (*SystemMediaTransportControlsDisplayUpdater, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiSystemMediaTransportControls))
-	defer itf.Release()
+	itf, err := impl.Interface(&IIDiSystemMediaTransportControls)
+	if err != nil {
+		return nil, err
+	}
+	defer impl.ReleaseInterface(itf)
 	v := (*iSystemMediaTransportControls)(unsafe.Pointer(itf))
 	return v.GetDisplayUpdater()
 }
 
+// GetSoundLevel was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (impl *SystemMediaTransportControls) GetSoundLevel() (SoundLevel, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiSystemMediaTransportControls))
-	defer itf.Release()
+	itf, err := impl.Interface(&IIDiSystemMediaTransportControls)
+	if err != nil {
+		return SoundLevelMuted, err
+	}
+	defer impl.ReleaseInterface(itf)
 	v := (*iSystemMediaTransportControls)(unsafe.Pointer(itf))
 	return v.GetSoundLevel()
 }
 
+// GetIsEnabled was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (impl *SystemMediaTransportControls) GetIsEnabled() (bool, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiSystemMediaTransportControls))
-	defer itf.Release()
+	itf, err := impl.Interface(&IIDiSystemMediaTransportControls)
+	if err != nil {
+		return false, err
+	}
+	defer impl.ReleaseInterface(itf)
 	v := (*iSystemMediaTransportControls)(unsafe.Pointer(itf))
 	return v.GetIsEnabled()
 }
 
+// SetIsEnabled was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (impl *SystemMediaTransportControls) SetIsEnabled(value bool) error {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiSystemMediaTransportControls))
-	defer itf.Release()
+	itf, err := impl.Interface(&IIDiSystemMediaTransportControls)
+	if err != nil {
+		return err
+	}
+	defer impl.ReleaseInterface(itf)
 	v := (*iSystemMediaTransportControls)(unsafe.Pointer(itf))
 	return v.SetIsEnabled(value)
 }
 
+// GetIsPlayEnabled was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (impl *SystemMediaTransportControls) GetIsPlayEnabled() (bool, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiSystemMediaTransportControls))
-	defer itf.Release()
+	itf, err := impl.Interface(&IIDiSystemMediaTransportControls)
+	if err != nil {
+		return false, err
+	}
+	defer impl.ReleaseInterface(itf)
 	v := (*iSystemMediaTransportControls)(unsafe.Pointer(itf))
 	return v.GetIsPlayEnabled()
 }
 
+// SetIsPlayEnabled was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (impl *SystemMediaTransportControls) SetIsPlayEnabled(value bool) error {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiSystemMediaTransportControls))
-	defer itf.Release()
+	itf, err := impl.Interface(&IIDiSystemMediaTransportControls)
+	if err != nil {
+		return err
+	}
+	defer impl.ReleaseInterface(itf)
 	v := (*iSystemMediaTransportControls)(unsafe.Pointer(itf))
 	return v.SetIsPlayEnabled(value)
 }
 
+// GetIsStopEnabled was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (impl *SystemMediaTransportControls) GetIsStopEnabled() (bool, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiSystemMediaTransportControls))
-	defer itf.Release()
+	itf, err := impl.Interface(&IIDiSystemMediaTransportControls)
+	if err != nil {
+		return false, err
+	}
+	defer impl.ReleaseInterface(itf)
 	v := (*iSystemMediaTransportControls)(unsafe.Pointer(itf))
 	return v.GetIsStopEnabled()
 }
 
+// SetIsStopEnabled was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (impl *SystemMediaTransportControls) SetIsStopEnabled(value bool) error {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiSystemMediaTransportControls))
-	defer itf.Release()
+	itf, err := impl.Interface(&IIDiSystemMediaTransportControls)
+	if err != nil {
+		return err
+	}
+	defer impl.ReleaseInterface(itf)
 	v := (*iSystemMediaTransportControls)(unsafe.Pointer(itf))
 	return v.SetIsStopEnabled(value)
 }
 
+// GetIsPauseEnabled was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (impl *SystemMediaTransportControls) GetIsPauseEnabled() (bool, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiSystemMediaTransportControls))
-	defer itf.Release()
+	itf, err := impl.Interface(&IIDiSystemMediaTransportControls)
+	if err != nil {
+		return false, err
+	}
+	defer impl.ReleaseInterface(itf)
 	v := (*iSystemMediaTransportControls)(unsafe.Pointer(itf))
 	return v.GetIsPauseEnabled()
 }
 
+// SetIsPauseEnabled was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (impl *SystemMediaTransportControls) SetIsPauseEnabled(value bool) error {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiSystemMediaTransportControls))
-	defer itf.Release()
+	itf, err := impl.Interface(&IIDiSystemMediaTransportControls)
+	if err != nil {
+		return err
+	}
+	defer impl.ReleaseInterface(itf)
 	v := (*iSystemMediaTransportControls)(unsafe.Pointer(itf))
 	return v.SetIsPauseEnabled(value)
 }
 
+// GetIsRecordEnabled was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (impl *SystemMediaTransportControls) GetIsRecordEnabled() (bool, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiSystemMediaTransportControls))
-	defer itf.Release()
+	itf, err := impl.Interface(&IIDiSystemMediaTransportControls)
+	if err != nil {
+		return false, err
+	}
+	defer impl.ReleaseInterface(itf)
 	v := (*iSystemMediaTransportControls)(unsafe.Pointer(itf))
 	return v.GetIsRecordEnabled()
 }
 
+// SetIsRecordEnabled was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (impl *SystemMediaTransportControls) SetIsRecordEnabled(value bool) error {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiSystemMediaTransportControls))
-	defer itf.Release()
+	itf, err := impl.Interface(&IIDiSystemMediaTransportControls)
+	if err != nil {
+		return err
+	}
+	defer impl.ReleaseInterface(itf)
 	v := (*iSystemMediaTransportControls)(unsafe.Pointer(itf))
 	return v.SetIsRecordEnabled(value)
 }
 
+// GetIsFastForwardEnabled was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (impl *SystemMediaTransportControls) GetIsFastForwardEnabled() (bool, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiSystemMediaTransportControls))
-	defer itf.Release()
+	itf, err := impl.Interface(&IIDiSystemMediaTransportControls)
+	if err != nil {
+		return false, err
+	}
+	defer impl.ReleaseInterface(itf)
 	v := (*iSystemMediaTransportControls)(unsafe.Pointer(itf))
 	return v.GetIsFastForwardEnabled()
 }
 
+// SetIsFastForwardEnabled was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (impl *SystemMediaTransportControls) SetIsFastForwardEnabled(value bool) error {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiSystemMediaTransportControls))
-	defer itf.Release()
+	itf, err := impl.Interface(&IIDiSystemMediaTransportControls)
+	if err != nil {
+		return err
+	}
+	defer impl.ReleaseInterface(itf)
 	v := (*iSystemMediaTransportControls)(unsafe.Pointer(itf))
 	return v.SetIsFastForwardEnabled(value)
 }
 
+// GetIsRewindEnabled was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (impl *SystemMediaTransportControls) GetIsRewindEnabled() (bool, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiSystemMediaTransportControls))
-	defer itf.Release()
+	itf, err := impl.Interface(&IIDiSystemMediaTransportControls)
+	if err != nil {
+		return false, err
+	}
+	defer impl.ReleaseInterface(itf)
 	v := (*iSystemMediaTransportControls)(unsafe.Pointer(itf))
 	return v.GetIsRewindEnabled()
 }
 
+// SetIsRewindEnabled was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (impl *SystemMediaTransportControls) SetIsRewindEnabled(value bool) error {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiSystemMediaTransportControls))
-	defer itf.Release()
+	itf, err := impl.Interface(&IIDiSystemMediaTransportControls)
+	if err != nil {
+		return err
+	}
+	defer impl.ReleaseInterface(itf)
 	v := (*iSystemMediaTransportControls)(unsafe.Pointer(itf))
 	return v.SetIsRewindEnabled(value)
 }
 
+// GetIsPreviousEnabled was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (impl *SystemMediaTransportControls) GetIsPreviousEnabled() (bool, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiSystemMediaTransportControls))
-	defer itf.Release()
+	itf, err := impl.Interface(&IIDiSystemMediaTransportControls)
+	if err != nil {
+		return false, err
+	}
+	defer impl.ReleaseInterface(itf)
 	v := (*iSystemMediaTransportControls)(unsafe.Pointer(itf))
 	return v.GetIsPreviousEnabled()
 }
 
+// SetIsPreviousEnabled was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (impl *SystemMediaTransportControls) SetIsPreviousEnabled(value bool) error {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiSystemMediaTransportControls))
-	defer itf.Release()
+	itf, err := impl.Interface(&IIDiSystemMediaTransportControls)
+	if err != nil {
+		return err
+	}
+	defer impl.ReleaseInterface(itf)
 	v := (*iSystemMediaTransportControls)(unsafe.Pointer(itf))
 	return v.SetIsPreviousEnabled(value)
 }
 
+// GetIsNextEnabled was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (impl *SystemMediaTransportControls) GetIsNextEnabled() (bool, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiSystemMediaTransportControls))
-	defer itf.Release()
+	itf, err := impl.Interface(&IIDiSystemMediaTransportControls)
+	if err != nil {
+		return false, err
+	}
+	defer impl.ReleaseInterface(itf)
 	v := (*iSystemMediaTransportControls)(unsafe.Pointer(itf))
 	return v.GetIsNextEnabled()
 }
 
+// SetIsNextEnabled was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (impl *SystemMediaTransportControls) SetIsNextEnabled(value bool) error {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiSystemMediaTransportControls))
-	defer itf.Release()
+	itf, err := impl.Interface(&IIDiSystemMediaTransportControls)
+	if err != nil {
+		return err
+	}
+	defer impl.ReleaseInterface(itf)
 	v := (*iSystemMediaTransportControls)(unsafe.Pointer(itf))
 	return v.SetIsNextEnabled(value)
 }
 
+// GetIsChannelUpEnabled was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (impl *SystemMediaTransportControls) GetIsChannelUpEnabled() (bool, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiSystemMediaTransportControls))
-	defer itf.Release()
+	itf, err := impl.Interface(&IIDiSystemMediaTransportControls)
+	if err != nil {
+		return false, err
+	}
+	defer impl.ReleaseInterface(itf)
 	v := (*iSystemMediaTransportControls)(unsafe.Pointer(itf))
 	return v.GetIsChannelUpEnabled()
 }
 
+// SetIsChannelUpEnabled was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (impl *SystemMediaTransportControls) SetIsChannelUpEnabled(value bool) error {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiSystemMediaTransportControls))
-	defer itf.Release()
+	itf, err := impl.Interface(&IIDiSystemMediaTransportControls)
+	if err != nil {
+		return err
+	}
+	defer impl.ReleaseInterface(itf)
 	v := (*iSystemMediaTransportControls)(unsafe.Pointer(itf))
 	return v.SetIsChannelUpEnabled(value)
 }
 
+// GetIsChannelDownEnabled was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (impl *SystemMediaTransportControls) GetIsChannelDownEnabled() (bool, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiSystemMediaTransportControls))
-	defer itf.Release()
+	itf, err := impl.Interface(&IIDiSystemMediaTransportControls)
+	if err != nil {
+		return false, err
+	}
+	defer impl.ReleaseInterface(itf)
 	v := (*iSystemMediaTransportControls)(unsafe.Pointer(itf))
 	return v.GetIsChannelDownEnabled()
 }
 
+// SetIsChannelDownEnabled was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (impl *SystemMediaTransportControls) SetIsChannelDownEnabled(value bool) error {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiSystemMediaTransportControls))
-	defer itf.Release()
+	itf, err := impl.Interface(&IIDiSystemMediaTransportControls)
+	if err != nil {
+		return err
+	}
+	defer impl.ReleaseInterface(itf)
 	v := (*iSystemMediaTransportControls)(unsafe.Pointer(itf))
 	return v.SetIsChannelDownEnabled(value)
 }
 
+// AddButtonPressed was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (impl *SystemMediaTransportControls) AddButtonPressed(handler *foundation.TypedEventHandler) (foundation.EventRegistrationToken, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiSystemMediaTransportControls))
-	defer itf.Release()
+	itf, err := impl.Interface(&IIDiSystemMediaTransportControls)
+	if err != nil {
+		return foundation.EventRegistrationToken{}, err
+	}
+	defer impl.ReleaseInterface(itf)
 	v := (*iSystemMediaTransportControls)(unsafe.Pointer(itf))
 	return v.AddButtonPressed(handler)
 }
 
+// RemoveButtonPressed was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (impl *SystemMediaTransportControls) RemoveButtonPressed(token foundation.EventRegistrationToken) error {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiSystemMediaTransportControls))
-	defer itf.Release()
+	itf, err := impl.Interface(&IIDiSystemMediaTransportControls)
+	if err != nil {
+		return err
+	}
+	defer impl.ReleaseInterface(itf)
 	v := (*iSystemMediaTransportControls)(unsafe.Pointer(itf))
 	return v.RemoveButtonPressed(token)
 }
 
+// AddPropertyChanged was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (impl *SystemMediaTransportControls) AddPropertyChanged(handler *foundation.TypedEventHandler) (foundation.EventRegistrationToken, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiSystemMediaTransportControls))
-	defer itf.Release()
+	itf, err := impl.Interface(&IIDiSystemMediaTransportControls)
+	if err != nil {
+		return foundation.EventRegistrationToken{}, err
+	}
+	defer impl.ReleaseInterface(itf)
 	v := (*iSystemMediaTransportControls)(unsafe.Pointer(itf))
 	return v.AddPropertyChanged(handler)
 }
 
+// RemovePropertyChanged was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (impl *SystemMediaTransportControls) RemovePropertyChanged(token foundation.EventRegistrationToken) error {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiSystemMediaTransportControls))
-	defer itf.Release()
+	itf, err := impl.Interface(&IIDiSystemMediaTransportControls)
+	if err != nil {
+		return err
+	}
+	defer impl.ReleaseInterface(itf)
 	v := (*iSystemMediaTransportControls)(unsafe.Pointer(itf))
 	return v.RemovePropertyChanged(token)
 }
 
+// HasISystemMediaTransportControls2 returns true if the SystemMediaTransportControls implements iSystemMediaTransportControls2.
+// Interfaces added in newer versions of Windows may not be implemented by older ones.
+func (impl *SystemMediaTransportControls) HasISystemMediaTransportControls2() bool {
+	return impl.HasInterface(&IIDiSystemMediaTransportControls2)
+}
+
+// SubscribePlaybackPositionChangeRequested adds a handler of the PlaybackPositionChangeRequested event, and returns the token that removes it.
+func (impl *SystemMediaTransportControls) SubscribePlaybackPositionChangeRequested(handler func(sender *SystemMediaTransportControls, args *PlaybackPositionChangeRequestedEventArgs)) (*winrt.EventToken, error) {
+	return impl.SubscribePlaybackPositionChangeRequestedWithDispatcher(nil, handler)
+}
+
+// SubscribePlaybackPositionChangeRequestedWithDispatcher is like SubscribePlaybackPositionChangeRequested, but the handler is run by the given dispatcher.
+// A nil dispatcher runs the handler inline.
+func (impl *SystemMediaTransportControls) SubscribePlaybackPositionChangeRequestedWithDispatcher(dispatcher *winrt.Dispatcher, handler func(sender *SystemMediaTransportControls, args *PlaybackPositionChangeRequestedEventArgs)) (*winrt.EventToken, error) {
+	itf, err := impl.Interface(&IIDiSystemMediaTransportControls2)
+	if err != nil {
+		return nil, err
+	}
+	defer impl.ReleaseInterface(itf)
+	v := (*iSystemMediaTransportControls2)(unsafe.Pointer(itf))
+	return v.SubscribePlaybackPositionChangeRequestedWithDispatcher(dispatcher, handler)
+}
+
+// SubscribePlaybackRateChangeRequested adds a handler of the PlaybackRateChangeRequested event, and returns the token that removes it.
+func (impl *SystemMediaTransportControls) SubscribePlaybackRateChangeRequested(handler func(sender *SystemMediaTransportControls, args *PlaybackRateChangeRequestedEventArgs)) (*winrt.EventToken, error) {
+	return impl.SubscribePlaybackRateChangeRequestedWithDispatcher(nil, handler)
+}
+
+// SubscribePlaybackRateChangeRequestedWithDispatcher is like SubscribePlaybackRateChangeRequested, but the handler is run by the given dispatcher.
+// A nil dispatcher runs the handler inline.
+func (impl *SystemMediaTransportControls) SubscribePlaybackRateChangeRequestedWithDispatcher(dispatcher *winrt.Dispatcher, handler func(sender *SystemMediaTransportControls, args *PlaybackRateChangeRequestedEventArgs)) (*winrt.EventToken, error) {
+	itf, err := impl.Interface(&IIDiSystemMediaTransportControls2)
+	if err != nil {
+		return nil, err
+	}
+	defer impl.ReleaseInterface(itf)
+	v := (*iSystemMediaTransportControls2)(unsafe.Pointer(itf))
+	return v.SubscribePlaybackRateChangeRequestedWithDispatcher(dispatcher, handler)
+}
+
+// SubscribeShuffleEnabledChangeRequested adds a handler of the ShuffleEnabledChangeRequested event, and returns the token that removes it.
+func (impl *SystemMediaTransportControls) SubscribeShuffleEnabledChangeRequested(handler func(sender *SystemMediaTransportControls, args *ShuffleEnabledChangeRequestedEventArgs)) (*winrt.EventToken, error) {
+	return impl.SubscribeShuffleEnabledChangeRequestedWithDispatcher(nil, handler)
+}
+
+// SubscribeShuffleEnabledChangeRequestedWithDispatcher is like SubscribeShuffleEnabledChangeRequested, but the handler is run by the given dispatcher.
+// A nil dispatcher runs the handler inline.
+func (impl *SystemMediaTransportControls) SubscribeShuffleEnabledChangeRequestedWithDispatcher(dispatcher *winrt.Dispatcher, handler func(sender *SystemMediaTransportControls, args *ShuffleEnabledChangeRequestedEventArgs)) (*winrt.EventToken, error) {
+	itf, err := impl.Interface(&IIDiSystemMediaTransportControls2)
+	if err != nil {
+		return nil, err
+	}
+	defer impl.ReleaseInterface(itf)
+	v := (*iSystemMediaTransportControls2)(unsafe.Pointer(itf))
+	return v.SubscribeShuffleEnabledChangeRequestedWithDispatcher(dispatcher, handler)
+}
+
+// SubscribeAutoRepeatModeChangeRequested adds a handler of the AutoRepeatModeChangeRequested event, and returns the token that removes it.
+func (impl *SystemMediaTransportControls) SubscribeAutoRepeatModeChangeRequested(handler func(sender *SystemMediaTransportControls, args *AutoRepeatModeChangeRequestedEventArgs)) (*winrt.EventToken, error) {
+	return impl.SubscribeAutoRepeatModeChangeRequestedWithDispatcher(nil, handler)
+}
+
+// SubscribeAutoRepeatModeChangeRequestedWithDispatcher is like SubscribeAutoRepeatModeChangeRequested, but the handler is run by the given dispatcher.
+// A nil dispatcher runs the handler inline.
+func (impl *SystemMediaTransportControls) SubscribeAutoRepeatModeChangeRequestedWithDispatcher(dispatcher *winrt.Dispatcher, handler func(sender *SystemMediaTransportControls, args *AutoRepeatModeChangeRequestedEventArgs)) (*winrt.EventToken, error) {
+	itf, err := impl.Interface(&IIDiSystemMediaTransportControls2)
+	if err != nil {
+		return nil, err
+	}
+	defer impl.ReleaseInterface(itf)
+	v := (*iSystemMediaTransportControls2)(unsafe.Pointer(itf))
+	return v.SubscribeAutoRepeatModeChangeRequestedWithDispatcher(dispatcher, handler)
+}
+
+// GetAutoRepeatMode was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (impl *SystemMediaTransportControls) GetAutoRepeatMode() (MediaPlaybackAutoRepeatMode, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiSystemMediaTransportControls2))
-	defer itf.Release()
+	itf, err := impl.Interface(&IIDiSystemMediaTransportControls2)
+	if err != nil {
+		return MediaPlaybackAutoRepeatModeNone, err
+	}
+	defer impl.ReleaseInterface(itf)
 	v := (*iSystemMediaTransportControls2)(unsafe.Pointer(itf))
 	return v.GetAutoRepeatMode()
 }
 
+// SetAutoRepeatMode was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (impl *SystemMediaTransportControls) SetAutoRepeatMode(value MediaPlaybackAutoRepeatMode) error {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiSystemMediaTransportControls2))
-	defer itf.Release()
+	itf, err := impl.Interface(&IIDiSystemMediaTransportControls2)
+	if err != nil {
+		return err
+	}
+	defer impl.ReleaseInterface(itf)
 	v := (*iSystemMediaTransportControls2)(unsafe.Pointer(itf))
 	return v.SetAutoRepeatMode(value)
 }
 
+// GetShuffleEnabled was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (impl *SystemMediaTransportControls) GetShuffleEnabled() (bool, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiSystemMediaTransportControls2))
-	defer itf.Release()
+	itf, err := impl.Interface(&IIDiSystemMediaTransportControls2)
+	if err != nil {
+		return false, err
+	}
+	defer impl.ReleaseInterface(itf)
 	v := (*iSystemMediaTransportControls2)(unsafe.Pointer(itf))
 	return v.GetShuffleEnabled()
 }
 
+// SetShuffleEnabled was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (impl *SystemMediaTransportControls) SetShuffleEnabled(value bool) error {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiSystemMediaTransportControls2))
-	defer itf.Release()
+	itf, err := impl.Interface(&IIDiSystemMediaTransportControls2)
+	if err != nil {
+		return err
+	}
+	defer impl.ReleaseInterface(itf)
 	v := (*iSystemMediaTransportControls2)(unsafe.Pointer(itf))
 	return v.SetShuffleEnabled(value)
 }
 
+// GetPlaybackRate was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (impl *SystemMediaTransportControls) GetPlaybackRate() (float64, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiSystemMediaTransportControls2))
-	defer itf.Release()
+	itf, err := impl.Interface(&IIDiSystemMediaTransportControls2)
+	if err != nil {
+		return 0.0, err
+	}
+	defer impl.ReleaseInterface(itf)
 	v := (*iSystemMediaTransportControls2)(unsafe.Pointer(itf))
 	return v.GetPlaybackRate()
 }
 
+// SetPlaybackRate was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (impl *SystemMediaTransportControls) SetPlaybackRate(value float64) error {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiSystemMediaTransportControls2))
-	defer itf.Release()
+	itf, err := impl.Interface(&IIDiSystemMediaTransportControls2)
+	if err != nil {
+		return err
+	}
+	defer impl.ReleaseInterface(itf)
 	v := (*iSystemMediaTransportControls2)(unsafe.Pointer(itf))
 	return v.SetPlaybackRate(value)
 }
 
+// UpdateTimelineProperties was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (impl *SystemMediaTransportControls) UpdateTimelineProperties(timelineProperties *SystemMediaTransportControlsTimelineProperties) error {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiSystemMediaTransportControls2))
-	defer itf.Release()
+	itf, err := impl.Interface(&IIDiSystemMediaTransportControls2)
+	if err != nil {
+		return err
+	}
+	defer impl.ReleaseInterface(itf)
 	v := (*iSystemMediaTransportControls2)(unsafe.Pointer(itf))
 	return v.UpdateTimelineProperties(timelineProperties)
 }
 
+// AddPlaybackPositionChangeRequested was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (impl *SystemMediaTransportControls) AddPlaybackPositionChangeRequested(handler *foundation.TypedEventHandler) (foundation.EventRegistrationToken, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiSystemMediaTransportControls2))
-	defer itf.Release()
+	itf, err := impl.Interface(&IIDiSystemMediaTransportControls2)
+	if err != nil {
+		return foundation.EventRegistrationToken{}, err
+	}
+	defer impl.ReleaseInterface(itf)
 	v := (*iSystemMediaTransportControls2)(unsafe.Pointer(itf))
 	return v.AddPlaybackPositionChangeRequested(handler)
 }
 
+// RemovePlaybackPositionChangeRequested was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (impl *SystemMediaTransportControls) RemovePlaybackPositionChangeRequested(token foundation.EventRegistrationToken) error {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiSystemMediaTransportControls2))
-	defer itf.Release()
+	itf, err := impl.Interface(&IIDiSystemMediaTransportControls2)
+	if err != nil {
+		return err
+	}
+	defer impl.ReleaseInterface(itf)
 	v := (*iSystemMediaTransportControls2)(unsafe.Pointer(itf))
 	return v.RemovePlaybackPositionChangeRequested(token)
 }
 
+// AddPlaybackRateChangeRequested was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (impl *SystemMediaTransportControls) AddPlaybackRateChangeRequested(handler *foundation.TypedEventHandler) (foundation.EventRegistrationToken, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiSystemMediaTransportControls2))
-	defer itf.Release()
+	itf, err := impl.Interface(&IIDiSystemMediaTransportControls2)
+	if err != nil {
+		return foundation.EventRegistrationToken{}, err
+	}
+	defer impl.ReleaseInterface(itf)
 	v := (*iSystemMediaTransportControls2)(unsafe.Pointer(itf))
 	return v.AddPlaybackRateChangeRequested(handler)
 }
 
+// RemovePlaybackRateChangeRequested was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (impl *SystemMediaTransportControls) RemovePlaybackRateChangeRequested(token foundation.EventRegistrationToken) error {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiSystemMediaTransportControls2))
-	defer itf.Release()
+	itf, err := impl.Interface(&IIDiSystemMediaTransportControls2)
+	if err != nil {
+		return err
+	}
+	defer impl.ReleaseInterface(itf)
 	v := (*iSystemMediaTransportControls2)(unsafe.Pointer(itf))
 	return v.RemovePlaybackRateChangeRequested(token)
 }
 
+// AddShuffleEnabledChangeRequested was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (impl *SystemMediaTransportControls) AddShuffleEnabledChangeRequested(handler *foundation.TypedEventHandler) (foundation.EventRegistrationToken, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiSystemMediaTransportControls2))
-	defer itf.Release()
+	itf, err := impl.Interface(&IIDiSystemMediaTransportControls2)
+	if err != nil {
+		return foundation.EventRegistrationToken{}, err
+	}
+	defer impl.ReleaseInterface(itf)
 	v := (*iSystemMediaTransportControls2)(unsafe.Pointer(itf))
 	return v.AddShuffleEnabledChangeRequested(handler)
 }
 
+// RemoveShuffleEnabledChangeRequested was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (impl *SystemMediaTransportControls) RemoveShuffleEnabledChangeRequested(token foundation.EventRegistrationToken) error {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiSystemMediaTransportControls2))
-	defer itf.Release()
+	itf, err := impl.Interface(&IIDiSystemMediaTransportControls2)
+	if err != nil {
+		return err
+	}
+	defer impl.ReleaseInterface(itf)
 	v := (*iSystemMediaTransportControls2)(unsafe.Pointer(itf))
 	return v.RemoveShuffleEnabledChangeRequested(token)
 }
 
+// AddAutoRepeatModeChangeRequested was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (impl *SystemMediaTransportControls) AddAutoRepeatModeChangeRequested(handler *foundation.TypedEventHandler) (foundation.EventRegistrationToken, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiSystemMediaTransportControls2))
-	defer itf.Release()
+	itf, err := impl.Interface(&IIDiSystemMediaTransportControls2)
+	if err != nil {
+		return foundation.EventRegistrationToken{}, err
+	}
+	defer impl.ReleaseInterface(itf)
 	v := (*iSystemMediaTransportControls2)(unsafe.Pointer(itf))
 	return v.AddAutoRepeatModeChangeRequested(handler)
 }
 
+// RemoveAutoRepeatModeChangeRequested was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (impl *SystemMediaTransportControls) RemoveAutoRepeatModeChangeRequested(token foundation.EventRegistrationToken) error {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiSystemMediaTransportControls2))
-	defer itf.Release()
+	itf, err := impl.Interface(&IIDiSystemMediaTransportControls2)
+	if err != nil {
+		return err
+	}
+	defer impl.ReleaseInterface(itf)
 	v := (*iSystemMediaTransportControls2)(unsafe.Pointer(itf))
 	return v.RemoveAutoRepeatModeChangeRequested(token)
 }
@@ -337,6 +632,9 @@ func (impl *SystemMediaTransportControls) RemoveAutoRepeatModeChangeRequested(to
 const GUIDiSystemMediaTransportControls string = "99fa3ff4-1742-42a6-902e-087d41f965ec"
 const SignatureiSystemMediaTransportControls string = "{99fa3ff4-1742-42a6-902e-087d41f965ec}"
 
+var IIDiSystemMediaTransportControls = ole.GUID{Data1: 0x99fa3ff4, Data2: 0x1742, Data3: 0x42a6, Data4: [8]byte{0x90, 0x2e, 0x08, 0x7d, 0x41, 0xf9, 0x65, 0xec}}
+
+// iSystemMediaTransportControls was introduced in Windows.Foundation.UniversalApiContract v1.0.
 type iSystemMediaTransportControls struct {
 	ole.IInspectable
 }
@@ -380,12 +678,20 @@ func (v *iSystemMediaTransportControls) VTable() *iSystemMediaTransportControlsV
 	return (*iSystemMediaTransportControlsVtbl)(unsafe.Pointer(v.RawVTable))
 }
 
+// IsSupportedISystemMediaTransportControls returns true if the given object implements iSystemMediaTransportControls.
+func IsSupportedISystemMediaTransportControls(obj *ole.IUnknown) bool {
+	return winrt.IsSupported(obj, &IIDiSystemMediaTransportControls)
+}
+
+// GetPlaybackStatus was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (v *iSystemMediaTransportControls) GetPlaybackStatus() (MediaPlaybackStatus, error) {
 	var out MediaPlaybackStatus
-	hr, _, _ := syscall.SyscallN(
+	hr, _, _ := syscall.Syscall(
 		v.VTable().GetPlaybackStatus,
+		2,                             // nargs
 		uintptr(unsafe.Pointer(v)),    // this
 		uintptr(unsafe.Pointer(&out)), // out MediaPlaybackStatus
+		0,
 	)
 
 	if hr != 0 {
@@ -395,11 +701,14 @@ func (v *iSystemMediaTransportControls) GetPlaybackStatus() (MediaPlaybackStatus
 	return out, nil
 }
 
+// SetPlaybackStatus was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (v *iSystemMediaTransportControls) SetPlaybackStatus(value MediaPlaybackStatus) error {
-	hr, _, _ := syscall.SyscallN(
+	hr, _, _ := syscall.Syscall(
 		v.VTable().SetPlaybackStatus,
+		2,                          // nargs
 		uintptr(unsafe.Pointer(v)), // this
 		uintptr(value),             // in MediaPlaybackStatus
+		0,
 	)
 
 	if hr != 0 {
@@ -409,27 +718,34 @@ func (v *iSystemMediaTransportControls) SetPlaybackStatus(value MediaPlaybackSta
 	return nil
 }
 
+// GetDisplayUpdater was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (v *iSystemMediaTransportControls) GetDisplayUpdater() (*SystemMediaTransportControlsDisplayUpdater, error) {
-	var out *SystemMediaTransportControlsDisplayUpdater
-	hr, _, _ := syscall.SyscallN(
+	var outPtr *ole.IUnknown
+	hr, _, _ := syscall.Syscall(
 		v.VTable().GetDisplayUpdater,
-		uintptr(unsafe.Pointer(v)),    // this
-		uintptr(unsafe.Pointer(&out)), // out SystemMediaTransportControlsDisplayUpdater
+		2,                                // nargs
+		uintptr(unsafe.Pointer(v)),       // this
+		uintptr(unsafe.Pointer(&outPtr)), // out SystemMediaTransportControlsDisplayUpdater
+		0,
 	)
 
 	if hr != 0 {
 		return nil, ole.NewError(hr)
 	}
 
+	out := (*SystemMediaTransportControlsDisplayUpdater)(unsafe.Pointer(winrt.NewObject(outPtr)))
 	return out, nil
 }
 
+// GetSoundLevel was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (v *iSystemMediaTransportControls) GetSoundLevel() (SoundLevel, error) {
 	var out SoundLevel
-	hr, _, _ := syscall.SyscallN(
+	hr, _, _ := syscall.Syscall(
 		v.VTable().GetSoundLevel,
+		2,                             // nargs
 		uintptr(unsafe.Pointer(v)),    // this
 		uintptr(unsafe.Pointer(&out)), // out SoundLevel
+		0,
 	)
 
 	if hr != 0 {
@@ -439,12 +755,15 @@ func (v *iSystemMediaTransportControls) GetSoundLevel() (SoundLevel, error) {
 	return out, nil
 }
 
+// GetIsEnabled was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (v *iSystemMediaTransportControls) GetIsEnabled() (bool, error) {
 	var out bool
-	hr, _, _ := syscall.SyscallN(
+	hr, _, _ := syscall.Syscall(
 		v.VTable().GetIsEnabled,
+		2,                             // nargs
 		uintptr(unsafe.Pointer(v)),    // this
 		uintptr(unsafe.Pointer(&out)), // out bool
+		0,
 	)
 
 	if hr != 0 {
@@ -454,11 +773,14 @@ func (v *iSystemMediaTransportControls) GetIsEnabled() (bool, error) {
 	return out, nil
 }
 
+// SetIsEnabled was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (v *iSystemMediaTransportControls) SetIsEnabled(value bool) error {
-	hr, _, _ := syscall.SyscallN(
+	hr, _, _ := syscall.Syscall(
 		v.VTable().SetIsEnabled,
-		uintptr(unsafe.Pointer(v)),                // this
+		2,                          // nargs
+		uintptr(unsafe.Pointer(v)), // this
 		uintptr(*(*byte)(unsafe.Pointer(&value))), // in bool
+		0,
 	)
 
 	if hr != 0 {
@@ -468,12 +790,15 @@ func (v *iSystemMediaTransportControls) SetIsEnabled(value bool) error {
 	return nil
 }
 
+// GetIsPlayEnabled was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (v *iSystemMediaTransportControls) GetIsPlayEnabled() (bool, error) {
 	var out bool
-	hr, _, _ := syscall.SyscallN(
+	hr, _, _ := syscall.Syscall(
 		v.VTable().GetIsPlayEnabled,
+		2,                             // nargs
 		uintptr(unsafe.Pointer(v)),    // this
 		uintptr(unsafe.Pointer(&out)), // out bool
+		0,
 	)
 
 	if hr != 0 {
@@ -483,11 +808,14 @@ func (v *iSystemMediaTransportControls) GetIsPlayEnabled() (bool, error) {
 	return out, nil
 }
 
+// SetIsPlayEnabled was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (v *iSystemMediaTransportControls) SetIsPlayEnabled(value bool) error {
-	hr, _, _ := syscall.SyscallN(
+	hr, _, _ := syscall.Syscall(
 		v.VTable().SetIsPlayEnabled,
-		uintptr(unsafe.Pointer(v)),                // this
+		2,                          // nargs
+		uintptr(unsafe.Pointer(v)), // this
 		uintptr(*(*byte)(unsafe.Pointer(&value))), // in bool
+		0,
 	)
 
 	if hr != 0 {
@@ -497,12 +825,15 @@ func (v *iSystemMediaTransportControls) SetIsPlayEnabled(value bool) error {
 	return nil
 }
 
+// GetIsStopEnabled was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (v *iSystemMediaTransportControls) GetIsStopEnabled() (bool, error) {
 	var out bool
-	hr, _, _ := syscall.SyscallN(
+	hr, _, _ := syscall.Syscall(
 		v.VTable().GetIsStopEnabled,
+		2,                             // nargs
 		uintptr(unsafe.Pointer(v)),    // this
 		uintptr(unsafe.Pointer(&out)), // out bool
+		0,
 	)
 
 	if hr != 0 {
@@ -512,11 +843,14 @@ func (v *iSystemMediaTransportControls) GetIsStopEnabled() (bool, error) {
 	return out, nil
 }
 
+// SetIsStopEnabled was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (v *iSystemMediaTransportControls) SetIsStopEnabled(value bool) error {
-	hr, _, _ := syscall.SyscallN(
+	hr, _, _ := syscall.Syscall(
 		v.VTable().SetIsStopEnabled,
-		uintptr(unsafe.Pointer(v)),                // this
+		2,                          // nargs
+		uintptr(unsafe.Pointer(v)), // this
 		uintptr(*(*byte)(unsafe.Pointer(&value))), // in bool
+		0,
 	)
 
 	if hr != 0 {
@@ -526,12 +860,15 @@ func (v *iSystemMediaTransportControls) SetIsStopEnabled(value bool) error {
 	return nil
 }
 
+// GetIsPauseEnabled was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (v *iSystemMediaTransportControls) GetIsPauseEnabled() (bool, error) {
 	var out bool
-	hr, _, _ := syscall.SyscallN(
+	hr, _, _ := syscall.Syscall(
 		v.VTable().GetIsPauseEnabled,
+		2,                             // nargs
 		uintptr(unsafe.Pointer(v)),    // this
 		uintptr(unsafe.Pointer(&out)), // out bool
+		0,
 	)
 
 	if hr != 0 {
@@ -541,11 +878,14 @@ func (v *iSystemMediaTransportControls) GetIsPauseEnabled() (bool, error) {
 	return out, nil
 }
 
+// SetIsPauseEnabled was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (v *iSystemMediaTransportControls) SetIsPauseEnabled(value bool) error {
-	hr, _, _ := syscall.SyscallN(
+	hr, _, _ := syscall.Syscall(
 		v.VTable().SetIsPauseEnabled,
-		uintptr(unsafe.Pointer(v)),                // this
+		2,                          // nargs
+		uintptr(unsafe.Pointer(v)), // this
 		uintptr(*(*byte)(unsafe.Pointer(&value))), // in bool
+		0,
 	)
 
 	if hr != 0 {
@@ -555,12 +895,15 @@ func (v *iSystemMediaTransportControls) SetIsPauseEnabled(value bool) error {
 	return nil
 }
 
+// GetIsRecordEnabled was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (v *iSystemMediaTransportControls) GetIsRecordEnabled() (bool, error) {
 	var out bool
-	hr, _, _ := syscall.SyscallN(
+	hr, _, _ := syscall.Syscall(
 		v.VTable().GetIsRecordEnabled,
+		2,                             // nargs
 		uintptr(unsafe.Pointer(v)),    // this
 		uintptr(unsafe.Pointer(&out)), // out bool
+		0,
 	)
 
 	if hr != 0 {
@@ -570,11 +913,14 @@ func (v *iSystemMediaTransportControls) GetIsRecordEnabled() (bool, error) {
 	return out, nil
 }
 
+// SetIsRecordEnabled was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (v *iSystemMediaTransportControls) SetIsRecordEnabled(value bool) error {
-	hr, _, _ := syscall.SyscallN(
+	hr, _, _ := syscall.Syscall(
 		v.VTable().SetIsRecordEnabled,
-		uintptr(unsafe.Pointer(v)),                // this
+		2,                          // nargs
+		uintptr(unsafe.Pointer(v)), // this
 		uintptr(*(*byte)(unsafe.Pointer(&value))), // in bool
+		0,
 	)
 
 	if hr != 0 {
@@ -584,12 +930,15 @@ func (v *iSystemMediaTransportControls) SetIsRecordEnabled(value bool) error {
 	return nil
 }
 
+// GetIsFastForwardEnabled was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (v *iSystemMediaTransportControls) GetIsFastForwardEnabled() (bool, error) {
 	var out bool
-	hr, _, _ := syscall.SyscallN(
+	hr, _, _ := syscall.Syscall(
 		v.VTable().GetIsFastForwardEnabled,
+		2,                             // nargs
 		uintptr(unsafe.Pointer(v)),    // this
 		uintptr(unsafe.Pointer(&out)), // out bool
+		0,
 	)
 
 	if hr != 0 {
@@ -599,11 +948,14 @@ func (v *iSystemMediaTransportControls) GetIsFastForwardEnabled() (bool, error)
 	return out, nil
 }
 
+// SetIsFastForwardEnabled was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (v *iSystemMediaTransportControls) SetIsFastForwardEnabled(value bool) error {
-	hr, _, _ := syscall.SyscallN(
+	hr, _, _ := syscall.Syscall(
 		v.VTable().SetIsFastForwardEnabled,
-		uintptr(unsafe.Pointer(v)),                // this
+		2,                          // nargs
+		uintptr(unsafe.Pointer(v)), // this
 		uintptr(*(*byte)(unsafe.Pointer(&value))), // in bool
+		0,
 	)
 
 	if hr != 0 {
@@ -613,12 +965,15 @@ func (v *iSystemMediaTransportControls) SetIsFastForwardEnabled(value bool) erro
 	return nil
 }
 
+// GetIsRewindEnabled was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (v *iSystemMediaTransportControls) GetIsRewindEnabled() (bool, error) {
 	var out bool
-	hr, _, _ := syscall.SyscallN(
+	hr, _, _ := syscall.Syscall(
 		v.VTable().GetIsRewindEnabled,
+		2,                             // nargs
 		uintptr(unsafe.Pointer(v)),    // this
 		uintptr(unsafe.Pointer(&out)), // out bool
+		0,
 	)
 
 	if hr != 0 {
@@ -628,11 +983,14 @@ func (v *iSystemMediaTransportControls) GetIsRewindEnabled() (bool, error) {
 	return out, nil
 }
 
+// SetIsRewindEnabled was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (v *iSystemMediaTransportControls) SetIsRewindEnabled(value bool) error {
-	hr, _, _ := syscall.SyscallN(
+	hr, _, _ := syscall.Syscall(
 		v.VTable().SetIsRewindEnabled,
-		uintptr(unsafe.Pointer(v)),                // this
+		2,                          // nargs
+		uintptr(unsafe.Pointer(v)), // this
 		uintptr(*(*byte)(unsafe.Pointer(&value))), // in bool
+		0,
 	)
 
 	if hr != 0 {
@@ -642,12 +1000,15 @@ func (v *iSystemMediaTransportControls) SetIsRewindEnabled(value bool) error {
 	return nil
 }
 
+// GetIsPreviousEnabled was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (v *iSystemMediaTransportControls) GetIsPreviousEnabled() (bool, error) {
 	var out bool
-	hr, _, _ := syscall.SyscallN(
+	hr, _, _ := syscall.Syscall(
 		v.VTable().GetIsPreviousEnabled,
+		2,                             // nargs
 		uintptr(unsafe.Pointer(v)),    // this
 		uintptr(unsafe.Pointer(&out)), // out bool
+		0,
 	)
 
 	if hr != 0 {
@@ -657,11 +1018,14 @@ func (v *iSystemMediaTransportControls) GetIsPreviousEnabled() (bool, error) {
 	return out, nil
 }
 
+// SetIsPreviousEnabled was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (v *iSystemMediaTransportControls) SetIsPreviousEnabled(value bool) error {
-	hr, _, _ := syscall.SyscallN(
+	hr, _, _ := syscall.Syscall(
 		v.VTable().SetIsPreviousEnabled,
-		uintptr(unsafe.Pointer(v)),                // this
+		2,                          // nargs
+		uintptr(unsafe.Pointer(v)), // this
 		uintptr(*(*byte)(unsafe.Pointer(&value))), // in bool
+		0,
 	)
 
 	if hr != 0 {
@@ -671,12 +1035,15 @@ func (v *iSystemMediaTransportControls) SetIsPreviousEnabled(value bool) error {
 	return nil
 }
 
+// GetIsNextEnabled was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (v *iSystemMediaTransportControls) GetIsNextEnabled() (bool, error) {
 	var out bool
-	hr, _, _ := syscall.SyscallN(
+	hr, _, _ := syscall.Syscall(
 		v.VTable().GetIsNextEnabled,
+		2,                             // nargs
 		uintptr(unsafe.Pointer(v)),    // this
 		uintptr(unsafe.Pointer(&out)), // out bool
+		0,
 	)
 
 	if hr != 0 {
@@ -686,11 +1053,14 @@ func (v *iSystemMediaTransportControls) GetIsNextEnabled() (bool, error) {
 	return out, nil
 }
 
+// SetIsNextEnabled was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (v *iSystemMediaTransportControls) SetIsNextEnabled(value bool) error {
-	hr, _, _ := syscall.SyscallN(
+	hr, _, _ := syscall.Syscall(
 		v.VTable().SetIsNextEnabled,
-		uintptr(unsafe.Pointer(v)),                // this
+		2,                          // nargs
+		uintptr(unsafe.Pointer(v)), // this
 		uintptr(*(*byte)(unsafe.Pointer(&value))), // in bool
+		0,
 	)
 
 	if hr != 0 {
@@ -700,12 +1070,15 @@ func (v *iSystemMediaTransportControls) SetIsNextEnabled(value bool) error {
 	return nil
 }
 
+// GetIsChannelUpEnabled was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (v *iSystemMediaTransportControls) GetIsChannelUpEnabled() (bool, error) {
 	var out bool
-	hr, _, _ := syscall.SyscallN(
+	hr, _, _ := syscall.Syscall(
 		v.VTable().GetIsChannelUpEnabled,
+		2,                             // nargs
 		uintptr(unsafe.Pointer(v)),    // this
 		uintptr(unsafe.Pointer(&out)), // out bool
+		0,
 	)
 
 	if hr != 0 {
@@ -715,11 +1088,14 @@ func (v *iSystemMediaTransportControls) GetIsChannelUpEnabled() (bool, error) {
 	return out, nil
 }
 
+// SetIsChannelUpEnabled was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (v *iSystemMediaTransportControls) SetIsChannelUpEnabled(value bool) error {
-	hr, _, _ := syscall.SyscallN(
+	hr, _, _ := syscall.Syscall(
 		v.VTable().SetIsChannelUpEnabled,
-		uintptr(unsafe.Pointer(v)),                // this
+		2,                          // nargs
+		uintptr(unsafe.Pointer(v)), // this
 		uintptr(*(*byte)(unsafe.Pointer(&value))), // in bool
+		0,
 	)
 
 	if hr != 0 {
@@ -729,12 +1105,15 @@ func (v *iSystemMediaTransportControls) SetIsChannelUpEnabled(value bool) error
 	return nil
 }
 
+// GetIsChannelDownEnabled was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (v *iSystemMediaTransportControls) GetIsChannelDownEnabled() (bool, error) {
 	var out bool
-	hr, _, _ := syscall.SyscallN(
+	hr, _, _ := syscall.Syscall(
 		v.VTable().GetIsChannelDownEnabled,
+		2,                             // nargs
 		uintptr(unsafe.Pointer(v)),    // this
 		uintptr(unsafe.Pointer(&out)), // out bool
+		0,
 	)
 
 	if hr != 0 {
@@ -744,11 +1123,14 @@ func (v *iSystemMediaTransportControls) GetIsChannelDownEnabled() (bool, error)
 	return out, nil
 }
 
+// SetIsChannelDownEnabled was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (v *iSystemMediaTransportControls) SetIsChannelDownEnabled(value bool) error {
-	hr, _, _ := syscall.SyscallN(
+	hr, _, _ := syscall.Syscall(
 		v.VTable().SetIsChannelDownEnabled,
-		uintptr(unsafe.Pointer(v)),                // this
+		2,                          // nargs
+		uintptr(unsafe.Pointer(v)), // this
 		uintptr(*(*byte)(unsafe.Pointer(&value))), // in bool
+		0,
 	)
 
 	if hr != 0 {
@@ -758,10 +1140,12 @@ func (v *iSystemMediaTransportControls) SetIsChannelDownEnabled(value bool) erro
 	return nil
 }
 
+// AddButtonPressed was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (v *iSystemMediaTransportControls) AddButtonPressed(handler *foundation.TypedEventHandler) (foundation.EventRegistrationToken, error) {
 	var out foundation.EventRegistrationToken
-	hr, _, _ := syscall.SyscallN(
+	hr, _, _ := syscall.Syscall(
 		v.VTable().AddButtonPressed,
+		3,                                // nargs
 		uintptr(unsafe.Pointer(v)),       // this
 		uintptr(unsafe.Pointer(handler)), // in foundation.TypedEventHandler
 		uintptr(unsafe.Pointer(&out)),    // out foundation.EventRegistrationToken
@@ -774,11 +1158,14 @@ func (v *iSystemMediaTransportControls) AddButtonPressed(handler *foundation.Typ
 	return out, nil
 }
 
+// RemoveButtonPressed was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (v *iSystemMediaTransportControls) RemoveButtonPressed(token foundation.EventRegistrationToken) error {
-	hr, _, _ := syscall.SyscallN(
+	hr, _, _ := syscall.Syscall(
 		v.VTable().RemoveButtonPressed,
-		uintptr(unsafe.Pointer(v)),      // this
-		uintptr(unsafe.Pointer(&token)), // in foundation.EventRegistrationToken
+		2,                          // nargs
+		uintptr(unsafe.Pointer(v)), // this
+		winrt.ABIValue(&token),     // in foundation.EventRegistrationToken
+		0,
 	)
 
 	if hr != 0 {
@@ -788,10 +1175,12 @@ func (v *iSystemMediaTransportControls) RemoveButtonPressed(token foundation.Eve
 	return nil
 }
 
+// AddPropertyChanged was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (v *iSystemMediaTransportControls) AddPropertyChanged(handler *foundation.TypedEventHandler) (foundation.EventRegistrationToken, error) {
 	var out foundation.EventRegistrationToken
-	hr, _, _ := syscall.SyscallN(
+	hr, _, _ := syscall.Syscall(
 		v.VTable().AddPropertyChanged,
+		3,                                // nargs
 		uintptr(unsafe.Pointer(v)),       // this
 		uintptr(unsafe.Pointer(handler)), // in foundation.TypedEventHandler
 		uintptr(unsafe.Pointer(&out)),    // out foundation.EventRegistrationToken
@@ -804,11 +1193,14 @@ func (v *iSystemMediaTransportControls) AddPropertyChanged(handler *foundation.T
 	return out, nil
 }
 
+// RemovePropertyChanged was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (v *iSystemMediaTransportControls) RemovePropertyChanged(token foundation.EventRegistrationToken) error {
-	hr, _, _ := syscall.SyscallN(
+	hr, _, _ := syscall.Syscall(
 		v.VTable().RemovePropertyChanged,
-		uintptr(unsafe.Pointer(v)),      // this
-		uintptr(unsafe.Pointer(&token)), // in foundation.EventRegistrationToken
+		2,                          // nargs
+		uintptr(unsafe.Pointer(v)), // this
+		winrt.ABIValue(&token),     // in foundation.EventRegistrationToken
+		0,
 	)
 
 	if hr != 0 {
@@ -818,9 +1210,66 @@ func (v *iSystemMediaTransportControls) RemovePropertyChanged(token foundation.E
 	return nil
 }
 
+// SubscribeButtonPressed adds a handler of the ButtonPressed event, and returns the token that removes it.
+func (v *iSystemMediaTransportControls) SubscribeButtonPressed(handler func(sender *SystemMediaTransportControls, args *SystemMediaTransportControlsButtonPressedEventArgs)) (*winrt.EventToken, error) {
+	return v.SubscribeButtonPressedWithDispatcher(nil, handler)
+}
+
+// SubscribeButtonPressedWithDispatcher is like SubscribeButtonPressed, but the handler is run by the given dispatcher.
+// A nil dispatcher runs the handler inline.
+func (v *iSystemMediaTransportControls) SubscribeButtonPressedWithDispatcher(dispatcher *winrt.Dispatcher, handler func(sender *SystemMediaTransportControls, args *SystemMediaTransportControlsButtonPressedEventArgs)) (*winrt.EventToken, error) {
+	// pinterface({9de1c534-6ae1-11e0-84e1-18a905bcc53f};rc(Windows.Media.SystemMediaTransportControls;{99fa3ff4-1742-42a6-902e-087d41f965ec});rc(Windows.Media.SystemMediaTransportControlsButtonPressedEventArgs;{b7f47116-a56f-4dc8-9e11-92031f4a87c2}))
+	iid := ole.GUID{Data1: 0x0557e996, Data2: 0x7b23, Data3: 0x5bae, Data4: [8]byte{0xaa, 0x81, 0xea, 0x0d, 0x67, 0x11, 0x43, 0xa4}}
+	objectTypeArgs := []bool{true, true}
+	delegate := foundation.NewTypedEventHandlerWithDispatcher(&iid, dispatcher, objectTypeArgs, func(_ *foundation.TypedEventHandler, sender unsafe.Pointer, args unsafe.Pointer) {
+		handler((*SystemMediaTransportControls)(unsafe.Pointer(winrt.BorrowObject((*ole.IUnknown)(sender)))), (*SystemMediaTransportControlsButtonPressedEventArgs)(unsafe.Pointer(winrt.BorrowObject((*ole.IUnknown)(args)))))
+	})
+	defer delegate.Release()
+
+	token, err := v.AddButtonPressed(delegate)
+	if err != nil {
+		return nil, err
+	}
+	v.AddRef()
+	return winrt.NewEventToken(token.Value, func(value int64) error {
+		defer v.Release()
+		return v.RemoveButtonPressed(foundation.EventRegistrationToken{Value: value})
+	}), nil
+}
+
+// SubscribePropertyChanged adds a handler of the PropertyChanged event, and returns the token that removes it.
+func (v *iSystemMediaTransportControls) SubscribePropertyChanged(handler func(sender *SystemMediaTransportControls, args *SystemMediaTransportControlsPropertyChangedEventArgs)) (*winrt.EventToken, error) {
+	return v.SubscribePropertyChangedWithDispatcher(nil, handler)
+}
+
+// SubscribePropertyChangedWithDispatcher is like SubscribePropertyChanged, but the handler is run by the given dispatcher.
+// A nil dispatcher runs the handler inline.
+func (v *iSystemMediaTransportControls) SubscribePropertyChangedWithDispatcher(dispatcher *winrt.Dispatcher, handler func(sender *SystemMediaTransportControls, args *SystemMediaTransportControlsPropertyChangedEventArgs)) (*winrt.EventToken, error) {
+	// pinterface({9de1c534-6ae1-11e0-84e1-18a905bcc53f};rc(Windows.Media.SystemMediaTransportControls;{99fa3ff4-1742-42a6-902e-087d41f965ec});rc(Windows.Media.SystemMediaTransportControlsPropertyChangedEventArgs;{d0ca0936-339b-4cb3-8eeb-737607f56e08}))
+	iid := ole.GUID{Data1: 0x9fd61dad, Data2: 0x1746, Data3: 0x5fa1, Data4: [8]byte{0xa9, 0x08, 0xef, 0x7c, 0xb4, 0x60, 0x3c, 0x85}}
+	objectTypeArgs := []bool{true, true}
+	delegate := foundation.NewTypedEventHandlerWithDispatcher(&iid, dispatcher, objectTypeArgs, func(_ *foundation.TypedEventHandler, sender unsafe.Pointer, args unsafe.Pointer) {
+		handler((*SystemMediaTransportControls)(unsafe.Pointer(winrt.BorrowObject((*ole.IUnknown)(sender)))), (*SystemMediaTransportControlsPropertyChangedEventArgs)(unsafe.Pointer(winrt.BorrowObject((*ole.IUnknown)(args)))))
+	})
+	defer delegate.Release()
+
+	token, err := v.AddPropertyChanged(delegate)
+	if err != nil {
+		return nil, err
+	}
+	v.AddRef()
+	return winrt.NewEventToken(token.Value, func(value int64) error {
+		defer v.Release()
+		return v.RemovePropertyChanged(foundation.EventRegistrationToken{Value: value})
+	}), nil
+}
+
 const GUIDiSystemMediaTransportControls2 string = "ea98d2f6-7f3c-4af2-a586-72889808efb1"
 const SignatureiSystemMediaTransportControls2 string = "{ea98d2f6-7f3c-4af2-a586-72889808efb1}"
 
+var IIDiSystemMediaTransportControls2 = ole.GUID{Data1: 0xea98d2f6, Data2: 0x7f3c, Data3: 0x4af2, Data4: [8]byte{0xa5, 0x86, 0x72, 0x88, 0x98, 0x08, 0xef, 0xb1}}
+
+// iSystemMediaTransportControls2 was introduced in Windows.Foundation.UniversalApiContract v1.0.
 type iSystemMediaTransportControls2 struct {
 	ole.IInspectable
 }
@@ -849,12 +1298,20 @@ func (v *iSystemMediaTransportControls2) VTable() *iSystemMediaTransportControls
 	return (*iSystemMediaTransportControls2Vtbl)(unsafe.Pointer(v.RawVTable))
 }
 
+// IsSupportedISystemMediaTransportControls2 returns true if the given object implements iSystemMediaTransportControls2.
+func IsSupportedISystemMediaTransportControls2(obj *ole.IUnknown) bool {
+	return winrt.IsSupported(obj, &IIDiSystemMediaTransportControls2)
+}
+
+// GetAutoRepeatMode was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (v *iSystemMediaTransportControls2) GetAutoRepeatMode() (MediaPlaybackAutoRepeatMode, error) {
 	var out MediaPlaybackAutoRepeatMode
-	hr, _, _ := syscall.SyscallN(
+	hr, _, _ := syscall.Syscall(
 		v.VTable().GetAutoRepeatMode,
+		2,                             // nargs
 		uintptr(unsafe.Pointer(v)),    // this
 		uintptr(unsafe.Pointer(&out)), // out MediaPlaybackAutoRepeatMode
+		0,
 	)
 
 	if hr != 0 {
@@ -864,11 +1321,14 @@ func (v *iSystemMediaTransportControls2) GetAutoRepeatMode() (MediaPlaybackAutoR
 	return out, nil
 }
 
+// SetAutoRepeatMode was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (v *iSystemMediaTransportControls2) SetAutoRepeatMode(value MediaPlaybackAutoRepeatMode) error {
-	hr, _, _ := syscall.SyscallN(
+	hr, _, _ := syscall.Syscall(
 		v.VTable().SetAutoRepeatMode,
+		2,                          // nargs
 		uintptr(unsafe.Pointer(v)), // this
 		uintptr(value),             // in MediaPlaybackAutoRepeatMode
+		0,
 	)
 
 	if hr != 0 {
@@ -878,12 +1338,15 @@ func (v *iSystemMediaTransportControls2) SetAutoRepeatMode(value MediaPlaybackAu
 	return nil
 }
 
+// GetShuffleEnabled was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (v *iSystemMediaTransportControls2) GetShuffleEnabled() (bool, error) {
 	var out bool
-	hr, _, _ := syscall.SyscallN(
+	hr, _, _ := syscall.Syscall(
 		v.VTable().GetShuffleEnabled,
+		2,                             // nargs
 		uintptr(unsafe.Pointer(v)),    // this
 		uintptr(unsafe.Pointer(&out)), // out bool
+		0,
 	)
 
 	if hr != 0 {
@@ -893,11 +1356,14 @@ func (v *iSystemMediaTransportControls2) GetShuffleEnabled() (bool, error) {
 	return out, nil
 }
 
+// SetShuffleEnabled was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (v *iSystemMediaTransportControls2) SetShuffleEnabled(value bool) error {
-	hr, _, _ := syscall.SyscallN(
+	hr, _, _ := syscall.Syscall(
 		v.VTable().SetShuffleEnabled,
-		uintptr(unsafe.Pointer(v)),                // this
+		2,                          // nargs
+		uintptr(unsafe.Pointer(v)), // this
 		uintptr(*(*byte)(unsafe.Pointer(&value))), // in bool
+		0,
 	)
 
 	if hr != 0 {
@@ -907,12 +1373,15 @@ func (v *iSystemMediaTransportControls2) SetShuffleEnabled(value bool) error {
 	return nil
 }
 
+// GetPlaybackRate was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (v *iSystemMediaTransportControls2) GetPlaybackRate() (float64, error) {
 	var out float64
-	hr, _, _ := syscall.SyscallN(
+	hr, _, _ := syscall.Syscall(
 		v.VTable().GetPlaybackRate,
+		2,                             // nargs
 		uintptr(unsafe.Pointer(v)),    // this
 		uintptr(unsafe.Pointer(&out)), // out float64
+		0,
 	)
 
 	if hr != 0 {
@@ -922,11 +1391,14 @@ func (v *iSystemMediaTransportControls2) GetPlaybackRate() (float64, error) {
 	return out, nil
 }
 
+// SetPlaybackRate was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (v *iSystemMediaTransportControls2) SetPlaybackRate(value float64) error {
-	hr, _, _ := syscall.SyscallN(
+	hr, _, _ := syscall.Syscall(
 		v.VTable().SetPlaybackRate,
+		2,                          // nargs
 		uintptr(unsafe.Pointer(v)), // this
-		uintptr(value),             // in float64
+		winrt.ABIValue(&value),     // in float64
+		0,
 	)
 
 	if hr != 0 {
@@ -936,11 +1408,14 @@ func (v *iSystemMediaTransportControls2) SetPlaybackRate(value float64) error {
 	return nil
 }
 
+// UpdateTimelineProperties was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (v *iSystemMediaTransportControls2) UpdateTimelineProperties(timelineProperties *SystemMediaTransportControlsTimelineProperties) error {
-	hr, _, _ := syscall.SyscallN(
+	hr, _, _ := syscall.Syscall(
 		v.VTable().UpdateTimelineProperties,
-		uintptr(unsafe.Pointer(v)),                  // this
-		uintptr(unsafe.Pointer(timelineProperties)), // in SystemMediaTransportControlsTimelineProperties
+		2,                          // nargs
+		uintptr(unsafe.Pointer(v)), // this
+		winrt.ObjectABI(unsafe.Pointer(timelineProperties)), // in SystemMediaTransportControlsTimelineProperties
+		0,
 	)
 
 	if hr != 0 {
@@ -950,10 +1425,12 @@ func (v *iSystemMediaTransportControls2) UpdateTimelineProperties(timelineProper
 	return nil
 }
 
+// AddPlaybackPositionChangeRequested was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (v *iSystemMediaTransportControls2) AddPlaybackPositionChangeRequested(handler *foundation.TypedEventHandler) (foundation.EventRegistrationToken, error) {
 	var out foundation.EventRegistrationToken
-	hr, _, _ := syscall.SyscallN(
+	hr, _, _ := syscall.Syscall(
 		v.VTable().AddPlaybackPositionChangeRequested,
+		3,                                // nargs
 		uintptr(unsafe.Pointer(v)),       // this
 		uintptr(unsafe.Pointer(handler)), // in foundation.TypedEventHandler
 		uintptr(unsafe.Pointer(&out)),    // out foundation.EventRegistrationToken
@@ -966,11 +1443,14 @@ func (v *iSystemMediaTransportControls2) AddPlaybackPositionChangeRequested(hand
 	return out, nil
 }
 
+// RemovePlaybackPositionChangeRequested was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (v *iSystemMediaTransportControls2) RemovePlaybackPositionChangeRequested(token foundation.EventRegistrationToken) error {
-	hr, _, _ := syscall.SyscallN(
+	hr, _, _ := syscall.Syscall(
 		v.VTable().RemovePlaybackPositionChangeRequested,
-		uintptr(unsafe.Pointer(v)),      // this
-		uintptr(unsafe.Pointer(&token)), // in foundation.EventRegistrationToken
+		2,                          // nargs
+		uintptr(unsafe.Pointer(v)), // this
+		winrt.ABIValue(&token),     // in foundation.EventRegistrationToken
+		0,
 	)
 
 	if hr != 0 {
@@ -980,10 +1460,12 @@ func (v *iSystemMediaTransportControls2) RemovePlaybackPositionChangeRequested(t
 	return nil
 }
 
+// AddPlaybackRateChangeRequested was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (v *iSystemMediaTransportControls2) AddPlaybackRateChangeRequested(handler *foundation.TypedEventHandler) (foundation.EventRegistrationToken, error) {
 	var out foundation.EventRegistrationToken
-	hr, _, _ := syscall.SyscallN(
+	hr, _, _ := syscall.Syscall(
 		v.VTable().AddPlaybackRateChangeRequested,
+		3,                                // nargs
 		uintptr(unsafe.Pointer(v)),       // this
 		uintptr(unsafe.Pointer(handler)), // in foundation.TypedEventHandler
 		uintptr(unsafe.Pointer(&out)),    // out foundation.EventRegistrationToken
@@ -996,11 +1478,14 @@ func (v *iSystemMediaTransportControls2) AddPlaybackRateChangeRequested(handler
 	return out, nil
 }
 
+// RemovePlaybackRateChangeRequested was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (v *iSystemMediaTransportControls2) RemovePlaybackRateChangeRequested(token foundation.EventRegistrationToken) error {
-	hr, _, _ := syscall.SyscallN(
+	hr, _, _ := syscall.Syscall(
 		v.VTable().RemovePlaybackRateChangeRequested,
-		uintptr(unsafe.Pointer(v)),      // this
-		uintptr(unsafe.Pointer(&token)), // in foundation.EventRegistrationToken
+		2,                          // nargs
+		uintptr(unsafe.Pointer(v)), // this
+		winrt.ABIValue(&token),     // in foundation.EventRegistrationToken
+		0,
 	)
 
 	if hr != 0 {
@@ -1010,10 +1495,12 @@ func (v *iSystemMediaTransportControls2) RemovePlaybackRateChangeRequested(token
 	return nil
 }
 
+// AddShuffleEnabledChangeRequested was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (v *iSystemMediaTransportControls2) AddShuffleEnabledChangeRequested(handler *foundation.TypedEventHandler) (foundation.EventRegistrationToken, error) {
 	var out foundation.EventRegistrationToken
-	hr, _, _ := syscall.SyscallN(
+	hr, _, _ := syscall.Syscall(
 		v.VTable().AddShuffleEnabledChangeRequested,
+		3,                                // nargs
 		uintptr(unsafe.Pointer(v)),       // this
 		uintptr(unsafe.Pointer(handler)), // in foundation.TypedEventHandler
 		uintptr(unsafe.Pointer(&out)),    // out foundation.EventRegistrationToken
@@ -1026,11 +1513,14 @@ func (v *iSystemMediaTransportControls2) AddShuffleEnabledChangeRequested(handle
 	return out, nil
 }
 
+// RemoveShuffleEnabledChangeRequested was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (v *iSystemMediaTransportControls2) RemoveShuffleEnabledChangeRequested(token foundation.EventRegistrationToken) error {
-	hr, _, _ := syscall.SyscallN(
+	hr, _, _ := syscall.Syscall(
 		v.VTable().RemoveShuffleEnabledChangeRequested,
-		uintptr(unsafe.Pointer(v)),      // this
-		uintptr(unsafe.Pointer(&token)), // in foundation.EventRegistrationToken
+		2,                          // nargs
+		uintptr(unsafe.Pointer(v)), // this
+		winrt.ABIValue(&token),     // in foundation.EventRegistrationToken
+		0,
 	)
 
 	if hr != 0 {
@@ -1040,10 +1530,12 @@ func (v *iSystemMediaTransportControls2) RemoveShuffleEnabledChangeRequested(tok
 	return nil
 }
 
+// AddAutoRepeatModeChangeRequested was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (v *iSystemMediaTransportControls2) AddAutoRepeatModeChangeRequested(handler *foundation.TypedEventHandler) (foundation.EventRegistrationToken, error) {
 	var out foundation.EventRegistrationToken
-	hr, _, _ := syscall.SyscallN(
+	hr, _, _ := syscall.Syscall(
 		v.VTable().AddAutoRepeatModeChangeRequested,
+		3,                                // nargs
 		uintptr(unsafe.Pointer(v)),       // this
 		uintptr(unsafe.Pointer(handler)), // in foundation.TypedEventHandler
 		uintptr(unsafe.Pointer(&out)),    // out foundation.EventRegistrationToken
@@ -1056,11 +1548,14 @@ func (v *iSystemMediaTransportControls2) AddAutoRepeatModeChangeRequested(handle
 	return out, nil
 }
 
+// RemoveAutoRepeatModeChangeRequested was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (v *iSystemMediaTransportControls2) RemoveAutoRepeatModeChangeRequested(token foundation.EventRegistrationToken) error {
-	hr, _, _ := syscall.SyscallN(
+	hr, _, _ := syscall.Syscall(
 		v.VTable().RemoveAutoRepeatModeChangeRequested,
-		uintptr(unsafe.Pointer(v)),      // this
-		uintptr(unsafe.Pointer(&token)), // in foundation.EventRegistrationToken
+		2,                          // nargs
+		uintptr(unsafe.Pointer(v)), // this
+		winrt.ABIValue(&token),     // in foundation.EventRegistrationToken
+		0,
 	)
 
 	if hr != 0 {
@@ -1070,9 +1565,120 @@ func (v *iSystemMediaTransportControls2) RemoveAutoRepeatModeChangeRequested(tok
 	return nil
 }
 
+// SubscribePlaybackPositionChangeRequested adds a handler of the PlaybackPositionChangeRequested event, and returns the token that removes it.
+func (v *iSystemMediaTransportControls2) SubscribePlaybackPositionChangeRequested(handler func(sender *SystemMediaTransportControls, args *PlaybackPositionChangeRequestedEventArgs)) (*winrt.EventToken, error) {
+	return v.SubscribePlaybackPositionChangeRequestedWithDispatcher(nil, handler)
+}
+
+// SubscribePlaybackPositionChangeRequestedWithDispatcher is like SubscribePlaybackPositionChangeRequested, but the handler is run by the given dispatcher.
+// A nil dispatcher runs the handler inline.
+func (v *iSystemMediaTransportControls2) SubscribePlaybackPositionChangeRequestedWithDispatcher(dispatcher *winrt.Dispatcher, handler func(sender *SystemMediaTransportControls, args *PlaybackPositionChangeRequestedEventArgs)) (*winrt.EventToken, error) {
+	// pinterface({9de1c534-6ae1-11e0-84e1-18a905bcc53f};rc(Windows.Media.SystemMediaTransportControls;{99fa3ff4-1742-42a6-902e-087d41f965ec});rc(Windows.Media.PlaybackPositionChangeRequestedEventArgs;{b4493f88-eb28-4961-9c14-335e44f3e125}))
+	iid := ole.GUID{Data1: 0x44e34f15, Data2: 0xbdc0, Data3: 0x50a7, Data4: [8]byte{0xac, 0xe4, 0x39, 0xe9, 0x1f, 0xb7, 0x53, 0xf1}}
+	objectTypeArgs := []bool{true, true}
+	delegate := foundation.NewTypedEventHandlerWithDispatcher(&iid, dispatcher, objectTypeArgs, func(_ *foundation.TypedEventHandler, sender unsafe.Pointer, args unsafe.Pointer) {
+		handler((*SystemMediaTransportControls)(unsafe.Pointer(winrt.BorrowObject((*ole.IUnknown)(sender)))), (*PlaybackPositionChangeRequestedEventArgs)(unsafe.Pointer(winrt.BorrowObject((*ole.IUnknown)(args)))))
+	})
+	defer delegate.Release()
+
+	token, err := v.AddPlaybackPositionChangeRequested(delegate)
+	if err != nil {
+		return nil, err
+	}
+	v.AddRef()
+	return winrt.NewEventToken(token.Value, func(value int64) error {
+		defer v.Release()
+		return v.RemovePlaybackPositionChangeRequested(foundation.EventRegistrationToken{Value: value})
+	}), nil
+}
+
+// SubscribePlaybackRateChangeRequested adds a handler of the PlaybackRateChangeRequested event, and returns the token that removes it.
+func (v *iSystemMediaTransportControls2) SubscribePlaybackRateChangeRequested(handler func(sender *SystemMediaTransportControls, args *PlaybackRateChangeRequestedEventArgs)) (*winrt.EventToken, error) {
+	return v.SubscribePlaybackRateChangeRequestedWithDispatcher(nil, handler)
+}
+
+// SubscribePlaybackRateChangeRequestedWithDispatcher is like SubscribePlaybackRateChangeRequested, but the handler is run by the given dispatcher.
+// A nil dispatcher runs the handler inline.
+func (v *iSystemMediaTransportControls2) SubscribePlaybackRateChangeRequestedWithDispatcher(dispatcher *winrt.Dispatcher, handler func(sender *SystemMediaTransportControls, args *PlaybackRateChangeRequestedEventArgs)) (*winrt.EventToken, error) {
+	// pinterface({9de1c534-6ae1-11e0-84e1-18a905bcc53f};rc(Windows.Media.SystemMediaTransportControls;{99fa3ff4-1742-42a6-902e-087d41f965ec});rc(Windows.Media.PlaybackRateChangeRequestedEventArgs;{2ce2c41f-3cd6-4f77-9ba7-eb27c26a2140}))
+	iid := ole.GUID{Data1: 0x15eb0182, Data2: 0x6366, Data3: 0x5b9f, Data4: [8]byte{0xbd, 0x8c, 0x8a, 0xb4, 0xfa, 0x9d, 0x7c, 0xd9}}
+	objectTypeArgs := []bool{true, true}
+	delegate := foundation.NewTypedEventHandlerWithDispatcher(&iid, dispatcher, objectTypeArgs, func(_ *foundation.TypedEventHandler, sender unsafe.Pointer, args unsafe.Pointer) {
+		handler((*SystemMediaTransportControls)(unsafe.Pointer(winrt.BorrowObject((*ole.IUnknown)(sender)))), (*PlaybackRateChangeRequestedEventArgs)(unsafe.Pointer(winrt.BorrowObject((*ole.IUnknown)(args)))))
+	})
+	defer delegate.Release()
+
+	token, err := v.AddPlaybackRateChangeRequested(delegate)
+	if err != nil {
+		return nil, err
+	}
+	v.AddRef()
+	return winrt.NewEventToken(token.Value, func(value int64) error {
+		defer v.Release()
+		return v.RemovePlaybackRateChangeRequested(foundation.EventRegistrationToken{Value: value})
+	}), nil
+}
+
+// SubscribeShuffleEnabledChangeRequested adds a handler of the ShuffleEnabledChangeRequested event, and returns the token that removes it.
+func (v *iSystemMediaTransportControls2) SubscribeShuffleEnabledChangeRequested(handler func(sender *SystemMediaTransportControls, args *ShuffleEnabledChangeRequestedEventArgs)) (*winrt.EventToken, error) {
+	return v.SubscribeShuffleEnabledChangeRequestedWithDispatcher(nil, handler)
+}
+
+// SubscribeShuffleEnabledChangeRequestedWithDispatcher is like SubscribeShuffleEnabledChangeRequested, but the handler is run by the given dispatcher.
+// A nil dispatcher runs the handler inline.
+func (v *iSystemMediaTransportControls2) SubscribeShuffleEnabledChangeRequestedWithDispatcher(dispatcher *winrt.Dispatcher, handler func(sender *SystemMediaTransportControls, args *ShuffleEnabledChangeRequestedEventArgs)) (*winrt.EventToken, error) {
+	// pinterface({9de1c534-6ae1-11e0-84e1-18a905bcc53f};rc(Windows.Media.SystemMediaTransportControls;{99fa3ff4-1742-42a6-902e-087d41f965ec});rc(Windows.Media.ShuffleEnabledChangeRequestedEventArgs;{49b593fe-4fd0-4666-a314-c0e01940d302}))
+	iid := ole.GUID{Data1: 0x17ecea80, Data2: 0x27e4, Data3: 0x5dae, Data4: [8]byte{0xab, 0xb4, 0xc8, 0x58, 0xad, 0x1c, 0x53, 0x07}}
+	objectTypeArgs := []bool{true, true}
+	delegate := foundation.NewTypedEventHandlerWithDispatcher(&iid, dispatcher, objectTypeArgs, func(_ *foundation.TypedEventHandler, sender unsafe.Pointer, args unsafe.Pointer) {
+		handler((*SystemMediaTransportControls)(unsafe.Pointer(winrt.BorrowObject((*ole.IUnknown)(sender)))), (*ShuffleEnabledChangeRequestedEventArgs)(unsafe.Pointer(winrt.BorrowObject((*ole.IUnknown)(args)))))
+	})
+	defer delegate.Release()
+
+	token, err := v.AddShuffleEnabledChangeRequested(delegate)
+	if err != nil {
+		return nil, err
+	}
+	v.AddRef()
+	return winrt.NewEventToken(token.Value, func(value int64) error {
+		defer v.Release()
+		return v.RemoveShuffleEnabledChangeRequested(foundation.EventRegistrationToken{Value: value})
+	}), nil
+}
+
+// SubscribeAutoRepeatModeChangeRequested adds a handler of the AutoRepeatModeChangeRequested event, and returns the token that removes it.
+func (v *iSystemMediaTransportControls2) SubscribeAutoRepeatModeChangeRequested(handler func(sender *SystemMediaTransportControls, args *AutoRepeatModeChangeRequestedEventArgs)) (*winrt.EventToken, error) {
+	return v.SubscribeAutoRepeatModeChangeRequestedWithDispatcher(nil, handler)
+}
+
+// SubscribeAutoRepeatModeChangeRequestedWithDispatcher is like SubscribeAutoRepeatModeChangeRequested, but the handler is run by the given dispatcher.
+// A nil dispatcher runs the handler inline.
+func (v *iSystemMediaTransportControls2) SubscribeAutoRepeatModeChangeRequestedWithDispatcher(dispatcher *winrt.Dispatcher, handler func(sender *SystemMediaTransportControls, args *AutoRepeatModeChangeRequestedEventArgs)) (*winrt.EventToken, error) {
+	// pinterface({9de1c534-6ae1-11e0-84e1-18a905bcc53f};rc(Windows.Media.SystemMediaTransportControls;{99fa3ff4-1742-42a6-902e-087d41f965ec});rc(Windows.Media.AutoRepeatModeChangeRequestedEventArgs;{ea137efa-d852-438e-882b-c990109a78f4}))
+	iid := ole.GUID{Data1: 0xa6214bde, Data2: 0x02d5, Data3: 0x55b3, Data4: [8]byte{0xab, 0x0d, 0xc6, 0x03, 0x1b, 0xe7, 0x0d, 0xa1}}
+	objectTypeArgs := []bool{true, true}
+	delegate := foundation.NewTypedEventHandlerWithDispatcher(&iid, dispatcher, objectTypeArgs, func(_ *foundation.TypedEventHandler, sender unsafe.Pointer, args unsafe.Pointer) {
+		handler((*SystemMediaTransportControls)(unsafe.Pointer(winrt.BorrowObject((*ole.IUnknown)(sender)))), (*AutoRepeatModeChangeRequestedEventArgs)(unsafe.Pointer(winrt.BorrowObject((*ole.IUnknown)(args)))))
+	})
+	defer delegate.Release()
+
+	token, err := v.AddAutoRepeatModeChangeRequested(delegate)
+	if err != nil {
+		return nil, err
+	}
+	v.AddRef()
+	return winrt.NewEventToken(token.Value, func(value int64) error {
+		defer v.Release()
+		return v.RemoveAutoRepeatModeChangeRequested(foundation.EventRegistrationToken{Value: value})
+	}), nil
+}
+
 const GUIDiSystemMediaTransportControlsStatics string = "43ba380a-eca4-4832-91ab-d415fae484c6"
 const SignatureiSystemMediaTransportControlsStatics string = "{43ba380a-eca4-4832-91ab-d415fae484c6}"
 
+var IIDiSystemMediaTransportControlsStatics = ole.GUID{Data1: 0x43ba380a, Data2: 0xeca4, Data3: 0x4832, Data4: [8]byte{0x91, 0xab, 0xd4, 0x15, 0xfa, 0xe4, 0x84, 0xc6}}
+
+// iSystemMediaTransportControlsStatics was introduced in Windows.Foundation.UniversalApiContract v1.0.
 type iSystemMediaTransportControlsStatics struct {
 	ole.IInspectable
 }
@@ -1087,23 +1693,27 @@ func (v *iSystemMediaTransportControlsStatics) VTable() *iSystemMediaTransportCo
 	return (*iSystemMediaTransportControlsStaticsVtbl)(unsafe.Pointer(v.RawVTable))
 }
 
+// SystemMediaTransportControlsGetForCurrentView was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func SystemMediaTransportControlsGetForCurrentView() (*SystemMediaTransportControls, error) {
-	inspectable, err := ole.RoGetActivationFactory("Windows.Media.SystemMediaTransportControls", ole.NewGUID(GUIDiSystemMediaTransportControlsStatics))
+	factory, err := winrt.GetActivationFactory("Windows.Media.SystemMediaTransportControls", &IIDiSystemMediaTransportControlsStatics)
 	if err != nil {
 		return nil, err
 	}
-	v := (*iSystemMediaTransportControlsStatics)(unsafe.Pointer(inspectable))
+	v := (*iSystemMediaTransportControlsStatics)(unsafe.Pointer(factory))
 
-	var out *SystemMediaTransportControls
-	hr, _, _ := syscall.SyscallN(
+	var outPtr *ole.IUnknown
+	hr, _, _ := syscall.Syscall(
 		v.VTable().SystemMediaTransportControlsGetForCurrentView,
-		0,                             // this is a static func, so there's no this
-		uintptr(unsafe.Pointer(&out)), // out SystemMediaTransportControls
+		2,                                // nargs
+		uintptr(unsafe.Pointer(v)),       // this
+		uintptr(unsafe.Pointer(&outPtr)), // out SystemMediaTransportControls
+		0,
 	)
 
 	if hr != 0 {
 		return nil, ole.NewError(hr)
 	}
 
+	out := (*SystemMediaTransportControls)(unsafe.Pointer(winrt.NewObject(outPtr)))
 	return out, nil
 }
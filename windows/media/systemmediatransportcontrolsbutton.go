@@ -0,0 +1,83 @@
+// Code generated by winrt-go-gen. DO NOT EDIT.
+
+//go:build windows
+
+//nolint:all
+package media
+
+import "fmt"
+
+type SystemMediaTransportControlsButton int32
+
+const SignatureSystemMediaTransportControlsButton string = "enum(Windows.Media.SystemMediaTransportControlsButton;i4)"
+
+const (
+	SystemMediaTransportControlsButtonPlay        SystemMediaTransportControlsButton = 0
+	SystemMediaTransportControlsButtonPause       SystemMediaTransportControlsButton = 1
+	SystemMediaTransportControlsButtonStop        SystemMediaTransportControlsButton = 2
+	SystemMediaTransportControlsButtonRecord      SystemMediaTransportControlsButton = 3
+	SystemMediaTransportControlsButtonFastForward SystemMediaTransportControlsButton = 4
+	SystemMediaTransportControlsButtonRewind      SystemMediaTransportControlsButton = 5
+	SystemMediaTransportControlsButtonNext        SystemMediaTransportControlsButton = 6
+	SystemMediaTransportControlsButtonPrevious    SystemMediaTransportControlsButton = 7
+	SystemMediaTransportControlsButtonChannelUp   SystemMediaTransportControlsButton = 8
+	SystemMediaTransportControlsButtonChannelDown SystemMediaTransportControlsButton = 9
+)
+
+var valuesSystemMediaTransportControlsButton = []SystemMediaTransportControlsButton{
+	SystemMediaTransportControlsButtonPlay,
+	SystemMediaTransportControlsButtonPause,
+	SystemMediaTransportControlsButtonStop,
+	SystemMediaTransportControlsButtonRecord,
+	SystemMediaTransportControlsButtonFastForward,
+	SystemMediaTransportControlsButtonRewind,
+	SystemMediaTransportControlsButtonNext,
+	SystemMediaTransportControlsButtonPrevious,
+	SystemMediaTransportControlsButtonChannelUp,
+	SystemMediaTransportControlsButtonChannelDown,
+}
+
+var namesSystemMediaTransportControlsButton = []string{
+	"Play",
+	"Pause",
+	"Stop",
+	"Record",
+	"FastForward",
+	"Rewind",
+	"Next",
+	"Previous",
+	"ChannelUp",
+	"ChannelDown",
+}
+
+// ValuesSystemMediaTransportControlsButton returns all the values defined by SystemMediaTransportControlsButton.
+func ValuesSystemMediaTransportControlsButton() []SystemMediaTransportControlsButton {
+	return append([]SystemMediaTransportControlsButton(nil), valuesSystemMediaTransportControlsButton...)
+}
+
+// String returns the name of the value.
+func (v SystemMediaTransportControlsButton) String() string {
+	for i, value := range valuesSystemMediaTransportControlsButton {
+		if value == v {
+			return namesSystemMediaTransportControlsButton[i]
+		}
+	}
+	return fmt.Sprintf("SystemMediaTransportControlsButton(%d)", int32(v))
+}
+
+// ParseSystemMediaTransportControlsButton returns the value with the given name.
+func ParseSystemMediaTransportControlsButton(s string) (SystemMediaTransportControlsButton, error) {
+	if v, ok := lookupSystemMediaTransportControlsButton(s); ok {
+		return v, nil
+	}
+	return 0, fmt.Errorf("invalid SystemMediaTransportControlsButton %q", s)
+}
+
+func lookupSystemMediaTransportControlsButton(name string) (SystemMediaTransportControlsButton, bool) {
+	for i, n := range namesSystemMediaTransportControlsButton {
+		if n == name {
+			return valuesSystemMediaTransportControlsButton[i], true
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,80 @@
+// Code generated by winrt-go-gen. DO NOT EDIT.
+
+//go:build windows
+
+//nolint:all
+package media
+
+import (
+	"syscall"
+	"unsafe"
+
+	"github.com/go-ole/go-ole"
+	"github.com/waylyrics/winrt-go"
+)
+
+const SignatureSystemMediaTransportControlsButtonPressedEventArgs string = "rc(Windows.Media.SystemMediaTransportControlsButtonPressedEventArgs;{b7f47116-a56f-4dc8-9e11-92031f4a87c2})"
+
+type SystemMediaTransportControlsButtonPressedEventArgs struct {
+	winrt.Object
+}
+
+// HasISystemMediaTransportControlsButtonPressedEventArgs returns true if the SystemMediaTransportControlsButtonPressedEventArgs implements iSystemMediaTransportControlsButtonPressedEventArgs.
+// Interfaces added in newer versions of Windows may not be implemented by older ones.
+func (impl *SystemMediaTransportControlsButtonPressedEventArgs) HasISystemMediaTransportControlsButtonPressedEventArgs() bool {
+	return impl.HasInterface(&IIDiSystemMediaTransportControlsButtonPressedEventArgs)
+}
+
+// GetButton was introduced in Windows.Foundation.UniversalApiContract v1.0.
+func (impl *SystemMediaTransportControlsButtonPressedEventArgs) GetButton() (SystemMediaTransportControlsButton, error) {
+	itf, err := impl.Interface(&IIDiSystemMediaTransportControlsButtonPressedEventArgs)
+	if err != nil {
+		return SystemMediaTransportControlsButtonPlay, err
+	}
+	defer impl.ReleaseInterface(itf)
+	v := (*iSystemMediaTransportControlsButtonPressedEventArgs)(unsafe.Pointer(itf))
+	return v.GetButton()
+}
+
+const GUIDiSystemMediaTransportControlsButtonPressedEventArgs string = "b7f47116-a56f-4dc8-9e11-92031f4a87c2"
+const SignatureiSystemMediaTransportControlsButtonPressedEventArgs string = "{b7f47116-a56f-4dc8-9e11-92031f4a87c2}"
+
+var IIDiSystemMediaTransportControlsButtonPressedEventArgs = ole.GUID{Data1: 0xb7f47116, Data2: 0xa56f, Data3: 0x4dc8, Data4: [8]byte{0x9e, 0x11, 0x92, 0x03, 0x1f, 0x4a, 0x87, 0xc2}}
+
+// iSystemMediaTransportControlsButtonPressedEventArgs was introduced in Windows.Foundation.UniversalApiContract v1.0.
+type iSystemMediaTransportControlsButtonPressedEventArgs struct {
+	ole.IInspectable
+}
+
+type iSystemMediaTransportControlsButtonPressedEventArgsVtbl struct {
+	ole.IInspectableVtbl
+
+	GetButton uintptr
+}
+
+func (v *iSystemMediaTransportControlsButtonPressedEventArgs) VTable() *iSystemMediaTransportControlsButtonPressedEventArgsVtbl {
+	return (*iSystemMediaTransportControlsButtonPressedEventArgsVtbl)(unsafe.Pointer(v.RawVTable))
+}
+
+// IsSupportedISystemMediaTransportControlsButtonPressedEventArgs returns true if the given object implements iSystemMediaTransportControlsButtonPressedEventArgs.
+func IsSupportedISystemMediaTransportControlsButtonPressedEventArgs(obj *ole.IUnknown) bool {
+	return winrt.IsSupported(obj, &IIDiSystemMediaTransportControlsButtonPressedEventArgs)
+}
+
+// GetButton was introduced in Windows.Foundation.UniversalApiContract v1.0.
+func (v *iSystemMediaTransportControlsButtonPressedEventArgs) GetButton() (SystemMediaTransportControlsButton, error) {
+	var out SystemMediaTransportControlsButton
+	hr, _, _ := syscall.Syscall(
+		v.VTable().GetButton,
+		2,                             // nargs
+		uintptr(unsafe.Pointer(v)),    // this
+		uintptr(unsafe.Pointer(&out)), // out SystemMediaTransportControlsButton
+		0,
+	)
+
+	if hr != 0 {
+		return SystemMediaTransportControlsButtonPlay, ole.NewError(hr)
+	}
+
+	return out, nil
+}
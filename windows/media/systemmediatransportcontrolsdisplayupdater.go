@@ -10,73 +10,117 @@ import (
 	"unsafe"
 
 	"github.com/go-ole/go-ole"
+	"github.com/waylyrics/winrt-go"
+	"github.com/waylyrics/winrt-go/hstring"
 )
 
 const SignatureSystemMediaTransportControlsDisplayUpdater string = "rc(Windows.Media.SystemMediaTransportControlsDisplayUpdater;{8abbc53e-fa55-4ecf-ad8e-c984e5dd1550})"
 
 type SystemMediaTransportControlsDisplayUpdater struct {
-	ole.IUnknown
+	winrt.Object
 }
 
+// HasISystemMediaTransportControlsDisplayUpdater returns true if the SystemMediaTransportControlsDisplayUpdater implements iSystemMediaTransportControlsDisplayUpdater.
+// Interfaces added in newer versions of Windows may not be implemented by older ones.
+func (impl *SystemMediaTransportControlsDisplayUpdater) HasISystemMediaTransportControlsDisplayUpdater() bool {
+	return impl.HasInterface(&IIDiSystemMediaTransportControlsDisplayUpdater)
+}
+
+// GetType was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (impl *SystemMediaTransportControlsDisplayUpdater) GetType() (MediaPlaybackType, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiSystemMediaTransportControlsDisplayUpdater))
-	defer itf.Release()
+	itf, err := impl.Interface(&IIDiSystemMediaTransportControlsDisplayUpdater)
+	if err != nil {
+		return MediaPlaybackTypeUnknown, err
+	}
+	defer impl.ReleaseInterface(itf)
 	v := (*iSystemMediaTransportControlsDisplayUpdater)(unsafe.Pointer(itf))
 	return v.GetType()
 }
 
+// SetType was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (impl *SystemMediaTransportControlsDisplayUpdater) SetType(value MediaPlaybackType) error {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiSystemMediaTransportControlsDisplayUpdater))
-	defer itf.Release()
+	itf, err := impl.Interface(&IIDiSystemMediaTransportControlsDisplayUpdater)
+	if err != nil {
+		return err
+	}
+	defer impl.ReleaseInterface(itf)
 	v := (*iSystemMediaTransportControlsDisplayUpdater)(unsafe.Pointer(itf))
 	return v.SetType(value)
 }
 
+// GetAppMediaId was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (impl *SystemMediaTransportControlsDisplayUpdater) GetAppMediaId() (string, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiSystemMediaTransportControlsDisplayUpdater))
-	defer itf.Release()
+	itf, err := impl.Interface(&IIDiSystemMediaTransportControlsDisplayUpdater)
+	if err != nil {
+		return "", err
+	}
+	defer impl.ReleaseInterface(itf)
 	v := (*iSystemMediaTransportControlsDisplayUpdater)(unsafe.Pointer(itf))
 	return v.GetAppMediaId()
 }
 
+// SetAppMediaId was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (impl *SystemMediaTransportControlsDisplayUpdater) SetAppMediaId(value string) error {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiSystemMediaTransportControlsDisplayUpdater))
-	defer itf.Release()
+	itf, err := impl.Interface(&IIDiSystemMediaTransportControlsDisplayUpdater)
+	if err != nil {
+		return err
+	}
+	defer impl.ReleaseInterface(itf)
 	v := (*iSystemMediaTransportControlsDisplayUpdater)(unsafe.Pointer(itf))
 	return v.SetAppMediaId(value)
 }
 
+// GetMusicProperties was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (impl *SystemMediaTransportControlsDisplayUpdater) GetMusicProperties() (*MusicDisplayProperties, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiSystemMediaTransportControlsDisplayUpdater))
-	defer itf.Release()
+	itf, err := impl.Interface(&IIDiSystemMediaTransportControlsDisplayUpdater)
+	if err != nil {
+		return nil, err
+	}
+	defer impl.ReleaseInterface(itf)
 	v := (*iSystemMediaTransportControlsDisplayUpdater)(unsafe.Pointer(itf))
 	return v.GetMusicProperties()
 }
 
+// GetVideoProperties was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (impl *SystemMediaTransportControlsDisplayUpdater) GetVideoProperties() (*VideoDisplayProperties, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiSystemMediaTransportControlsDisplayUpdater))
-	defer itf.Release()
+	itf, err := impl.Interface(&IIDiSystemMediaTransportControlsDisplayUpdater)
+	if err != nil {
+		return nil, err
+	}
+	defer impl.ReleaseInterface(itf)
 	v := (*iSystemMediaTransportControlsDisplayUpdater)(unsafe.Pointer(itf))
 	return v.GetVideoProperties()
 }
 
+// GetImageProperties was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (impl *SystemMediaTransportControlsDisplayUpdater) GetImageProperties() (*ImageDisplayProperties, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiSystemMediaTransportControlsDisplayUpdater))
-	defer itf.Release()
+	itf, err := impl.Interface(&IIDiSystemMediaTransportControlsDisplayUpdater)
+	if err != nil {
+		return nil, err
+	}
+	defer impl.ReleaseInterface(itf)
 	v := (*iSystemMediaTransportControlsDisplayUpdater)(unsafe.Pointer(itf))
 	return v.GetImageProperties()
 }
 
+// ClearAll was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (impl *SystemMediaTransportControlsDisplayUpdater) ClearAll() error {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiSystemMediaTransportControlsDisplayUpdater))
-	defer itf.Release()
+	itf, err := impl.Interface(&IIDiSystemMediaTransportControlsDisplayUpdater)
+	if err != nil {
+		return err
+	}
+	defer impl.ReleaseInterface(itf)
 	v := (*iSystemMediaTransportControlsDisplayUpdater)(unsafe.Pointer(itf))
 	return v.ClearAll()
 }
 
+// Update was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (impl *SystemMediaTransportControlsDisplayUpdater) Update() error {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiSystemMediaTransportControlsDisplayUpdater))
-	defer itf.Release()
+	itf, err := impl.Interface(&IIDiSystemMediaTransportControlsDisplayUpdater)
+	if err != nil {
+		return err
+	}
+	defer impl.ReleaseInterface(itf)
 	v := (*iSystemMediaTransportControlsDisplayUpdater)(unsafe.Pointer(itf))
 	return v.Update()
 }
@@ -84,6 +128,9 @@ func (impl *SystemMediaTransportControlsDisplayUpdater) Update() error {
 const GUIDiSystemMediaTransportControlsDisplayUpdater string = "8abbc53e-fa55-4ecf-ad8e-c984e5dd1550"
 const SignatureiSystemMediaTransportControlsDisplayUpdater string = "{8abbc53e-fa55-4ecf-ad8e-c984e5dd1550}"
 
+var IIDiSystemMediaTransportControlsDisplayUpdater = ole.GUID{Data1: 0x8abbc53e, Data2: 0xfa55, Data3: 0x4ecf, Data4: [8]byte{0xad, 0x8e, 0xc9, 0x84, 0xe5, 0xdd, 0x15, 0x50}}
+
+// iSystemMediaTransportControlsDisplayUpdater was introduced in Windows.Foundation.UniversalApiContract v1.0.
 type iSystemMediaTransportControlsDisplayUpdater struct {
 	ole.IInspectable
 }
@@ -109,12 +156,20 @@ func (v *iSystemMediaTransportControlsDisplayUpdater) VTable() *iSystemMediaTran
 	return (*iSystemMediaTransportControlsDisplayUpdaterVtbl)(unsafe.Pointer(v.RawVTable))
 }
 
+// IsSupportedISystemMediaTransportControlsDisplayUpdater returns true if the given object implements iSystemMediaTransportControlsDisplayUpdater.
+func IsSupportedISystemMediaTransportControlsDisplayUpdater(obj *ole.IUnknown) bool {
+	return winrt.IsSupported(obj, &IIDiSystemMediaTransportControlsDisplayUpdater)
+}
+
+// GetType was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (v *iSystemMediaTransportControlsDisplayUpdater) GetType() (MediaPlaybackType, error) {
 	var out MediaPlaybackType
-	hr, _, _ := syscall.SyscallN(
+	hr, _, _ := syscall.Syscall(
 		v.VTable().GetType,
+		2,                             // nargs
 		uintptr(unsafe.Pointer(v)),    // this
 		uintptr(unsafe.Pointer(&out)), // out MediaPlaybackType
+		0,
 	)
 
 	if hr != 0 {
@@ -124,11 +179,14 @@ func (v *iSystemMediaTransportControlsDisplayUpdater) GetType() (MediaPlaybackTy
 	return out, nil
 }
 
+// SetType was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (v *iSystemMediaTransportControlsDisplayUpdater) SetType(value MediaPlaybackType) error {
-	hr, _, _ := syscall.SyscallN(
+	hr, _, _ := syscall.Syscall(
 		v.VTable().SetType,
+		2,                          // nargs
 		uintptr(unsafe.Pointer(v)), // this
 		uintptr(value),             // in MediaPlaybackType
+		0,
 	)
 
 	if hr != 0 {
@@ -138,12 +196,15 @@ func (v *iSystemMediaTransportControlsDisplayUpdater) SetType(value MediaPlaybac
 	return nil
 }
 
+// GetAppMediaId was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (v *iSystemMediaTransportControlsDisplayUpdater) GetAppMediaId() (string, error) {
-	var outHStr ole.HString
-	hr, _, _ := syscall.SyscallN(
+	var outHStr hstring.HString
+	hr, _, _ := syscall.Syscall(
 		v.VTable().GetAppMediaId,
+		2,                                 // nargs
 		uintptr(unsafe.Pointer(v)),        // this
 		uintptr(unsafe.Pointer(&outHStr)), // out string
+		0,
 	)
 
 	if hr != 0 {
@@ -151,21 +212,26 @@ func (v *iSystemMediaTransportControlsDisplayUpdater) GetAppMediaId() (string, e
 	}
 
 	out := outHStr.String()
-	ole.DeleteHString(outHStr)
+	_ = outHStr.Delete()
 	return out, nil
 }
 
+// SetAppMediaId was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (v *iSystemMediaTransportControlsDisplayUpdater) SetAppMediaId(value string) error {
-	valueHStr, err := ole.NewHString(value)
+	valueHStr, err := hstring.NewReference(value)
 	if err != nil {
 		return err
 	}
-	hr, _, _ := syscall.SyscallN(
+	hr, _, _ := syscall.Syscall(
 		v.VTable().SetAppMediaId,
-		uintptr(unsafe.Pointer(v)), // this
-		uintptr(valueHStr),         // in string
+		2,                            // nargs
+		uintptr(unsafe.Pointer(v)),   // this
+		uintptr(valueHStr.HString()), // in string
+		0,
 	)
 
+	valueHStr.Release()
+
 	if hr != 0 {
 		return ole.NewError(hr)
 	}
@@ -173,55 +239,71 @@ func (v *iSystemMediaTransportControlsDisplayUpdater) SetAppMediaId(value string
 	return nil
 }
 
+// GetMusicProperties was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (v *iSystemMediaTransportControlsDisplayUpdater) GetMusicProperties() (*MusicDisplayProperties, error) {
-	var out *MusicDisplayProperties
-	hr, _, _ := syscall.SyscallN(
+	var outPtr *ole.IUnknown
+	hr, _, _ := syscall.Syscall(
 		v.VTable().GetMusicProperties,
-		uintptr(unsafe.Pointer(v)),    // this
-		uintptr(unsafe.Pointer(&out)), // out MusicDisplayProperties
+		2,                                // nargs
+		uintptr(unsafe.Pointer(v)),       // this
+		uintptr(unsafe.Pointer(&outPtr)), // out MusicDisplayProperties
+		0,
 	)
 
 	if hr != 0 {
 		return nil, ole.NewError(hr)
 	}
 
+	out := (*MusicDisplayProperties)(unsafe.Pointer(winrt.NewObject(outPtr)))
 	return out, nil
 }
 
+// GetVideoProperties was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (v *iSystemMediaTransportControlsDisplayUpdater) GetVideoProperties() (*VideoDisplayProperties, error) {
-	var out *VideoDisplayProperties
-	hr, _, _ := syscall.SyscallN(
+	var outPtr *ole.IUnknown
+	hr, _, _ := syscall.Syscall(
 		v.VTable().GetVideoProperties,
-		uintptr(unsafe.Pointer(v)),    // this
-		uintptr(unsafe.Pointer(&out)), // out VideoDisplayProperties
+		2,                                // nargs
+		uintptr(unsafe.Pointer(v)),       // this
+		uintptr(unsafe.Pointer(&outPtr)), // out VideoDisplayProperties
+		0,
 	)
 
 	if hr != 0 {
 		return nil, ole.NewError(hr)
 	}
 
+	out := (*VideoDisplayProperties)(unsafe.Pointer(winrt.NewObject(outPtr)))
 	return out, nil
 }
 
+// GetImageProperties was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (v *iSystemMediaTransportControlsDisplayUpdater) GetImageProperties() (*ImageDisplayProperties, error) {
-	var out *ImageDisplayProperties
-	hr, _, _ := syscall.SyscallN(
+	var outPtr *ole.IUnknown
+	hr, _, _ := syscall.Syscall(
 		v.VTable().GetImageProperties,
-		uintptr(unsafe.Pointer(v)),    // this
-		uintptr(unsafe.Pointer(&out)), // out ImageDisplayProperties
+		2,                                // nargs
+		uintptr(unsafe.Pointer(v)),       // this
+		uintptr(unsafe.Pointer(&outPtr)), // out ImageDisplayProperties
+		0,
 	)
 
 	if hr != 0 {
 		return nil, ole.NewError(hr)
 	}
 
+	out := (*ImageDisplayProperties)(unsafe.Pointer(winrt.NewObject(outPtr)))
 	return out, nil
 }
 
+// ClearAll was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (v *iSystemMediaTransportControlsDisplayUpdater) ClearAll() error {
-	hr, _, _ := syscall.SyscallN(
+	hr, _, _ := syscall.Syscall(
 		v.VTable().ClearAll,
+		1,                          // nargs
 		uintptr(unsafe.Pointer(v)), // this
+		0,
+		0,
 	)
 
 	if hr != 0 {
@@ -231,10 +313,14 @@ func (v *iSystemMediaTransportControlsDisplayUpdater) ClearAll() error {
 	return nil
 }
 
+// Update was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (v *iSystemMediaTransportControlsDisplayUpdater) Update() error {
-	hr, _, _ := syscall.SyscallN(
+	hr, _, _ := syscall.Syscall(
 		v.VTable().Update,
+		1,                          // nargs
 		uintptr(unsafe.Pointer(v)), // this
+		0,
+		0,
 	)
 
 	if hr != 0 {
@@ -0,0 +1,56 @@
+// Code generated by winrt-go-gen. DO NOT EDIT.
+
+//go:build windows
+
+//nolint:all
+package media
+
+import "fmt"
+
+type SystemMediaTransportControlsProperty int32
+
+const SignatureSystemMediaTransportControlsProperty string = "enum(Windows.Media.SystemMediaTransportControlsProperty;i4)"
+
+const (
+	SystemMediaTransportControlsPropertySoundLevel SystemMediaTransportControlsProperty = 0
+)
+
+var valuesSystemMediaTransportControlsProperty = []SystemMediaTransportControlsProperty{
+	SystemMediaTransportControlsPropertySoundLevel,
+}
+
+var namesSystemMediaTransportControlsProperty = []string{
+	"SoundLevel",
+}
+
+// ValuesSystemMediaTransportControlsProperty returns all the values defined by SystemMediaTransportControlsProperty.
+func ValuesSystemMediaTransportControlsProperty() []SystemMediaTransportControlsProperty {
+	return append([]SystemMediaTransportControlsProperty(nil), valuesSystemMediaTransportControlsProperty...)
+}
+
+// String returns the name of the value.
+func (v SystemMediaTransportControlsProperty) String() string {
+	for i, value := range valuesSystemMediaTransportControlsProperty {
+		if value == v {
+			return namesSystemMediaTransportControlsProperty[i]
+		}
+	}
+	return fmt.Sprintf("SystemMediaTransportControlsProperty(%d)", int32(v))
+}
+
+// ParseSystemMediaTransportControlsProperty returns the value with the given name.
+func ParseSystemMediaTransportControlsProperty(s string) (SystemMediaTransportControlsProperty, error) {
+	if v, ok := lookupSystemMediaTransportControlsProperty(s); ok {
+		return v, nil
+	}
+	return 0, fmt.Errorf("invalid SystemMediaTransportControlsProperty %q", s)
+}
+
+func lookupSystemMediaTransportControlsProperty(name string) (SystemMediaTransportControlsProperty, bool) {
+	for i, n := range namesSystemMediaTransportControlsProperty {
+		if n == name {
+			return valuesSystemMediaTransportControlsProperty[i], true
+		}
+	}
+	return 0, false
+}
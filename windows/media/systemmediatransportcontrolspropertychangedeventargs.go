@@ -0,0 +1,80 @@
+// Code generated by winrt-go-gen. DO NOT EDIT.
+
+//go:build windows
+
+//nolint:all
+package media
+
+import (
+	"syscall"
+	"unsafe"
+
+	"github.com/go-ole/go-ole"
+	"github.com/waylyrics/winrt-go"
+)
+
+const SignatureSystemMediaTransportControlsPropertyChangedEventArgs string = "rc(Windows.Media.SystemMediaTransportControlsPropertyChangedEventArgs;{d0ca0936-339b-4cb3-8eeb-737607f56e08})"
+
+type SystemMediaTransportControlsPropertyChangedEventArgs struct {
+	winrt.Object
+}
+
+// HasISystemMediaTransportControlsPropertyChangedEventArgs returns true if the SystemMediaTransportControlsPropertyChangedEventArgs implements iSystemMediaTransportControlsPropertyChangedEventArgs.
+// Interfaces added in newer versions of Windows may not be implemented by older ones.
+func (impl *SystemMediaTransportControlsPropertyChangedEventArgs) HasISystemMediaTransportControlsPropertyChangedEventArgs() bool {
+	return impl.HasInterface(&IIDiSystemMediaTransportControlsPropertyChangedEventArgs)
+}
+
+// GetProperty was introduced in Windows.Foundation.UniversalApiContract v1.0.
+func (impl *SystemMediaTransportControlsPropertyChangedEventArgs) GetProperty() (SystemMediaTransportControlsProperty, error) {
+	itf, err := impl.Interface(&IIDiSystemMediaTransportControlsPropertyChangedEventArgs)
+	if err != nil {
+		return SystemMediaTransportControlsPropertySoundLevel, err
+	}
+	defer impl.ReleaseInterface(itf)
+	v := (*iSystemMediaTransportControlsPropertyChangedEventArgs)(unsafe.Pointer(itf))
+	return v.GetProperty()
+}
+
+const GUIDiSystemMediaTransportControlsPropertyChangedEventArgs string = "d0ca0936-339b-4cb3-8eeb-737607f56e08"
+const SignatureiSystemMediaTransportControlsPropertyChangedEventArgs string = "{d0ca0936-339b-4cb3-8eeb-737607f56e08}"
+
+var IIDiSystemMediaTransportControlsPropertyChangedEventArgs = ole.GUID{Data1: 0xd0ca0936, Data2: 0x339b, Data3: 0x4cb3, Data4: [8]byte{0x8e, 0xeb, 0x73, 0x76, 0x07, 0xf5, 0x6e, 0x08}}
+
+// iSystemMediaTransportControlsPropertyChangedEventArgs was introduced in Windows.Foundation.UniversalApiContract v1.0.
+type iSystemMediaTransportControlsPropertyChangedEventArgs struct {
+	ole.IInspectable
+}
+
+type iSystemMediaTransportControlsPropertyChangedEventArgsVtbl struct {
+	ole.IInspectableVtbl
+
+	GetProperty uintptr
+}
+
+func (v *iSystemMediaTransportControlsPropertyChangedEventArgs) VTable() *iSystemMediaTransportControlsPropertyChangedEventArgsVtbl {
+	return (*iSystemMediaTransportControlsPropertyChangedEventArgsVtbl)(unsafe.Pointer(v.RawVTable))
+}
+
+// IsSupportedISystemMediaTransportControlsPropertyChangedEventArgs returns true if the given object implements iSystemMediaTransportControlsPropertyChangedEventArgs.
+func IsSupportedISystemMediaTransportControlsPropertyChangedEventArgs(obj *ole.IUnknown) bool {
+	return winrt.IsSupported(obj, &IIDiSystemMediaTransportControlsPropertyChangedEventArgs)
+}
+
+// GetProperty was introduced in Windows.Foundation.UniversalApiContract v1.0.
+func (v *iSystemMediaTransportControlsPropertyChangedEventArgs) GetProperty() (SystemMediaTransportControlsProperty, error) {
+	var out SystemMediaTransportControlsProperty
+	hr, _, _ := syscall.Syscall(
+		v.VTable().GetProperty,
+		2,                             // nargs
+		uintptr(unsafe.Pointer(v)),    // this
+		uintptr(unsafe.Pointer(&out)), // out SystemMediaTransportControlsProperty
+		0,
+	)
+
+	if hr != 0 {
+		return SystemMediaTransportControlsPropertySoundLevel, ole.NewError(hr)
+	}
+
+	return out, nil
+}
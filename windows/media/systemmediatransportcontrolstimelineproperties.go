@@ -10,13 +10,14 @@ import (
 	"unsafe"
 
 	"github.com/go-ole/go-ole"
+	"github.com/waylyrics/winrt-go"
 	"github.com/waylyrics/winrt-go/windows/foundation"
 )
 
 const SignatureSystemMediaTransportControlsTimelineProperties string = "rc(Windows.Media.SystemMediaTransportControlsTimelineProperties;{5125316a-c3a2-475b-8507-93534dc88f15})"
 
 type SystemMediaTransportControlsTimelineProperties struct {
-	ole.IUnknown
+	winrt.Object
 }
 
 func NewSystemMediaTransportControlsTimelineProperties() (*SystemMediaTransportControlsTimelineProperties, error) {
@@ -24,75 +25,121 @@ func NewSystemMediaTransportControlsTimelineProperties() (*SystemMediaTransportC
 	if err != nil {
 		return nil, err
 	}
-	return (*SystemMediaTransportControlsTimelineProperties)(unsafe.Pointer(inspectable)), nil
+	return (*SystemMediaTransportControlsTimelineProperties)(unsafe.Pointer(winrt.NewObject(&inspectable.IUnknown))), nil
 }
 
+// HasISystemMediaTransportControlsTimelineProperties returns true if the SystemMediaTransportControlsTimelineProperties implements iSystemMediaTransportControlsTimelineProperties.
+// Interfaces added in newer versions of Windows may not be implemented by older ones.
+func (impl *SystemMediaTransportControlsTimelineProperties) HasISystemMediaTransportControlsTimelineProperties() bool {
+	return impl.HasInterface(&IIDiSystemMediaTransportControlsTimelineProperties)
+}
+
+// GetStartTime was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (impl *SystemMediaTransportControlsTimelineProperties) GetStartTime() (foundation.TimeSpan, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiSystemMediaTransportControlsTimelineProperties))
-	defer itf.Release()
+	itf, err := impl.Interface(&IIDiSystemMediaTransportControlsTimelineProperties)
+	if err != nil {
+		return foundation.TimeSpan{}, err
+	}
+	defer impl.ReleaseInterface(itf)
 	v := (*iSystemMediaTransportControlsTimelineProperties)(unsafe.Pointer(itf))
 	return v.GetStartTime()
 }
 
+// SetStartTime was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (impl *SystemMediaTransportControlsTimelineProperties) SetStartTime(value foundation.TimeSpan) error {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiSystemMediaTransportControlsTimelineProperties))
-	defer itf.Release()
+	itf, err := impl.Interface(&IIDiSystemMediaTransportControlsTimelineProperties)
+	if err != nil {
+		return err
+	}
+	defer impl.ReleaseInterface(itf)
 	v := (*iSystemMediaTransportControlsTimelineProperties)(unsafe.Pointer(itf))
 	return v.SetStartTime(value)
 }
 
+// GetEndTime was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (impl *SystemMediaTransportControlsTimelineProperties) GetEndTime() (foundation.TimeSpan, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiSystemMediaTransportControlsTimelineProperties))
-	defer itf.Release()
+	itf, err := impl.Interface(&IIDiSystemMediaTransportControlsTimelineProperties)
+	if err != nil {
+		return foundation.TimeSpan{}, err
+	}
+	defer impl.ReleaseInterface(itf)
 	v := (*iSystemMediaTransportControlsTimelineProperties)(unsafe.Pointer(itf))
 	return v.GetEndTime()
 }
 
+// SetEndTime was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (impl *SystemMediaTransportControlsTimelineProperties) SetEndTime(value foundation.TimeSpan) error {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiSystemMediaTransportControlsTimelineProperties))
-	defer itf.Release()
+	itf, err := impl.Interface(&IIDiSystemMediaTransportControlsTimelineProperties)
+	if err != nil {
+		return err
+	}
+	defer impl.ReleaseInterface(itf)
 	v := (*iSystemMediaTransportControlsTimelineProperties)(unsafe.Pointer(itf))
 	return v.SetEndTime(value)
 }
 
+// GetMinSeekTime was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (impl *SystemMediaTransportControlsTimelineProperties) GetMinSeekTime() (foundation.TimeSpan, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiSystemMediaTransportControlsTimelineProperties))
-	defer itf.Release()
+	itf, err := impl.Interface(&IIDiSystemMediaTransportControlsTimelineProperties)
+	if err != nil {
+		return foundation.TimeSpan{}, err
+	}
+	defer impl.ReleaseInterface(itf)
 	v := (*iSystemMediaTransportControlsTimelineProperties)(unsafe.Pointer(itf))
 	return v.GetMinSeekTime()
 }
 
+// SetMinSeekTime was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (impl *SystemMediaTransportControlsTimelineProperties) SetMinSeekTime(value foundation.TimeSpan) error {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiSystemMediaTransportControlsTimelineProperties))
-	defer itf.Release()
+	itf, err := impl.Interface(&IIDiSystemMediaTransportControlsTimelineProperties)
+	if err != nil {
+		return err
+	}
+	defer impl.ReleaseInterface(itf)
 	v := (*iSystemMediaTransportControlsTimelineProperties)(unsafe.Pointer(itf))
 	return v.SetMinSeekTime(value)
 }
 
+// GetMaxSeekTime was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (impl *SystemMediaTransportControlsTimelineProperties) GetMaxSeekTime() (foundation.TimeSpan, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiSystemMediaTransportControlsTimelineProperties))
-	defer itf.Release()
+	itf, err := impl.Interface(&IIDiSystemMediaTransportControlsTimelineProperties)
+	if err != nil {
+		return foundation.TimeSpan{}, err
+	}
+	defer impl.ReleaseInterface(itf)
 	v := (*iSystemMediaTransportControlsTimelineProperties)(unsafe.Pointer(itf))
 	return v.GetMaxSeekTime()
 }
 
+// SetMaxSeekTime was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (impl *SystemMediaTransportControlsTimelineProperties) SetMaxSeekTime(value foundation.TimeSpan) error {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiSystemMediaTransportControlsTimelineProperties))
-	defer itf.Release()
+	itf, err := impl.Interface(&IIDiSystemMediaTransportControlsTimelineProperties)
+	if err != nil {
+		return err
+	}
+	defer impl.ReleaseInterface(itf)
 	v := (*iSystemMediaTransportControlsTimelineProperties)(unsafe.Pointer(itf))
 	return v.SetMaxSeekTime(value)
 }
 
+// GetPosition was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (impl *SystemMediaTransportControlsTimelineProperties) GetPosition() (foundation.TimeSpan, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiSystemMediaTransportControlsTimelineProperties))
-	defer itf.Release()
+	itf, err := impl.Interface(&IIDiSystemMediaTransportControlsTimelineProperties)
+	if err != nil {
+		return foundation.TimeSpan{}, err
+	}
+	defer impl.ReleaseInterface(itf)
 	v := (*iSystemMediaTransportControlsTimelineProperties)(unsafe.Pointer(itf))
 	return v.GetPosition()
 }
 
+// SetPosition was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (impl *SystemMediaTransportControlsTimelineProperties) SetPosition(value foundation.TimeSpan) error {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiSystemMediaTransportControlsTimelineProperties))
-	defer itf.Release()
+	itf, err := impl.Interface(&IIDiSystemMediaTransportControlsTimelineProperties)
+	if err != nil {
+		return err
+	}
+	defer impl.ReleaseInterface(itf)
 	v := (*iSystemMediaTransportControlsTimelineProperties)(unsafe.Pointer(itf))
 	return v.SetPosition(value)
 }
@@ -100,6 +147,9 @@ func (impl *SystemMediaTransportControlsTimelineProperties) SetPosition(value fo
 const GUIDiSystemMediaTransportControlsTimelineProperties string = "5125316a-c3a2-475b-8507-93534dc88f15"
 const SignatureiSystemMediaTransportControlsTimelineProperties string = "{5125316a-c3a2-475b-8507-93534dc88f15}"
 
+var IIDiSystemMediaTransportControlsTimelineProperties = ole.GUID{Data1: 0x5125316a, Data2: 0xc3a2, Data3: 0x475b, Data4: [8]byte{0x85, 0x07, 0x93, 0x53, 0x4d, 0xc8, 0x8f, 0x15}}
+
+// iSystemMediaTransportControlsTimelineProperties was introduced in Windows.Foundation.UniversalApiContract v1.0.
 type iSystemMediaTransportControlsTimelineProperties struct {
 	ole.IInspectable
 }
@@ -123,12 +173,20 @@ func (v *iSystemMediaTransportControlsTimelineProperties) VTable() *iSystemMedia
 	return (*iSystemMediaTransportControlsTimelinePropertiesVtbl)(unsafe.Pointer(v.RawVTable))
 }
 
+// IsSupportedISystemMediaTransportControlsTimelineProperties returns true if the given object implements iSystemMediaTransportControlsTimelineProperties.
+func IsSupportedISystemMediaTransportControlsTimelineProperties(obj *ole.IUnknown) bool {
+	return winrt.IsSupported(obj, &IIDiSystemMediaTransportControlsTimelineProperties)
+}
+
+// GetStartTime was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (v *iSystemMediaTransportControlsTimelineProperties) GetStartTime() (foundation.TimeSpan, error) {
 	var out foundation.TimeSpan
-	hr, _, _ := syscall.SyscallN(
+	hr, _, _ := syscall.Syscall(
 		v.VTable().GetStartTime,
+		2,                             // nargs
 		uintptr(unsafe.Pointer(v)),    // this
 		uintptr(unsafe.Pointer(&out)), // out foundation.TimeSpan
+		0,
 	)
 
 	if hr != 0 {
@@ -138,11 +196,14 @@ func (v *iSystemMediaTransportControlsTimelineProperties) GetStartTime() (founda
 	return out, nil
 }
 
+// SetStartTime was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (v *iSystemMediaTransportControlsTimelineProperties) SetStartTime(value foundation.TimeSpan) error {
-	hr, _, _ := syscall.SyscallN(
+	hr, _, _ := syscall.Syscall(
 		v.VTable().SetStartTime,
-		uintptr(unsafe.Pointer(v)),      // this
-		uintptr(unsafe.Pointer(&value)), // in foundation.TimeSpan
+		2,                          // nargs
+		uintptr(unsafe.Pointer(v)), // this
+		winrt.ABIValue(&value),     // in foundation.TimeSpan
+		0,
 	)
 
 	if hr != 0 {
@@ -152,12 +213,15 @@ func (v *iSystemMediaTransportControlsTimelineProperties) SetStartTime(value fou
 	return nil
 }
 
+// GetEndTime was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (v *iSystemMediaTransportControlsTimelineProperties) GetEndTime() (foundation.TimeSpan, error) {
 	var out foundation.TimeSpan
-	hr, _, _ := syscall.SyscallN(
+	hr, _, _ := syscall.Syscall(
 		v.VTable().GetEndTime,
+		2,                             // nargs
 		uintptr(unsafe.Pointer(v)),    // this
 		uintptr(unsafe.Pointer(&out)), // out foundation.TimeSpan
+		0,
 	)
 
 	if hr != 0 {
@@ -167,11 +231,14 @@ func (v *iSystemMediaTransportControlsTimelineProperties) GetEndTime() (foundati
 	return out, nil
 }
 
+// SetEndTime was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (v *iSystemMediaTransportControlsTimelineProperties) SetEndTime(value foundation.TimeSpan) error {
-	hr, _, _ := syscall.SyscallN(
+	hr, _, _ := syscall.Syscall(
 		v.VTable().SetEndTime,
-		uintptr(unsafe.Pointer(v)),      // this
-		uintptr(unsafe.Pointer(&value)), // in foundation.TimeSpan
+		2,                          // nargs
+		uintptr(unsafe.Pointer(v)), // this
+		winrt.ABIValue(&value),     // in foundation.TimeSpan
+		0,
 	)
 
 	if hr != 0 {
@@ -181,12 +248,15 @@ func (v *iSystemMediaTransportControlsTimelineProperties) SetEndTime(value found
 	return nil
 }
 
+// GetMinSeekTime was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (v *iSystemMediaTransportControlsTimelineProperties) GetMinSeekTime() (foundation.TimeSpan, error) {
 	var out foundation.TimeSpan
-	hr, _, _ := syscall.SyscallN(
+	hr, _, _ := syscall.Syscall(
 		v.VTable().GetMinSeekTime,
+		2,                             // nargs
 		uintptr(unsafe.Pointer(v)),    // this
 		uintptr(unsafe.Pointer(&out)), // out foundation.TimeSpan
+		0,
 	)
 
 	if hr != 0 {
@@ -196,11 +266,14 @@ func (v *iSystemMediaTransportControlsTimelineProperties) GetMinSeekTime() (foun
 	return out, nil
 }
 
+// SetMinSeekTime was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (v *iSystemMediaTransportControlsTimelineProperties) SetMinSeekTime(value foundation.TimeSpan) error {
-	hr, _, _ := syscall.SyscallN(
+	hr, _, _ := syscall.Syscall(
 		v.VTable().SetMinSeekTime,
-		uintptr(unsafe.Pointer(v)),      // this
-		uintptr(unsafe.Pointer(&value)), // in foundation.TimeSpan
+		2,                          // nargs
+		uintptr(unsafe.Pointer(v)), // this
+		winrt.ABIValue(&value),     // in foundation.TimeSpan
+		0,
 	)
 
 	if hr != 0 {
@@ -210,12 +283,15 @@ func (v *iSystemMediaTransportControlsTimelineProperties) SetMinSeekTime(value f
 	return nil
 }
 
+// GetMaxSeekTime was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (v *iSystemMediaTransportControlsTimelineProperties) GetMaxSeekTime() (foundation.TimeSpan, error) {
 	var out foundation.TimeSpan
-	hr, _, _ := syscall.SyscallN(
+	hr, _, _ := syscall.Syscall(
 		v.VTable().GetMaxSeekTime,
+		2,                             // nargs
 		uintptr(unsafe.Pointer(v)),    // this
 		uintptr(unsafe.Pointer(&out)), // out foundation.TimeSpan
+		0,
 	)
 
 	if hr != 0 {
@@ -225,11 +301,14 @@ func (v *iSystemMediaTransportControlsTimelineProperties) GetMaxSeekTime() (foun
 	return out, nil
 }
 
+// SetMaxSeekTime was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (v *iSystemMediaTransportControlsTimelineProperties) SetMaxSeekTime(value foundation.TimeSpan) error {
-	hr, _, _ := syscall.SyscallN(
+	hr, _, _ := syscall.Syscall(
 		v.VTable().SetMaxSeekTime,
-		uintptr(unsafe.Pointer(v)),      // this
-		uintptr(unsafe.Pointer(&value)), // in foundation.TimeSpan
+		2,                          // nargs
+		uintptr(unsafe.Pointer(v)), // this
+		winrt.ABIValue(&value),     // in foundation.TimeSpan
+		0,
 	)
 
 	if hr != 0 {
@@ -239,12 +318,15 @@ func (v *iSystemMediaTransportControlsTimelineProperties) SetMaxSeekTime(value f
 	return nil
 }
 
+// GetPosition was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (v *iSystemMediaTransportControlsTimelineProperties) GetPosition() (foundation.TimeSpan, error) {
 	var out foundation.TimeSpan
-	hr, _, _ := syscall.SyscallN(
+	hr, _, _ := syscall.Syscall(
 		v.VTable().GetPosition,
+		2,                             // nargs
 		uintptr(unsafe.Pointer(v)),    // this
 		uintptr(unsafe.Pointer(&out)), // out foundation.TimeSpan
+		0,
 	)
 
 	if hr != 0 {
@@ -254,11 +336,14 @@ func (v *iSystemMediaTransportControlsTimelineProperties) GetPosition() (foundat
 	return out, nil
 }
 
+// SetPosition was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (v *iSystemMediaTransportControlsTimelineProperties) SetPosition(value foundation.TimeSpan) error {
-	hr, _, _ := syscall.SyscallN(
+	hr, _, _ := syscall.Syscall(
 		v.VTable().SetPosition,
-		uintptr(unsafe.Pointer(v)),      // this
-		uintptr(unsafe.Pointer(&value)), // in foundation.TimeSpan
+		2,                          // nargs
+		uintptr(unsafe.Pointer(v)), // this
+		winrt.ABIValue(&value),     // in foundation.TimeSpan
+		0,
 	)
 
 	if hr != 0 {
@@ -10,46 +10,80 @@ import (
 	"unsafe"
 
 	"github.com/go-ole/go-ole"
+	"github.com/waylyrics/winrt-go"
+	"github.com/waylyrics/winrt-go/hstring"
 	"github.com/waylyrics/winrt-go/windows/foundation/collections"
 )
 
 const SignatureVideoDisplayProperties string = "rc(Windows.Media.VideoDisplayProperties;{5609fdb1-5d2d-4872-8170-45dee5bc2f5c})"
 
 type VideoDisplayProperties struct {
-	ole.IUnknown
+	winrt.Object
 }
 
+// HasIVideoDisplayProperties returns true if the VideoDisplayProperties implements iVideoDisplayProperties.
+// Interfaces added in newer versions of Windows may not be implemented by older ones.
+func (impl *VideoDisplayProperties) HasIVideoDisplayProperties() bool {
+	return impl.HasInterface(&IIDiVideoDisplayProperties)
+}
+
+// GetTitle was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (impl *VideoDisplayProperties) GetTitle() (string, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiVideoDisplayProperties))
-	defer itf.Release()
+	itf, err := impl.Interface(&IIDiVideoDisplayProperties)
+	if err != nil {
+		return "", err
+	}
+	defer impl.ReleaseInterface(itf)
 	v := (*iVideoDisplayProperties)(unsafe.Pointer(itf))
 	return v.GetTitle()
 }
 
+// SetTitle was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (impl *VideoDisplayProperties) SetTitle(value string) error {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiVideoDisplayProperties))
-	defer itf.Release()
+	itf, err := impl.Interface(&IIDiVideoDisplayProperties)
+	if err != nil {
+		return err
+	}
+	defer impl.ReleaseInterface(itf)
 	v := (*iVideoDisplayProperties)(unsafe.Pointer(itf))
 	return v.SetTitle(value)
 }
 
+// GetSubtitle was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (impl *VideoDisplayProperties) GetSubtitle() (string, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiVideoDisplayProperties))
-	defer itf.Release()
+	itf, err := impl.Interface(&IIDiVideoDisplayProperties)
+	if err != nil {
+		return "", err
+	}
+	defer impl.ReleaseInterface(itf)
 	v := (*iVideoDisplayProperties)(unsafe.Pointer(itf))
 	return v.GetSubtitle()
 }
 
+// SetSubtitle was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (impl *VideoDisplayProperties) SetSubtitle(value string) error {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiVideoDisplayProperties))
-	defer itf.Release()
+	itf, err := impl.Interface(&IIDiVideoDisplayProperties)
+	if err != nil {
+		return err
+	}
+	defer impl.ReleaseInterface(itf)
 	v := (*iVideoDisplayProperties)(unsafe.Pointer(itf))
 	return v.SetSubtitle(value)
 }
 
+// HasIVideoDisplayProperties2 returns true if the VideoDisplayProperties implements iVideoDisplayProperties2.
+// Interfaces added in newer versions of Windows may not be implemented by older ones.
+func (impl *VideoDisplayProperties) HasIVideoDisplayProperties2() bool {
+	return impl.HasInterface(&IIDiVideoDisplayProperties2)
+}
+
+// GetGenres was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (impl *VideoDisplayProperties) GetGenres() (*collections.IVector, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiVideoDisplayProperties2))
-	defer itf.Release()
+	itf, err := impl.Interface(&IIDiVideoDisplayProperties2)
+	if err != nil {
+		return nil, err
+	}
+	defer impl.ReleaseInterface(itf)
 	v := (*iVideoDisplayProperties2)(unsafe.Pointer(itf))
 	return v.GetGenres()
 }
@@ -57,6 +91,9 @@ func (impl *VideoDisplayProperties) GetGenres() (*collections.IVector, error) {
 const GUIDiVideoDisplayProperties string = "5609fdb1-5d2d-4872-8170-45dee5bc2f5c"
 const SignatureiVideoDisplayProperties string = "{5609fdb1-5d2d-4872-8170-45dee5bc2f5c}"
 
+var IIDiVideoDisplayProperties = ole.GUID{Data1: 0x5609fdb1, Data2: 0x5d2d, Data3: 0x4872, Data4: [8]byte{0x81, 0x70, 0x45, 0xde, 0xe5, 0xbc, 0x2f, 0x5c}}
+
+// iVideoDisplayProperties was introduced in Windows.Foundation.UniversalApiContract v1.0.
 type iVideoDisplayProperties struct {
 	ole.IInspectable
 }
@@ -74,12 +111,20 @@ func (v *iVideoDisplayProperties) VTable() *iVideoDisplayPropertiesVtbl {
 	return (*iVideoDisplayPropertiesVtbl)(unsafe.Pointer(v.RawVTable))
 }
 
+// IsSupportedIVideoDisplayProperties returns true if the given object implements iVideoDisplayProperties.
+func IsSupportedIVideoDisplayProperties(obj *ole.IUnknown) bool {
+	return winrt.IsSupported(obj, &IIDiVideoDisplayProperties)
+}
+
+// GetTitle was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (v *iVideoDisplayProperties) GetTitle() (string, error) {
-	var outHStr ole.HString
-	hr, _, _ := syscall.SyscallN(
+	var outHStr hstring.HString
+	hr, _, _ := syscall.Syscall(
 		v.VTable().GetTitle,
+		2,                                 // nargs
 		uintptr(unsafe.Pointer(v)),        // this
 		uintptr(unsafe.Pointer(&outHStr)), // out string
+		0,
 	)
 
 	if hr != 0 {
@@ -87,21 +132,26 @@ func (v *iVideoDisplayProperties) GetTitle() (string, error) {
 	}
 
 	out := outHStr.String()
-	ole.DeleteHString(outHStr)
+	_ = outHStr.Delete()
 	return out, nil
 }
 
+// SetTitle was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (v *iVideoDisplayProperties) SetTitle(value string) error {
-	valueHStr, err := ole.NewHString(value)
+	valueHStr, err := hstring.NewReference(value)
 	if err != nil {
 		return err
 	}
-	hr, _, _ := syscall.SyscallN(
+	hr, _, _ := syscall.Syscall(
 		v.VTable().SetTitle,
-		uintptr(unsafe.Pointer(v)), // this
-		uintptr(valueHStr),         // in string
+		2,                            // nargs
+		uintptr(unsafe.Pointer(v)),   // this
+		uintptr(valueHStr.HString()), // in string
+		0,
 	)
 
+	valueHStr.Release()
+
 	if hr != 0 {
 		return ole.NewError(hr)
 	}
@@ -109,12 +159,15 @@ func (v *iVideoDisplayProperties) SetTitle(value string) error {
 	return nil
 }
 
+// GetSubtitle was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (v *iVideoDisplayProperties) GetSubtitle() (string, error) {
-	var outHStr ole.HString
-	hr, _, _ := syscall.SyscallN(
+	var outHStr hstring.HString
+	hr, _, _ := syscall.Syscall(
 		v.VTable().GetSubtitle,
+		2,                                 // nargs
 		uintptr(unsafe.Pointer(v)),        // this
 		uintptr(unsafe.Pointer(&outHStr)), // out string
+		0,
 	)
 
 	if hr != 0 {
@@ -122,21 +175,26 @@ func (v *iVideoDisplayProperties) GetSubtitle() (string, error) {
 	}
 
 	out := outHStr.String()
-	ole.DeleteHString(outHStr)
+	_ = outHStr.Delete()
 	return out, nil
 }
 
+// SetSubtitle was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (v *iVideoDisplayProperties) SetSubtitle(value string) error {
-	valueHStr, err := ole.NewHString(value)
+	valueHStr, err := hstring.NewReference(value)
 	if err != nil {
 		return err
 	}
-	hr, _, _ := syscall.SyscallN(
+	hr, _, _ := syscall.Syscall(
 		v.VTable().SetSubtitle,
-		uintptr(unsafe.Pointer(v)), // this
-		uintptr(valueHStr),         // in string
+		2,                            // nargs
+		uintptr(unsafe.Pointer(v)),   // this
+		uintptr(valueHStr.HString()), // in string
+		0,
 	)
 
+	valueHStr.Release()
+
 	if hr != 0 {
 		return ole.NewError(hr)
 	}
@@ -147,6 +205,9 @@ func (v *iVideoDisplayProperties) SetSubtitle(value string) error {
 const GUIDiVideoDisplayProperties2 string = "b410e1ce-ab52-41ab-a486-cc10fab152f9"
 const SignatureiVideoDisplayProperties2 string = "{b410e1ce-ab52-41ab-a486-cc10fab152f9}"
 
+var IIDiVideoDisplayProperties2 = ole.GUID{Data1: 0xb410e1ce, Data2: 0xab52, Data3: 0x41ab, Data4: [8]byte{0xa4, 0x86, 0xcc, 0x10, 0xfa, 0xb1, 0x52, 0xf9}}
+
+// iVideoDisplayProperties2 was introduced in Windows.Foundation.UniversalApiContract v1.0.
 type iVideoDisplayProperties2 struct {
 	ole.IInspectable
 }
@@ -161,12 +222,20 @@ func (v *iVideoDisplayProperties2) VTable() *iVideoDisplayProperties2Vtbl {
 	return (*iVideoDisplayProperties2Vtbl)(unsafe.Pointer(v.RawVTable))
 }
 
+// IsSupportedIVideoDisplayProperties2 returns true if the given object implements iVideoDisplayProperties2.
+func IsSupportedIVideoDisplayProperties2(obj *ole.IUnknown) bool {
+	return winrt.IsSupported(obj, &IIDiVideoDisplayProperties2)
+}
+
+// GetGenres was introduced in Windows.Foundation.UniversalApiContract v1.0.
 func (v *iVideoDisplayProperties2) GetGenres() (*collections.IVector, error) {
 	var out *collections.IVector
-	hr, _, _ := syscall.SyscallN(
+	hr, _, _ := syscall.Syscall(
 		v.VTable().GetGenres,
+		2,                             // nargs
 		uintptr(unsafe.Pointer(v)),    // this
 		uintptr(unsafe.Pointer(&out)), // out collections.IVector
+		0,
 	)
 
 	if hr != 0 {